@@ -8,11 +8,41 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// Embed the time zone database for the recurrences of hosts without one
+	_ "time/tzdata"
 
+	"github.com/supachai1998/task_services/internal/auth"
 	"github.com/supachai1998/task_services/internal/configs"
+	apiKeyRepository "github.com/supachai1998/task_services/internal/domains/apikeys/infrastructure/repository"
+	apiKeyHandlerV1 "github.com/supachai1998/task_services/internal/domains/apikeys/interfaces/handlers/v1"
+	apiKeyUsecases "github.com/supachai1998/task_services/internal/domains/apikeys/usecases"
+	attachmentBlobStore "github.com/supachai1998/task_services/internal/domains/attachments/infrastructure/blobstore"
+	attachmentRepository "github.com/supachai1998/task_services/internal/domains/attachments/infrastructure/repository"
+	attachmentHandlerV1 "github.com/supachai1998/task_services/internal/domains/attachments/interfaces/handlers/v1"
+	attachmentUsecases "github.com/supachai1998/task_services/internal/domains/attachments/usecases"
+	commentRepository "github.com/supachai1998/task_services/internal/domains/comments/infrastructure/repository"
+	commentHandlerV1 "github.com/supachai1998/task_services/internal/domains/comments/interfaces/handlers/v1"
+	commentUsecases "github.com/supachai1998/task_services/internal/domains/comments/usecases"
+	labelRepository "github.com/supachai1998/task_services/internal/domains/labels/infrastructure/repository"
+	labelHandlerV1 "github.com/supachai1998/task_services/internal/domains/labels/interfaces/handlers/v1"
+	labelUsecases "github.com/supachai1998/task_services/internal/domains/labels/usecases"
+	outboxRepository "github.com/supachai1998/task_services/internal/domains/outbox/infrastructure/repository"
+	outboxSinks "github.com/supachai1998/task_services/internal/domains/outbox/infrastructure/sinks"
+	outboxUsecases "github.com/supachai1998/task_services/internal/domains/outbox/usecases"
+	recurrenceRepository "github.com/supachai1998/task_services/internal/domains/recurrences/infrastructure/repository"
+	recurrenceHandlerV1 "github.com/supachai1998/task_services/internal/domains/recurrences/interfaces/handlers/v1"
+	recurrenceUsecases "github.com/supachai1998/task_services/internal/domains/recurrences/usecases"
 	taskRepository "github.com/supachai1998/task_services/internal/domains/tasks/infrastructure/repository"
 	taskHandlerV1 "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
 	taskUsecase "github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	webhookRepository "github.com/supachai1998/task_services/internal/domains/webhooks/infrastructure/repository"
+	webhookHandlerV1 "github.com/supachai1998/task_services/internal/domains/webhooks/interfaces/handlers/v1"
+	webhookUsecases "github.com/supachai1998/task_services/internal/domains/webhooks/usecases"
+	workflowRepository "github.com/supachai1998/task_services/internal/domains/workflows/infrastructure/repository"
+	workflowHandlerV1 "github.com/supachai1998/task_services/internal/domains/workflows/interfaces/handlers/v1"
+	workflowUsecases "github.com/supachai1998/task_services/internal/domains/workflows/usecases"
+	workspaceRepository "github.com/supachai1998/task_services/internal/domains/workspaces/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/entities"
 	"github.com/supachai1998/task_services/internal/infrastructure"
 	"github.com/supachai1998/task_services/internal/interfaces"
 )
@@ -22,6 +52,14 @@ import (
 // @description This is a simple task service API.
 // @termsOfService
 // @contact.name Supachai
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description JWT bearer token, as "Bearer <token>"
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name Authorization
+// @description API key, as "ApiKey <key>"
 func main() {
 	// Initialize configuration
 	configs.InitConfig()
@@ -30,13 +68,84 @@ func main() {
 	if err != nil {
 		panic("failed to connect to database")
 	}
+	// Initialize authentication
+	var verifier *auth.Verifier
+	if configs.AppConfig.Auth.Enabled {
+		verifier, err = auth.NewVerifier(&configs.AppConfig.Auth)
+		if err != nil {
+			panic(fmt.Sprintf("failed to initialize authentication: %v", err))
+		}
+	}
+	defaultRole, err := entities.ParseWorkspaceRole(configs.AppConfig.Auth.DefaultWorkspaceRole)
+	if err != nil {
+		panic(err)
+	}
+	queryTimeout := time.Duration(configs.AppConfig.Database.QueryTimeout) * time.Second
+	memberRepo := workspaceRepository.NewMemberCache(
+		workspaceRepository.NewMemberRepository(db, workspaceRepository.Options{QueryTimeout: queryTimeout}),
+		time.Duration(configs.AppConfig.Auth.WorkspaceRoleCacheTTL)*time.Second,
+	)
+	apiKeyRepo := apiKeyRepository.NewApiKeyRepository(db, apiKeyRepository.Options{QueryTimeout: queryTimeout})
+	apiKeyUsecase := apiKeyUsecases.NewApiKeyUsecase(apiKeyRepo)
 	// Initialize Echo
-	e := interfaces.NewEchoInterface(&configs.AppConfig.Server)
+	e := interfaces.NewEchoInterface(&configs.AppConfig.Server, interfaces.AuthOptions{
+		Verifier:    verifier,
+		ApiKeys:     apiKeyUsecase,
+		Members:     memberRepo,
+		DefaultRole: defaultRole,
+	})
 
 	// Initialize repositories, use cases, and handlers
-	taskRepo := taskRepository.NewTaskRepository(db)
-	taskUsecase := taskUsecase.NewTaskUsecase(taskRepo)
+	workflowRepo := workflowRepository.NewWorkflowRepository(db, workflowRepository.Options{QueryTimeout: queryTimeout})
+	workflowUsecase := workflowUsecases.NewWorkflowUsecase(workflowRepo)
+	workflowHandlerV1.NewWorkflowHandler(e, workflowUsecase)
+	webhookRepo := webhookRepository.NewWebhookRepository(db, webhookRepository.Options{QueryTimeout: queryTimeout})
+	webhookUsecase := webhookUsecases.NewWebhookUsecase(webhookRepo, webhookUsecases.Options{
+		Client:       &http.Client{Timeout: time.Duration(configs.AppConfig.Webhook.Timeout) * time.Second},
+		MaxAttempts:  configs.AppConfig.Webhook.MaxAttempts,
+		PollInterval: time.Duration(configs.AppConfig.Webhook.PollInterval) * time.Second,
+	})
+	webhookHandlerV1.NewWebhookHandler(e, webhookUsecase)
+	taskRepo := taskRepository.NewTaskRepository(db, taskRepository.Options{QueryTimeout: queryTimeout})
+	visibility, err := taskUsecase.ParseVisibility(configs.AppConfig.Auth.TaskVisibility)
+	if err != nil {
+		panic(err)
+	}
+	taskOptions := taskUsecase.Options{
+		Visibility: visibility,
+		Workflows:  workflowUsecase,
+	}
+	taskStream := taskUsecase.NewTaskStream(taskRepo, taskOptions, taskUsecase.StreamOptions{
+		BufferSize:  configs.AppConfig.Stream.BufferSize,
+		ReplayLimit: configs.AppConfig.Stream.ReplayLimit,
+	})
+	taskUsecase := taskUsecase.NewTaskUsecase(taskRepo, taskOptions)
 	taskHandlerV1.NewTaskHandler(e, taskUsecase)
+	taskHandlerV1.NewTaskStreamHandler(e, taskStream, taskHandlerV1.StreamOptions{
+		Heartbeat:    time.Duration(configs.AppConfig.Stream.HeartbeatInterval) * time.Second,
+		WriteTimeout: time.Duration(configs.AppConfig.Stream.WriteTimeout) * time.Second,
+	})
+	apiKeyHandlerV1.NewApiKeyHandler(e, apiKeyUsecase)
+	labelRepo := labelRepository.NewLabelRepository(db, labelRepository.Options{QueryTimeout: queryTimeout})
+	labelHandlerV1.NewLabelHandler(e, labelUsecases.NewLabelUsecase(labelRepo))
+	commentRepo := commentRepository.NewCommentRepository(db, commentRepository.Options{QueryTimeout: queryTimeout})
+	commentHandlerV1.NewCommentHandler(e, commentUsecases.NewCommentUsecase(commentRepo, taskUsecase))
+	blobStore, err := attachmentBlobStore.NewBlobStore(&configs.AppConfig.Storage)
+	if err != nil {
+		panic(fmt.Sprintf("failed to initialize attachment storage: %v", err))
+	}
+	attachmentRepo := attachmentRepository.NewAttachmentRepository(db, attachmentRepository.Options{QueryTimeout: queryTimeout})
+	attachmentHandlerV1.NewAttachmentHandler(e, attachmentUsecases.NewAttachmentUsecase(attachmentRepo, blobStore, taskUsecase, attachmentUsecases.Options{
+		MaxSize:      configs.AppConfig.Storage.MaxUploadSize,
+		AllowedTypes: configs.AppConfig.Storage.AllowedTypes,
+	}))
+	recurrenceRepo := recurrenceRepository.NewRecurrenceRepository(db, recurrenceRepository.Options{QueryTimeout: queryTimeout})
+	recurrenceUsecase := recurrenceUsecases.NewRecurrenceUsecase(recurrenceRepo, taskUsecase, recurrenceUsecases.Options{
+		PollInterval: time.Duration(configs.AppConfig.Recurrence.PollInterval) * time.Second,
+		BatchSize:    configs.AppConfig.Recurrence.BatchSize,
+		Lease:        time.Duration(configs.AppConfig.Recurrence.Lease) * time.Second,
+	})
+	recurrenceHandlerV1.NewRecurrenceHandler(e, recurrenceUsecase)
 
 	server := &http.Server{
 		Addr:         fmt.Sprintf(":%s", configs.AppConfig.Server.Port),
@@ -45,6 +154,33 @@ func main() {
 		IdleTimeout:  time.Duration(configs.AppConfig.Server.IdleTimeout) * time.Second,
 	}
 
+	// Relay the domain events of the outbox to the in-process subscribers and the configured
+	// brokers, and send the queued webhook deliveries, until the server shuts down
+	subscribers := outboxSinks.NewSubscribers()
+	subscribers.Subscribe(webhookUsecase.HandleDomainEvent)
+	sinks, err := outboxSinks.NewSinks(&configs.AppConfig.Outbox, subscribers)
+	if err != nil {
+		panic(fmt.Sprintf("failed to initialize the outbox sinks: %v", err))
+	}
+	relay := outboxUsecases.NewRelay(
+		outboxRepository.NewOutboxRepository(db, outboxRepository.Options{QueryTimeout: queryTimeout}),
+		sinks,
+		outboxUsecases.Options{
+			PollInterval: time.Duration(configs.AppConfig.Outbox.PollInterval) * time.Second,
+			Retention:    time.Duration(configs.AppConfig.Outbox.RetentionHours) * time.Hour,
+		},
+	)
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go relay.Run(workersCtx)
+	go webhookUsecase.RunDispatcher(workersCtx)
+	// Create the tasks of the due recurrences; the instances share the work
+	go recurrenceUsecase.RunScheduler(workersCtx)
+	// Stream the task events recorded by every instance to the subscribers of this one
+	taskEvents := infrastructure.NewPostgresListener(&configs.AppConfig.Database, entities.TaskEventsChannel,
+		taskStream.HandleNotification, taskStream.Reset)
+	go taskEvents.Run(workersCtx)
+
 	// Start the server in a goroutine
 	go func() {
 		if err := e.StartServer(server); err != nil && err != http.ErrServerClosed {
@@ -57,6 +193,10 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	e.Logger.Info("Gracefully shutting down the server...")
+	stopWorkers()
+	// End the task streams, whose connections the server no longer tracks, so their clients
+	// resume on another instance
+	taskStream.Reset()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
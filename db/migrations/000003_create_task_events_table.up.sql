@@ -0,0 +1,11 @@
+CREATE TABLE task_events (
+    id BIGSERIAL PRIMARY KEY,
+    task_id INTEGER NOT NULL REFERENCES tasks (id),
+    action VARCHAR(32) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}',
+    actor VARCHAR(255) NOT NULL,
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_events_task_id ON task_events (task_id, id);
@@ -0,0 +1,15 @@
+ALTER TABLE tasks
+    ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN completed_at TIMESTAMP NULL;
+
+-- Backfill from the audit trail where it is available.
+UPDATE tasks
+SET created_at = task_events.created_at, updated_at = task_events.created_at
+FROM task_events
+WHERE task_events.task_id = tasks.id AND task_events.action = 'created';
+
+UPDATE tasks SET completed_at = updated_at WHERE status = 'DONE';
+
+CREATE INDEX idx_tasks_created_at ON tasks (created_at, id);
+CREATE INDEX idx_tasks_updated_at ON tasks (updated_at, id);
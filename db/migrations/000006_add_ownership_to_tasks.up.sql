@@ -0,0 +1,7 @@
+-- Existing tasks have no owner; only admins can change them until they are assigned.
+ALTER TABLE tasks
+    ADD COLUMN created_by VARCHAR(255) NULL,
+    ADD COLUMN assignee_id VARCHAR(255) NULL;
+
+CREATE INDEX idx_tasks_created_by ON tasks (created_by);
+CREATE INDEX idx_tasks_assignee_id ON tasks (assignee_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_task_events_workspace_id;
+DROP INDEX IF EXISTS idx_tasks_workspace_id;
+
+ALTER TABLE task_events DROP COLUMN IF EXISTS workspace_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS workspace_id;
+
+DROP TABLE IF EXISTS workspaces;
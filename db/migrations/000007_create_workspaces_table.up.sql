@@ -0,0 +1,23 @@
+CREATE TABLE workspaces (
+    id SERIAL PRIMARY KEY,
+    slug VARCHAR(64) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Existing tasks and events move to the default workspace.
+INSERT INTO workspaces (id, slug, name) VALUES (1, 'default', 'Default');
+SELECT setval(pg_get_serial_sequence('workspaces', 'id'), 1);
+
+ALTER TABLE tasks
+    ADD COLUMN workspace_id INTEGER NOT NULL DEFAULT 1
+        CONSTRAINT fk_tasks_workspace REFERENCES workspaces (id);
+ALTER TABLE tasks ALTER COLUMN workspace_id DROP DEFAULT;
+
+ALTER TABLE task_events
+    ADD COLUMN workspace_id INTEGER NOT NULL DEFAULT 1
+        CONSTRAINT fk_task_events_workspace REFERENCES workspaces (id);
+ALTER TABLE task_events ALTER COLUMN workspace_id DROP DEFAULT;
+
+CREATE INDEX idx_tasks_workspace_id ON tasks (workspace_id, id);
+CREATE INDEX idx_task_events_workspace_id ON task_events (workspace_id, task_id, id);
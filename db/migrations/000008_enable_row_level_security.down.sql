@@ -0,0 +1,5 @@
+DROP POLICY IF EXISTS task_events_workspace_isolation ON task_events;
+ALTER TABLE task_events DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tasks_workspace_isolation ON tasks;
+ALTER TABLE tasks DISABLE ROW LEVEL SECURITY;
@@ -0,0 +1,12 @@
+-- Defense in depth for roles other than the table owner, such as reporting users: they only see
+-- the rows of the workspace set with SET app.workspace_id = '<id>'. The service connects as the
+-- owner, which bypasses these policies, and scopes its queries itself.
+ALTER TABLE tasks ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tasks_workspace_isolation ON tasks
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
+
+ALTER TABLE task_events ENABLE ROW LEVEL SECURITY;
+CREATE POLICY task_events_workspace_isolation ON task_events
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
@@ -0,0 +1,8 @@
+-- Users without a row get the default role of the service (DEFAULT_WORKSPACE_ROLE).
+CREATE TABLE workspace_members (
+    workspace_id INTEGER NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL,
+    role VARCHAR(16) NOT NULL CONSTRAINT chk_workspace_members_role CHECK (role IN ('viewer', 'member', 'admin')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (workspace_id, user_id)
+);
@@ -0,0 +1,17 @@
+CREATE TABLE api_keys (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    -- SHA-256 of the key, in hex; the key itself is only shown when it is created.
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    -- Space-separated scopes, such as 'tasks:read tasks:write'.
+    scopes VARCHAR(255) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NULL,
+    last_used_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL
+);
+
+CREATE INDEX idx_api_keys_workspace_id ON api_keys (workspace_id, created_by);
@@ -0,0 +1,6 @@
+-- Subtasks point to their parent; a parent keeps its subtasks when it is soft-deleted.
+ALTER TABLE tasks
+    ADD COLUMN parent_id INTEGER NULL
+        CONSTRAINT fk_tasks_parent REFERENCES tasks (id);
+
+CREATE INDEX idx_tasks_parent_id ON tasks (workspace_id, parent_id);
@@ -0,0 +1,20 @@
+-- blocker_id blocks blocked_id: the blocked task cannot start or be completed until the blocker is DONE.
+CREATE TABLE task_dependencies (
+    workspace_id INTEGER NOT NULL
+        CONSTRAINT fk_task_dependencies_workspace REFERENCES workspaces (id),
+    blocker_id INTEGER NOT NULL
+        CONSTRAINT fk_task_dependencies_blocker REFERENCES tasks (id),
+    blocked_id INTEGER NOT NULL
+        CONSTRAINT fk_task_dependencies_blocked REFERENCES tasks (id),
+    created_by VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CONSTRAINT chk_task_dependencies_self CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX idx_task_dependencies_blocked_id ON task_dependencies (workspace_id, blocked_id);
+
+ALTER TABLE task_dependencies ENABLE ROW LEVEL SECURITY;
+CREATE POLICY task_dependencies_workspace_isolation ON task_dependencies
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
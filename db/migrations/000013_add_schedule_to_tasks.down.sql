@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_tasks_priority;
+DROP INDEX IF EXISTS idx_tasks_due_at;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS estimate_minutes,
+    DROP COLUMN IF EXISTS priority,
+    DROP COLUMN IF EXISTS due_at;
+
+DROP TYPE IF EXISTS task_priority;
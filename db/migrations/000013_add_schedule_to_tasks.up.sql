@@ -0,0 +1,12 @@
+-- The enum order is the priority order, so tasks sort from LOW to URGENT.
+CREATE TYPE task_priority AS ENUM ('LOW', 'MEDIUM', 'HIGH', 'URGENT');
+
+ALTER TABLE tasks
+    ADD COLUMN due_at TIMESTAMP NULL,
+    ADD COLUMN priority task_priority NOT NULL DEFAULT 'MEDIUM',
+    ADD COLUMN estimate_minutes INTEGER NULL
+        CONSTRAINT chk_tasks_estimate_minutes CHECK (estimate_minutes >= 0);
+
+-- Tasks without a due date sort after the others.
+CREATE INDEX idx_tasks_due_at ON tasks (workspace_id, (COALESCE(due_at, 'infinity'::TIMESTAMP)), id);
+CREATE INDEX idx_tasks_priority ON tasks (workspace_id, priority, id);
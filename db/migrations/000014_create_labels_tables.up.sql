@@ -0,0 +1,38 @@
+CREATE TABLE labels (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL
+        CONSTRAINT fk_labels_workspace REFERENCES workspaces (id),
+    name VARCHAR(50) NOT NULL,
+    color VARCHAR(7) NOT NULL,
+    created_by VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- The target of the foreign key of task_labels, which keeps a label in the workspace of its tasks.
+    CONSTRAINT uq_labels_workspace_id UNIQUE (workspace_id, id)
+);
+
+CREATE UNIQUE INDEX idx_labels_workspace_name ON labels (workspace_id, LOWER(name));
+
+-- Deleting a label removes it from its tasks.
+CREATE TABLE task_labels (
+    workspace_id INTEGER NOT NULL,
+    task_id INTEGER NOT NULL
+        CONSTRAINT fk_task_labels_task REFERENCES tasks (id),
+    label_id INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (task_id, label_id),
+    CONSTRAINT fk_task_labels_label FOREIGN KEY (workspace_id, label_id)
+        REFERENCES labels (workspace_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_labels_label_id ON task_labels (workspace_id, label_id);
+
+ALTER TABLE labels ENABLE ROW LEVEL SECURITY;
+CREATE POLICY labels_workspace_isolation ON labels
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
+
+ALTER TABLE task_labels ENABLE ROW LEVEL SECURITY;
+CREATE POLICY task_labels_workspace_isolation ON task_labels
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
@@ -0,0 +1,21 @@
+CREATE TABLE comments (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL
+        CONSTRAINT fk_comments_workspace REFERENCES workspaces (id),
+    task_id INTEGER NOT NULL
+        CONSTRAINT fk_comments_task REFERENCES tasks (id),
+    author VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    edited_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL
+);
+
+-- Lists the comments of a task and counts them for the task list, leaving out deleted comments.
+CREATE INDEX idx_comments_task_id ON comments (workspace_id, task_id, id) WHERE deleted_at IS NULL;
+
+ALTER TABLE comments ENABLE ROW LEVEL SECURITY;
+CREATE POLICY comments_workspace_isolation ON comments
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
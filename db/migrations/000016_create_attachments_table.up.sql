@@ -0,0 +1,20 @@
+CREATE TABLE attachments (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL
+        CONSTRAINT fk_attachments_workspace REFERENCES workspaces (id),
+    task_id INTEGER NOT NULL
+        CONSTRAINT fk_attachments_task REFERENCES tasks (id),
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL CHECK (size >= 0),
+    sha256 CHAR(64) NOT NULL,
+    uploaded_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_attachments_task_id ON attachments (workspace_id, task_id, id);
+
+ALTER TABLE attachments ENABLE ROW LEVEL SECURITY;
+CREATE POLICY attachments_workspace_isolation ON attachments
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
@@ -0,0 +1,11 @@
+-- Statuses of custom workflows fall back to the default status of their category.
+UPDATE tasks SET status = CASE status_category WHEN 'done' THEN 'DONE' WHEN 'active' THEN 'IN_PROGRESS' ELSE 'TO_DO' END
+    WHERE status NOT IN ('TO_DO', 'IN_PROGRESS', 'DONE');
+ALTER TABLE tasks DROP COLUMN IF EXISTS status_category;
+
+CREATE TYPE task_status AS ENUM ('TO_DO', 'IN_PROGRESS', 'DONE');
+ALTER TABLE tasks ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE tasks ALTER COLUMN status TYPE task_status USING status::task_status;
+ALTER TABLE tasks ALTER COLUMN status SET DEFAULT 'TO_DO';
+
+DROP TABLE IF EXISTS workflows;
@@ -0,0 +1,32 @@
+CREATE TABLE workflows (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL
+        CONSTRAINT fk_workflows_workspace REFERENCES workspaces (id),
+    name VARCHAR(100) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT FALSE,
+    statuses JSONB NOT NULL,
+    transitions JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_workflows_workspace_name ON workflows (workspace_id, LOWER(name));
+-- A workspace has at most one active workflow; the built-in default applies when it has none.
+CREATE UNIQUE INDEX idx_workflows_active ON workflows (workspace_id) WHERE active;
+
+ALTER TABLE workflows ENABLE ROW LEVEL SECURITY;
+CREATE POLICY workflows_workspace_isolation ON workflows
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
+
+-- Statuses are now defined by the workflows, so the status column takes any name.
+ALTER TABLE tasks ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE tasks ALTER COLUMN status TYPE VARCHAR(50) USING status::TEXT;
+ALTER TABLE tasks ALTER COLUMN status SET DEFAULT 'TO_DO';
+DROP TYPE task_status;
+
+-- The category of the status is copied on the task, so blockers, progress and overdue tasks are
+-- found without reading the workflow.
+ALTER TABLE tasks ADD COLUMN status_category VARCHAR(10) NOT NULL DEFAULT 'todo'
+    CONSTRAINT chk_tasks_status_category CHECK (status_category IN ('todo', 'active', 'done'));
+UPDATE tasks SET status_category = CASE status WHEN 'DONE' THEN 'done' WHEN 'IN_PROGRESS' THEN 'active' ELSE 'todo' END;
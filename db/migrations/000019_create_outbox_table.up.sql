@@ -0,0 +1,27 @@
+-- The outbox holds the domain events written in the transactions of the changes they describe,
+-- until the relay has published them to every sink.
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL,
+    workspace_id INTEGER NOT NULL
+        CONSTRAINT fk_outbox_workspace REFERENCES workspaces (id),
+    type VARCHAR(32) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    -- The sinks that accepted the event, space-separated, so a retry only publishes it to the others.
+    published_sinks VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_outbox_event_id ON outbox (event_id);
+-- Finds the unpublished messages of every workspace, oldest first.
+CREATE INDEX idx_outbox_due ON outbox (next_attempt_at, id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_published_at ON outbox (published_at) WHERE published_at IS NOT NULL;
+
+-- The webhooks subscribe to the domain events, which the relay may publish more than once: a
+-- webhook gets one delivery per event. Redeliveries have no event so they are not deduplicated.
+ALTER TABLE webhook_deliveries ADD COLUMN event_id UUID;
+CREATE UNIQUE INDEX idx_webhook_deliveries_event ON webhook_deliveries (webhook_id, event_id);
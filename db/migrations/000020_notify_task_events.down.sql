@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_task_events_notify ON task_events;
+DROP FUNCTION IF EXISTS notify_task_event();
@@ -0,0 +1,17 @@
+-- Notify every instance of the recorded task events when their transaction commits, so each
+-- instance streams them to its subscribers. The payload only identifies the event, as
+-- notifications are limited to 8000 bytes.
+CREATE FUNCTION notify_task_event() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('task_events', json_build_object(
+        'id', NEW.id,
+        'workspace_id', NEW.workspace_id,
+        'task_id', NEW.task_id
+    )::TEXT);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_task_events_notify
+    AFTER INSERT ON task_events
+    FOR EACH ROW EXECUTE FUNCTION notify_task_event();
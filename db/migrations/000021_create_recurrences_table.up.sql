@@ -0,0 +1,42 @@
+-- Recurrences are the templates of the tasks created by the scheduler at every occurrence of
+-- their schedule.
+CREATE TABLE recurrences (
+    id SERIAL PRIMARY KEY,
+    workspace_id INTEGER NOT NULL
+        CONSTRAINT fk_recurrences_workspace REFERENCES workspaces (id),
+    title VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+    priority task_priority NOT NULL DEFAULT 'MEDIUM',
+    estimate_minutes INTEGER,
+    assignee_id VARCHAR(255),
+    due_after_minutes INTEGER,
+    schedule VARCHAR(255) NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP,
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    next_occurrence_at TIMESTAMP,
+    last_occurrence_at TIMESTAMP,
+    locked_until TIMESTAMP,
+    created_by VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_recurrences_workspace_id ON recurrences (workspace_id, id);
+-- Finds the due recurrences of every workspace.
+CREATE INDEX idx_recurrences_due ON recurrences (next_occurrence_at) WHERE NOT paused;
+
+ALTER TABLE recurrences ENABLE ROW LEVEL SECURITY;
+CREATE POLICY recurrences_workspace_isolation ON recurrences
+    USING (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER)
+    WITH CHECK (workspace_id = NULLIF(current_setting('app.workspace_id', TRUE), '')::INTEGER);
+
+-- The tasks of a recurrence keep their occurrence, which is unique so that an occurrence creates
+-- one task however many schedulers materialize it.
+ALTER TABLE tasks
+    ADD COLUMN recurrence_id INTEGER
+        CONSTRAINT fk_tasks_recurrence REFERENCES recurrences (id) ON DELETE SET NULL,
+    ADD COLUMN occurrence_at TIMESTAMP;
+
+CREATE UNIQUE INDEX idx_tasks_recurrence_occurrence ON tasks (recurrence_id, occurrence_at);
@@ -17,22 +17,32 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/v1/tasks": {
+        "/v1/api-keys": {
             "get": {
-                "description": "List all tasks",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "List your API keys in the workspace, or every key of the workspace for admins. Keys are never returned.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "api-keys"
+                ],
+                "summary": "List API keys",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
                 ],
-                "summary": "List all tasks",
                 "responses": {
                     "200": {
-                        "description": "Tasks listed successfully",
+                        "description": "API keys",
                         "schema": {
                             "allOf": [
                                 {
@@ -44,7 +54,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/entities.Task"
+                                                "$ref": "#/definitions/entities.ApiKey"
                                             }
                                         }
                                     }
@@ -52,16 +62,33 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "401": {
+                        "description": "Missing or invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Requested with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new task with the provided details",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create an API key acting on your behalf in the workspace, limited to its scopes.\nThe key is only returned in this response; store it, it cannot be read again.",
                 "consumes": [
                     "application/json"
                 ],
@@ -69,23 +96,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "api-keys"
                 ],
-                "summary": "Create a new task",
+                "summary": "Create an API key",
                 "parameters": [
                     {
-                        "description": "Task object",
-                        "name": "task",
+                        "description": "API key",
+                        "name": "key",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateTaskRequest"
+                            "$ref": "#/definitions/models.CreateApiKeyRequest"
                         }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Task created successfully",
+                        "description": "API key created",
                         "schema": {
                             "allOf": [
                                 {
@@ -95,7 +128,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Task"
+                                            "$ref": "#/definitions/models.CreatedApiKey"
                                         }
                                     }
                                 }
@@ -105,43 +138,132 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Requested with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     }
                 }
             }
         },
-        "/v1/tasks/{id}": {
-            "get": {
-                "description": "Get a task using its unique ID",
-                "consumes": [
-                    "application/json"
+        "/v1/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Revoke one of your API keys, or any key of the workspace for admins. Revoked keys stop working at once.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "api-keys"
                 ],
-                "summary": "Retrieve a task by ID",
+                "summary": "Revoke an API key",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
+                        "description": "API key ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "API key revoked"
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Requested with an API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "API key not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "API key already revoked",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/labels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the labels of the workspace, by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "labels"
+                ],
+                "summary": "List the labels",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task found successfully",
+                        "description": "Labels listed",
                         "schema": {
                             "allOf": [
                                 {
@@ -151,29 +273,46 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Task"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Label"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Invalid ID format",
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     },
-                    "404": {
-                        "description": "Task not found",
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Get a task using its unique ID",
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a label of the workspace; its name must be unique in the workspace, ignoring case",
                 "consumes": [
                     "application/json"
                 ],
@@ -181,30 +320,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "labels"
                 ],
-                "summary": "Retrieve a task by ID",
+                "summary": "Create a label",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Task object",
-                        "name": "task",
+                        "description": "Label",
+                        "name": "label",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateTaskRequest"
+                            "$ref": "#/definitions/models.LabelRequest"
                         }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Task found successfully",
+                    "201": {
+                        "description": "Label created",
                         "schema": {
                             "allOf": [
                                 {
@@ -214,7 +352,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/entities.Task"
+                                            "$ref": "#/definitions/entities.Label"
                                         }
                                     }
                                 }
@@ -222,21 +360,49 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid ID format",
+                        "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     },
-                    "404": {
-                        "description": "Task not found",
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "A label with this name exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Update a task by its unique ID",
+            }
+        },
+        "/v1/labels/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Rename or recolor a label; the tasks with the label show the change at once",
                 "consumes": [
                     "application/json"
                 ],
@@ -244,149 +410,5844 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "labels"
                 ],
-                "summary": "Update task details",
+                "summary": "Update a label",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
+                        "description": "Label ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Label",
+                        "name": "label",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LabelRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Task deleted successfully"
+                    "200": {
+                        "description": "Label updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Label"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
                     "400": {
                         "description": "Invalid input",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "A label with this name exists",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     }
                 }
-            }
-        },
-        "/v1/tasks/{id}/status": {
-            "patch": {
-                "description": "Update a task by its unique ID",
-                "consumes": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Delete a label and remove it from every task; only admins of the workspace can",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "labels"
                 ],
-                "summary": "Update task details",
+                "summary": "Delete a label",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
+                        "description": "Label ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Task details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateTaskStatusRequest"
-                        }
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Task updated successfully",
+                    "204": {
+                        "description": "Label deleted"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseSuccess"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     },
-                    "400": {
-                        "description": "Invalid input",
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/models.ResponseError"
+                            "$ref": "#/definitions/models.ProblemDetails"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "entities.Task": {
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "id": {
+        },
+        "/v1/recurrences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the recurrences of the workspace, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "List the recurrences",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recurrences listed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Recurrence"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a task template that the scheduler turns into a task at each occurrence of a cron expression or an RRULE,\nevaluated in the time zone of the recurrence. Occurrences missed while the scheduler was down are skipped but\nthe latest one. Only members of the workspace can",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "Create a recurrence",
+                "parameters": [
+                    {
+                        "description": "Recurrence",
+                        "name": "recurrence",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RecurrenceRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Recurrence created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Recurrence"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input, schedule or time zone",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recurrences/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a recurrence of the workspace with its next occurrence",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "Get a recurrence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recurrence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recurrence found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Recurrence"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Recurrence not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the template and the schedule of a recurrence; its next occurrence is the first one after now, and the\ntasks it already created are kept. Without starts_at, the recurrence keeps its start. Only its creator and\nadmins of the workspace can",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "Update a recurrence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recurrence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Recurrence",
+                        "name": "recurrence",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RecurrenceRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recurrence updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Recurrence"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input, schedule or time zone",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role, API key scope or creator not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Recurrence not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a recurrence; the tasks it created are kept. Only its creator and admins of the workspace can",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "Delete a recurrence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recurrence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Recurrence deleted"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role, API key scope or creator not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Recurrence not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recurrences/{id}/occurrences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the next occurrences of a recurrence after now, in its time zone, up to its end. A paused recurrence\nskips its occurrences until it resumes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "List the next occurrences of a recurrence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recurrence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of occurrences",
+                        "name": "count",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Occurrences listed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Occurrences"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Recurrence not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/recurrences:preview": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the next occurrences of a cron expression or an RRULE after now, in the time zone, without creating a\nrecurrence",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "Preview a schedule",
+                "parameters": [
+                    {
+                        "description": "Schedule",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PreviewRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Occurrences listed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.Occurrences"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input, schedule or time zone",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List tasks with optional status filter, title/description search, sorting and cursor pagination. Priorities sort from LOW to URGENT and tasks without a due date sort after the others.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List tasks",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "TO_DO",
+                                "IN_PROGRESS",
+                                "DONE"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Substring search on title and description",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only tasks created after this RFC 3339 time",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Only tasks last updated before this RFC 3339 time",
+                        "name": "updated_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the tasks created by this user, or \\",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the tasks assigned to this user, or \\",
+                        "name": "assignee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only the tasks that are not DONE and were due before now",
+                        "name": "overdue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only the tasks with these labels, by name ignoring case",
+                        "name": "label",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Whether a task needs any or all of the labels",
+                        "name": "label_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also list soft-deleted tasks; admin only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin key",
+                        "name": "X-Admin-Key",
+                        "in": "header"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "title",
+                            "status",
+                            "priority",
+                            "due_at",
+                            "created_at",
+                            "updated_at"
+                        ],
+                        "type": "string",
+                        "default": "id",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Sort direction",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tasks listed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponsePaginated"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Task"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or include_deleted without admin privileges",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new task with the provided details, as a subtask of parent_id when it is set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Create a new task",
+                "parameters": [
+                    {
+                        "description": "Task object",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateTaskRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Task"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or parent task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Full-text search on title and description, best match first. Every word of q must match a word\nprefix. Snippets wrap the matching words in \u003cmark\u003e tags and are not otherwise HTML-escaped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Search tasks",
+                "parameters": [
+                    {
+                        "maxLength": 200,
+                        "type": "string",
+                        "description": "Search words",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tasks found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponsePaginated"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.TaskSearchResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stream the changes of the tasks matching the filters as Server-Sent Events, named after the type of the change and with the task event ID as event ID. A change with matches false took its task out of the filters. A Reset event asks the client to list its tasks again, as it missed too many changes to replay them. Comments are sent as heartbeats. The stream ends when the client falls behind; it resumes with the Last-Event-ID header.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Stream task changes",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only the tasks with these labels, by name ignoring case",
+                        "name": "label",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Whether a task needs any or all of the labels",
+                        "name": "label_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Replay the changes after this task event ID",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Replay the changes after this task event ID, sent by EventSource on reconnection",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of task changes",
+                        "schema": {
+                            "$ref": "#/definitions/models.TaskChange"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/stream/ws": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stream the changes of the tasks matching the filters as WebSocket text messages, each a task change. A change with matches false took its task out of the filters. A Reset change asks the client to list its tasks again, as it missed too many changes to replay them. The server pings the client as heartbeats and closes the connection with code 1013 when the client falls behind; it resumes with last_event_id.",
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Stream task changes over WebSocket",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Only the tasks with these labels, by name ignoring case",
+                        "name": "label",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "any",
+                        "description": "Whether a task needs any or all of the labels",
+                        "name": "label_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Replay the changes after this task event ID",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching to the WebSocket protocol",
+                        "schema": {
+                            "$ref": "#/definitions/models.TaskChange"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query or WebSocket handshake",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a task using its unique ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Retrieve a task by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy of the task",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task found successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Task"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task, with a hash of its computed fields"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Task not modified"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the title, description and schedule of a task: an omitted due_at or estimate_minutes\nis cleared and an omitted priority becomes MEDIUM, like the fields of a new task.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Replace the details of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task object",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTaskRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the update is conditional on; only its version is compared",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task found successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.TaskUpdate"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner or the assignee of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Task is done and cannot be updated",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "412": {
+                        "description": "Task version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update a task by its unique ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Update task details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Task deleted successfully"
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/assignee": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Assign a task to a user, to the caller with \"me\", or to nobody with null. Only the owner, the assignee or an admin can.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Assign a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assignee",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTaskAssigneeRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the update is conditional on; only its version is compared",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task assigned successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseSuccess"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner or the assignee of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "412": {
+                        "description": "Task version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/attachments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the metadata of the files attached to a task, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "List the attachments of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachments listed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Attachment"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a file as the \"file\" field of a multipart form. The file is streamed to the\nstorage, its media type is detected from its content, and files with the same\ncontent are stored once.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Attach a file to a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Attachment created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Attachment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or empty file",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "413": {
+                        "description": "File larger than the size limit",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "415": {
+                        "description": "Media type not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/attachments/{attachment_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stream the content of an attachment. A Range header reads part of it, answered with\n206 Partial Content; the ETag is the SHA-256 of the content.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Download an attached file",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Attachment ID",
+                        "name": "attachment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Byte range, such as bytes=0-1023",
+                        "name": "Range",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Content of the file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "206": {
+                        "description": "Requested range of the file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "416": {
+                        "description": "Range not satisfiable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/children": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the direct subtasks of a task by ID, each with the progress of its own subtasks",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List the subtasks of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Subtasks listed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponsePaginated"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Task"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/comments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the comments of a task that are not deleted, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "List the comments of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comments listed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponsePaginated"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Comment"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a Markdown comment to a task the caller can read; the caller is its author",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Comment on a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CommentRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Comment created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Comment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/comments/{comment_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Soft delete a comment, which is no longer listed nor counted. Only its author or an admin can.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Delete a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Comment deleted"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the author of the comment",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the body of a comment and set its edited_at. Only its author or an admin can.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Edit a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CommentRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Comment"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the author of the comment",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/dependencies": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the dependencies a task is the blocker or the blocked task of",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List the dependencies of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dependencies listed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.TaskDependency"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Record that the task blocker_id blocks the task: it cannot move to IN_PROGRESS or DONE until the blocker is DONE. Only the owner, the assignee or an admin of the blocked task can, and a dependency that closes a cycle is refused.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Add a dependency",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blocked task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blocker",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AddTaskDependencyRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Dependency added successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.TaskDependency"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or blocker task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner or the assignee of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "The dependency exists or would create a cycle",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/dependencies/{blocker_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete the dependency of a task on the task blocker_id. Only the owner, the assignee or an admin of the blocked task can.",
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Remove a dependency",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blocked task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Blocker task ID",
+                        "name": "blocker_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Dependency removed successfully"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner or the assignee of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task or dependency not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List every create, update, status change and delete of a task in chronological order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List the history of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task history listed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponsePaginated"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.TaskEvent"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/labels": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Put a label of the workspace on the task. Only the owner, the assignee or an admin of the task can.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Add a label to a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AddTaskLabelRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Label added successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.TaskLabel"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or label not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner or the assignee of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "The task already has the label",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/labels/{label_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Take the label label_id off the task. Only the owner, the assignee or an admin of the task can.",
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Remove a label from a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Label ID",
+                        "name": "label_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Label removed successfully"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner or the assignee of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found or the task does not have the label",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/parent": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Make a task a subtask of another task, or a top-level task with null. Only the owner, the assignee or an admin can, and the new parent cannot be the task or one of its subtasks.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Move a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Parent",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTaskParentRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the update is conditional on; only its version is compared",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task moved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseSuccess"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or parent task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner or the assignee of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "The parent is the task or one of its subtasks",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "412": {
+                        "description": "Task version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Undo the soft delete of a task by its unique ID; requires X-Admin-Key or the admin role of the workspace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Restore a deleted task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin key",
+                        "name": "X-Admin-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task restored successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Task"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task, with a hash of its computed fields"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Admin privileges required",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Task is not deleted",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change the status of a task by its unique ID. A task cannot become DONE while its subtasks are open, unless force is true, nor change status while a task blocking it is open.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Update task details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTaskStatusRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the update is conditional on; only its version is compared",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/models.ResponseSuccess"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the updated task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed or not the owner or the assignee of the task",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Status transition not allowed; the body carries current_status and allowed_statuses, open_subtasks or blocked_by",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "412": {
+                        "description": "Task version does not match If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks/{id}/tree": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a task and its subtasks, nested in children, down to depth levels. Every task carries the progress of its subtasks.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Retrieve the tree of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 3,
+                        "description": "Number of subtask levels",
+                        "name": "depth",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task tree found successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/models.TaskTree"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query or tree too large for the depth",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tasks:batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create, update, change the status of or delete up to 100 tasks. Each operation is validated on its own\nand reported with its index, status code and error. With atomic set, either every operation is applied\nor none is, and the operations that did not fail report 424.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Apply a batch of task operations",
+                "parameters": [
+                    {
+                        "description": "Batch of operations",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.BatchTasksRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Every operation succeeded",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.BatchItemResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "At least one operation failed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.BatchItemResult"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid batch",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the webhooks of the workspace, oldest first. Only admins of the workspace can",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List the webhooks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhooks listed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Webhook"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Subscribe a URL to events of the tasks of the workspace. The secret is never returned. Only admins of the workspace can",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Webhook created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Webhook"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a webhook of the workspace. Only admins of the workspace can",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Webhook"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the URL, the secret and the events of a webhook; pending deliveries go to the new URL, signed with the new secret. Only admins of the workspace can",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Update a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Webhook updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Webhook"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a webhook with its deliveries; the pending deliveries are not sent. Only admins of the workspace can",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Webhook deleted"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the deliveries of a webhook, newest first, with their status, attempts and last response. Only admins of the workspace can",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List the deliveries of a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries listed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponsePaginated"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.WebhookDelivery"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/{id}/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Queue a new delivery of the payload of a delivery, whatever its status; the dispatcher sends it shortly. Only admins of the workspace can",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Redeliver a delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Delivery queued",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.WebhookDelivery"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Webhook or delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/workflows": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the workflows of the workspace, by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "List the workflows",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workflows listed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entities.Workflow"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a workflow of the workspace; an active workflow replaces the active one and every task must be in one of its statuses. Only admins of the workspace can",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Create a workflow",
+                "parameters": [
+                    {
+                        "description": "Workflow",
+                        "name": "workflow",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkflowRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Workflow created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Workflow"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "A workflow with this name exists, or tasks are in statuses the workflow does not have",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/workflows/active": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the workflow the tasks of the workspace follow: the active workflow, or the default workflow (id 0) when none is active",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Get the active workflow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workflow found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Workflow"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/workflows/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a workflow of the workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Get a workflow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workflow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workflow found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Workflow"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Workflow not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace a workflow; the tasks follow the change when it is or becomes active, and the default workflow applies when it stops being active. Only admins of the workspace can",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Update a workflow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workflow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Workflow",
+                        "name": "workflow",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.WorkflowRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workflow updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/models.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/entities.Workflow"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Workflow not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "A workflow with this name exists, or tasks are in statuses the workflow does not have",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a workflow; the default workflow applies when it was active, which requires every task to be in a default status. Only admins of the workspace can",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workflows"
+                ],
+                "summary": "Delete a workflow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workflow ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Workspace, by default the first workspace of the token",
+                        "name": "X-Workspace-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Workflow deleted"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid bearer token or API key",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "403": {
+                        "description": "Workspace role or API key scope not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Workflow not found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Tasks are in statuses the default workflow does not have",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entities.ApiKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "ExpiresAt is when the key stops working; keys without it never expire.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_used_at": {
+                    "description": "LastUsedAt is when the key last authenticated a request, to the minute.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "Prefix is the start of the key, shown to recognize it.",
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.Attachment": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "description": "ContentType is detected from the content, not taken from the client.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "description": "FileName is the name of the uploaded file, without its directories.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "sha256": {
+                    "description": "Sha256 is the hex SHA-256 of the content, which names its blob: files with the same content share it.",
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "integer"
+                },
+                "uploaded_by": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.Comment": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "Author is the actor who wrote the comment; only they and admins can edit or delete it.",
+                    "type": "string"
+                },
+                "body": {
+                    "description": "Body is the comment in Markdown, stored as written; clients render it.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "description": "EditedAt is set when the body is edited.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.DomainEventType": {
+            "type": "string",
+            "enum": [
+                "TaskCreated",
+                "TaskUpdated",
+                "TaskStatusChanged",
+                "TaskDeleted"
+            ],
+            "x-enum-varnames": [
+                "DomainEventTaskCreated",
+                "DomainEventTaskUpdated",
+                "DomainEventTaskStatusChanged",
+                "DomainEventTaskDeleted"
+            ]
+        },
+        "entities.Label": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "Color is a hex color, such as #d73a4a.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy is the user who created the label.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.Recurrence": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy is the user who created the recurrence, the owner of its tasks.",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_after_minutes": {
+                    "description": "DueAfterMinutes makes the tasks due that long after their occurrence; they have no due date when it is null.",
+                    "type": "integer"
+                },
+                "ends_at": {
+                    "description": "EndsAt, when set, is the last possible occurrence.",
+                    "type": "string"
+                },
+                "estimate_minutes": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_occurrence_at": {
+                    "description": "LastOccurrenceAt is the occurrence of the last task created.",
+                    "type": "string"
+                },
+                "next_occurrence_at": {
+                    "description": "NextOccurrenceAt is when the next task is created, null once the schedule is over.",
+                    "type": "string"
+                },
+                "paused": {
+                    "description": "Paused recurrences create no task; the occurrences missed while paused are skipped.",
+                    "type": "boolean"
+                },
+                "priority": {
+                    "$ref": "#/definitions/entities.TaskPriority"
+                },
+                "schedule": {
+                    "description": "Schedule is a 5-field cron expression or an RRULE.",
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=0"
+                },
+                "starts_at": {
+                    "description": "StartsAt is the first possible occurrence, and the DTSTART of an RRULE.",
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA time zone of the wall clock times of the schedule.",
+                    "type": "string",
+                    "example": "Asia/Bangkok"
+                },
+                "title": {
+                    "description": "Title, Description, Priority, EstimateMinutes and AssigneeId are copied to the tasks.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.StatusCategory": {
+            "type": "string",
+            "enum": [
+                "todo",
+                "active",
+                "done"
+            ],
+            "x-enum-varnames": [
+                "StatusCategoryTodo",
+                "StatusCategoryActive",
+                "StatusCategoryDone"
+            ]
+        },
+        "entities.Task": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "description": "AssigneeId is the user the task is assigned to.",
+                    "type": "string"
+                },
+                "blocked": {
+                    "description": "Blocked reports whether a task that is not done blocks the task.",
+                    "type": "boolean"
+                },
+                "comment_count": {
+                    "description": "CommentCount is the number of comments of the task that are not deleted.",
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "description": "CompletedAt is set when the task moves to a done status.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy is the user who created the task; tasks created anonymously have no owner.",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt is only set on soft-deleted tasks, which are listed with include_deleted.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "description": "DueAt is when the task is due; open tasks due before now are overdue.",
+                    "type": "string"
+                },
+                "estimate_minutes": {
+                    "description": "EstimateMinutes is the estimated effort of the task.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "labels": {
+                    "description": "Labels are the labels of the task, sorted by name.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Label"
+                    }
+                },
+                "occurrence_at": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "ParentId is the task this task is a subtask of.",
+                    "type": "integer"
+                },
+                "priority": {
+                    "$ref": "#/definitions/entities.TaskPriority"
+                },
+                "progress": {
+                    "description": "Progress is the roll-up of the subtasks of the task; it is only set when the task has subtasks.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskProgress"
+                        }
+                    ]
+                },
+                "recurrence_id": {
+                    "description": "RecurrenceId is the recurrence that created the task at OccurrenceAt.",
+                    "type": "integer"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskStatus"
+                        }
+                    ],
+                    "example": "TO_DO"
+                },
+                "status_category": {
+                    "description": "StatusCategory is the category of the status in the workflow of the workspace.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.StatusCategory"
+                        }
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.TaskDependency": {
+            "type": "object",
+            "properties": {
+                "blocked_id": {
+                    "type": "integer"
+                },
+                "blocker_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy is the user who added the dependency.",
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.TaskEvent": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/entities.TaskEventAction"
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "changes": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.TaskEventAction": {
+            "type": "string",
+            "enum": [
+                "created",
+                "updated",
+                "status_changed",
+                "deleted",
+                "restored",
+                "assigned",
+                "moved",
+                "dependency_added",
+                "dependency_removed",
+                "label_added",
+                "label_removed"
+            ],
+            "x-enum-varnames": [
+                "TaskEventCreated",
+                "TaskEventUpdated",
+                "TaskEventStatusChanged",
+                "TaskEventDeleted",
+                "TaskEventRestored",
+                "TaskEventAssigned",
+                "TaskEventMoved",
+                "TaskEventDependencyAdded",
+                "TaskEventDependencyRemoved",
+                "TaskEventLabelAdded",
+                "TaskEventLabelRemoved"
+            ]
+        },
+        "entities.TaskLabel": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "label_id": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.TaskPriority": {
+            "type": "string",
+            "enum": [
+                "LOW",
+                "MEDIUM",
+                "HIGH",
+                "URGENT"
+            ],
+            "x-enum-varnames": [
+                "TaskPriorityLow",
+                "TaskPriorityMedium",
+                "TaskPriorityHigh",
+                "TaskPriorityUrgent"
+            ]
+        },
+        "entities.TaskProgress": {
+            "type": "object",
+            "properties": {
+                "done": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "percent": {
+                    "description": "Percent is the percentage of the subtasks that are done, rounded down.",
+                    "type": "integer",
+                    "example": 25
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "entities.TaskStatus": {
+            "type": "string",
+            "enum": [
+                "TO_DO",
+                "IN_PROGRESS",
+                "DONE"
+            ],
+            "x-enum-varnames": [
+                "TaskStatusToDo",
+                "TaskStatusInProgress",
+                "TaskStatusDone"
+            ]
+        },
+        "entities.TaskUpdate": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "description": "DueAt is when the task is due; open tasks due before now are overdue.",
+                    "type": "string"
+                },
+                "estimate_minutes": {
+                    "description": "EstimateMinutes is the estimated effort of the task.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "priority": {
+                    "$ref": "#/definitions/entities.TaskPriority"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.TaskStatus"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the task after the update.",
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.Webhook": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "task.created",
+                        "task.status_changed"
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "workspace_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "entities.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/entities.WebhookEvent"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "description": "LastError tells why the last attempt failed.",
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "description": "NextAttemptAt is when a pending delivery is sent next.",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "Payload is the body of the requests, a WebhookPayload.",
+                    "type": "object"
+                },
+                "response_status": {
+                    "description": "ResponseStatus is the HTTP status of the last attempt, null when it got no response.",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.WebhookDeliveryStatus"
+                },
+                "task_event_id": {
+                    "description": "TaskEventId is the entry of the history of the task the delivery notifies of.",
+                    "type": "integer"
+                },
+                "webhook_id": {
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliverySucceeded",
+                "WebhookDeliveryFailed"
+            ]
+        },
+        "entities.WebhookEvent": {
+            "type": "string",
+            "enum": [
+                "task.created",
+                "task.updated",
+                "task.status_changed",
+                "task.deleted"
+            ],
+            "x-enum-varnames": [
+                "WebhookEventTaskCreated",
+                "WebhookEventTaskUpdated",
+                "WebhookEventTaskStatusChanged",
+                "WebhookEventTaskDeleted"
+            ]
+        },
+        "entities.Workflow": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active makes the workflow the one of the tasks of the workspace; at most one workflow is active.",
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "statuses": {
+                    "description": "Statuses are the statuses of the tasks; new tasks take the first one, which is a todo status.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.WorkflowStatus"
+                    }
+                },
+                "transitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.WorkflowTransition"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entities.WorkflowStatus": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.StatusCategory"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "name": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_REVIEW"
+                },
+                "read_only": {
+                    "description": "ReadOnly forbids changing the details of the tasks in this status.",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "entities.WorkflowTransition": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                },
+                "role": {
+                    "description": "Role is the workspace role required to make the transition; members can make it when empty.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.WorkspaceRole"
+                        }
+                    ],
+                    "example": "admin"
+                },
+                "to": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_REVIEW"
+                }
+            }
+        },
+        "entities.WorkspaceRole": {
+            "type": "string",
+            "enum": [
+                "viewer",
+                "member",
+                "admin"
+            ],
+            "x-enum-varnames": [
+                "WorkspaceRoleViewer",
+                "WorkspaceRoleMember",
+                "WorkspaceRoleAdmin"
+            ]
+        },
+        "models.AddTaskDependencyRequest": {
+            "type": "object",
+            "required": [
+                "blocker_id"
+            ],
+            "properties": {
+                "blocker_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12
+                }
+            }
+        },
+        "models.AddTaskLabelRequest": {
+            "type": "object",
+            "required": [
+                "label_id"
+            ],
+            "properties": {
+                "label_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 3
+                }
+            }
+        },
+        "models.BatchItemResult": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "$ref": "#/definitions/models.ProblemDetails"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 201
+                }
+            }
+        },
+        "models.BatchOperation": {
+            "type": "object",
+            "required": [
+                "op"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "When 'later' turns into 'never', it's just your code's way of saying it loves the TODO comments."
+                },
+                "due_at": {
+                    "type": "string",
+                    "example": "2026-11-30T17:00:00Z"
+                },
+                "estimate_minutes": {
+                    "description": "EstimateMinutes is at most a year.",
+                    "type": "integer",
+                    "maximum": 525600,
+                    "minimum": 0,
+                    "example": 90
+                },
+                "force": {
+                    "description": "Force completes a task whose subtasks are not all DONE in a status operation.",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "status",
+                        "delete"
+                    ],
+                    "example": "create"
+                },
+                "parent_id": {
+                    "description": "ParentId makes the task of a create operation a subtask of another task.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "priority": {
+                    "description": "Priority is MEDIUM when omitted.",
+                    "enum": [
+                        "LOW",
+                        "MEDIUM",
+                        "HIGH",
+                        "URGENT"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "IN_PROGRESS"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Later is never"
+                },
+                "version": {
+                    "description": "Version makes an update or status operation conditional, like If-Match.",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.BatchTasksRequest": {
+            "type": "object",
+            "required": [
+                "operations"
+            ],
+            "properties": {
+                "atomic": {
+                    "description": "Atomic applies all operations in one transaction, or none of them if one fails.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "operations": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.BatchOperation"
+                    }
+                }
+            }
+        },
+        "models.CommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Body is Markdown.",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "minLength": 1,
+                    "example": "Reproduced on **staging**, see the logs."
+                }
+            }
+        },
+        "models.CreateApiKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "ExpiresAt is when the key stops working; the key never expires when it is omitted.",
+                    "type": "string",
+                    "example": "2030-01-01T00:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "CI bot"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "tasks:read",
+                        "tasks:write"
+                    ]
+                }
+            }
+        },
+        "models.CreateTaskRequest": {
+            "type": "object",
+            "required": [
+                "description",
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 25500,
+                    "minLength": 3,
+                    "example": "When 'later' turns into 'never', it's just your code's way of saying it loves the TODO comments."
+                },
+                "due_at": {
+                    "type": "string",
+                    "example": "2026-11-30T17:00:00Z"
+                },
+                "estimate_minutes": {
+                    "description": "EstimateMinutes is at most a year.",
+                    "type": "integer",
+                    "maximum": 525600,
+                    "minimum": 0,
+                    "example": 90
+                },
+                "parent_id": {
+                    "description": "ParentId makes the new task a subtask of another task.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "priority": {
+                    "description": "Priority is MEDIUM when omitted.",
+                    "enum": [
+                        "LOW",
+                        "MEDIUM",
+                        "HIGH",
+                        "URGENT"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3,
+                    "example": "Later is never"
+                }
+            }
+        },
+        "models.CreatedApiKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "ExpiresAt is when the key stops working; keys without it never expire.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "tsk_3q2-7wEXAMPLEb0Jx9f_mHwV5o0i3Q9wSQ2kR4lYzA"
+                },
+                "last_used_at": {
+                    "description": "LastUsedAt is when the key last authenticated a request, to the minute.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "Prefix is the start of the key, shown to recognize it.",
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LabelRequest": {
+            "type": "object",
+            "required": [
+                "color",
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "description": "Color is a hex color of six digits.",
+                    "type": "string",
+                    "example": "#d73a4a"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "example": "bug"
+                }
+            }
+        },
+        "models.Occurrences": {
+            "type": "object",
+            "properties": {
+                "occurrences": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2026-11-02T09:00:00+07:00",
+                        "2026-11-03T09:00:00+07:00"
+                    ]
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Bangkok"
+                }
+            }
+        },
+        "models.PreviewRequest": {
+            "type": "object",
+            "required": [
+                "schedule",
+                "timezone"
+            ],
+            "properties": {
+                "count": {
+                    "description": "Count is the number of occurrences, 10 by default.",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "ends_at": {
+                    "description": "EndsAt, when set, is the last possible occurrence.",
+                    "type": "string"
+                },
+                "schedule": {
+                    "description": "Schedule is a 5-field cron expression, with the @daily, @weekly, @monthly, @yearly and @hourly\nmacros, or an RRULE with a FREQ of DAILY, WEEKLY, MONTHLY or YEARLY.",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "0 9 * * MON-FRI"
+                },
+                "starts_at": {
+                    "description": "StartsAt is the first possible occurrence and the DTSTART of an RRULE; now when omitted.",
+                    "type": "string",
+                    "example": "2026-11-02T00:00:00+07:00"
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA time zone of the wall clock times of the schedule.",
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "Asia/Bangkok"
+                }
+            }
+        },
+        "models.ProblemDetails": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "task not found"
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/v1/tasks/1"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "task-services-1729212000000000000"
                 },
                 "status": {
-                    "$ref": "#/definitions/entities.TaskStatus"
+                    "type": "integer",
+                    "example": 404
                 },
                 "title": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
                 }
             }
         },
-        "entities.TaskStatus": {
-            "type": "string",
-            "enum": [
-                "TO_DO",
-                "IN_PROGRESS",
-                "DONE"
-            ],
-            "x-enum-varnames": [
-                "TaskStatusToDo",
-                "TaskStatusInProgress",
-                "TaskStatusDone"
-            ]
-        },
-        "models.CreateTaskRequest": {
+        "models.RecurrenceRequest": {
             "type": "object",
             "required": [
                 "description",
+                "schedule",
+                "timezone",
                 "title"
             ],
             "properties": {
+                "assignee_id": {
+                    "description": "AssigneeId is the user the tasks are assigned to, or \"me\".",
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "me"
+                },
                 "description": {
                     "type": "string",
                     "maxLength": 25500,
                     "minLength": 3,
-                    "example": "When 'later' turns into 'never', it's just your code's way of saying it loves the TODO comments."
+                    "example": "Send the weekly report to the team."
+                },
+                "due_after_minutes": {
+                    "description": "DueAfterMinutes makes the tasks due that long after their occurrence.",
+                    "type": "integer",
+                    "maximum": 525600,
+                    "minimum": 0,
+                    "example": 480
+                },
+                "ends_at": {
+                    "description": "EndsAt, when set, is the last possible occurrence.",
+                    "type": "string"
+                },
+                "estimate_minutes": {
+                    "type": "integer",
+                    "maximum": 525600,
+                    "minimum": 0,
+                    "example": 30
+                },
+                "paused": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "priority": {
+                    "description": "Priority is MEDIUM when omitted.",
+                    "enum": [
+                        "LOW",
+                        "MEDIUM",
+                        "HIGH",
+                        "URGENT"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "schedule": {
+                    "description": "Schedule is a 5-field cron expression, with the @daily, @weekly, @monthly, @yearly and @hourly\nmacros, or an RRULE with a FREQ of DAILY, WEEKLY, MONTHLY or YEARLY.",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "0 9 * * MON-FRI"
+                },
+                "starts_at": {
+                    "description": "StartsAt is the first possible occurrence and the DTSTART of an RRULE; now when omitted.",
+                    "type": "string",
+                    "example": "2026-11-02T00:00:00+07:00"
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA time zone of the wall clock times of the schedule.",
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "Asia/Bangkok"
                 },
                 "title": {
                     "type": "string",
                     "maxLength": 100,
                     "minLength": 3,
-                    "example": "Later is never"
+                    "example": "Weekly report"
                 }
             }
         },
-        "models.ResponseError": {
+        "models.ResponsePaginated": {
             "type": "object",
             "properties": {
+                "data": {},
                 "message": {
                     "type": "string"
                 },
+                "next_cursor": {
+                    "type": "string"
+                },
                 "status": {
                     "type": "string"
                 }
@@ -404,6 +6265,282 @@ const docTemplate = `{
                 }
             }
         },
+        "models.TaskChange": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/entities.TaskEvent"
+                },
+                "id": {
+                    "description": "Id is the ID of the task event, the ID to resume the stream after.",
+                    "type": "integer",
+                    "example": 42
+                },
+                "matches": {
+                    "description": "Matches is false when the change took the task out of the filters of the stream.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "task": {
+                    "$ref": "#/definitions/entities.Task"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.DomainEventType"
+                        }
+                    ],
+                    "example": "TaskStatusChanged"
+                }
+            }
+        },
+        "models.TaskSearchResult": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "description": "AssigneeId is the user the task is assigned to.",
+                    "type": "string"
+                },
+                "blocked": {
+                    "description": "Blocked reports whether a task that is not done blocks the task.",
+                    "type": "boolean"
+                },
+                "comment_count": {
+                    "description": "CommentCount is the number of comments of the task that are not deleted.",
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "description": "CompletedAt is set when the task moves to a done status.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy is the user who created the task; tasks created anonymously have no owner.",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt is only set on soft-deleted tasks, which are listed with include_deleted.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "description_snippet": {
+                    "type": "string",
+                    "example": "When '\u003cmark\u003elater\u003c/mark\u003e' turns into 'never'"
+                },
+                "due_at": {
+                    "description": "DueAt is when the task is due; open tasks due before now are overdue.",
+                    "type": "string"
+                },
+                "estimate_minutes": {
+                    "description": "EstimateMinutes is the estimated effort of the task.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "labels": {
+                    "description": "Labels are the labels of the task, sorted by name.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Label"
+                    }
+                },
+                "occurrence_at": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "ParentId is the task this task is a subtask of.",
+                    "type": "integer"
+                },
+                "priority": {
+                    "$ref": "#/definitions/entities.TaskPriority"
+                },
+                "progress": {
+                    "description": "Progress is the roll-up of the subtasks of the task; it is only set when the task has subtasks.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskProgress"
+                        }
+                    ]
+                },
+                "rank": {
+                    "type": "number",
+                    "example": 0.6
+                },
+                "recurrence_id": {
+                    "description": "RecurrenceId is the recurrence that created the task at OccurrenceAt.",
+                    "type": "integer"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskStatus"
+                        }
+                    ],
+                    "example": "TO_DO"
+                },
+                "status_category": {
+                    "description": "StatusCategory is the category of the status in the workflow of the workspace.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.StatusCategory"
+                        }
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                },
+                "title_snippet": {
+                    "type": "string",
+                    "example": "\u003cmark\u003eLater\u003c/mark\u003e is never"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.TaskTree": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "description": "AssigneeId is the user the task is assigned to.",
+                    "type": "string"
+                },
+                "blocked": {
+                    "description": "Blocked reports whether a task that is not done blocks the task.",
+                    "type": "boolean"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TaskTree"
+                    }
+                },
+                "comment_count": {
+                    "description": "CommentCount is the number of comments of the task that are not deleted.",
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "description": "CompletedAt is set when the task moves to a done status.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "description": "CreatedBy is the user who created the task; tasks created anonymously have no owner.",
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "description": "DeletedAt is only set on soft-deleted tasks, which are listed with include_deleted.",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "description": "DueAt is when the task is due; open tasks due before now are overdue.",
+                    "type": "string"
+                },
+                "estimate_minutes": {
+                    "description": "EstimateMinutes is the estimated effort of the task.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "labels": {
+                    "description": "Labels are the labels of the task, sorted by name.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.Label"
+                    }
+                },
+                "occurrence_at": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "ParentId is the task this task is a subtask of.",
+                    "type": "integer"
+                },
+                "priority": {
+                    "$ref": "#/definitions/entities.TaskPriority"
+                },
+                "progress": {
+                    "description": "Progress is the roll-up of the subtasks of the task; it is only set when the task has subtasks.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskProgress"
+                        }
+                    ]
+                },
+                "recurrence_id": {
+                    "description": "RecurrenceId is the recurrence that created the task at OccurrenceAt.",
+                    "type": "integer"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskStatus"
+                        }
+                    ],
+                    "example": "TO_DO"
+                },
+                "status_category": {
+                    "description": "StatusCategory is the category of the status in the workflow of the workspace.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.StatusCategory"
+                        }
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "workspace_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.UpdateTaskAssigneeRequest": {
+            "type": "object",
+            "properties": {
+                "assignee_id": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "me"
+                }
+            }
+        },
+        "models.UpdateTaskParentRequest": {
+            "type": "object",
+            "properties": {
+                "parent_id": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
         "models.UpdateTaskRequest": {
             "type": "object",
             "required": [
@@ -417,6 +6554,32 @@ const docTemplate = `{
                     "minLength": 3,
                     "example": "Coding without coffee is like debugging without a console log."
                 },
+                "due_at": {
+                    "type": "string",
+                    "example": "2026-11-30T17:00:00Z"
+                },
+                "estimate_minutes": {
+                    "description": "EstimateMinutes is at most a year.",
+                    "type": "integer",
+                    "maximum": 525600,
+                    "minimum": 0,
+                    "example": 90
+                },
+                "priority": {
+                    "description": "Priority is MEDIUM when omitted.",
+                    "enum": [
+                        "LOW",
+                        "MEDIUM",
+                        "HIGH",
+                        "URGENT"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
                 "title": {
                     "type": "string",
                     "maxLength": 100,
@@ -431,14 +6594,155 @@ const docTemplate = `{
                 "status"
             ],
             "properties": {
+                "force": {
+                    "description": "Force completes a task whose subtasks are not all done.",
+                    "type": "boolean",
+                    "example": false
+                },
                 "status": {
+                    "description": "Status is a status of the workflow of the workspace that the current status can move to.",
                     "type": "string",
-                    "enum": [
-                        "IN_PROGRESS",
-                        "DONE"
+                    "example": "IN_PROGRESS"
+                }
+            }
+        },
+        "models.WebhookRequest": {
+            "type": "object",
+            "required": [
+                "events",
+                "secret",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "maxItems": 4,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "task.created",
+                        "task.status_changed"
                     ]
+                },
+                "secret": {
+                    "description": "Secret keys the HMAC-SHA256 signature of the requests, sent in X-Webhook-Signature.",
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 16,
+                    "example": "8f14e45fceea167a5a36dedd4bea2543"
+                },
+                "url": {
+                    "description": "Url receives the events as POST requests; it must be an http or https URL.",
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://ci.example.com/hooks/tasks"
+                }
+            }
+        },
+        "models.WorkflowRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "statuses"
+            ],
+            "properties": {
+                "active": {
+                    "description": "Active makes the workflow the one of the tasks of the workspace, in place of the active workflow.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "Code review"
+                },
+                "statuses": {
+                    "description": "Statuses are the statuses of the tasks; new tasks take the first one, which must be a todo status.",
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.WorkflowStatusRequest"
+                    }
+                },
+                "transitions": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "items": {
+                        "$ref": "#/definitions/models.WorkflowTransitionRequest"
+                    }
+                }
+            }
+        },
+        "models.WorkflowStatusRequest": {
+            "type": "object",
+            "required": [
+                "category",
+                "name"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "active",
+                        "done"
+                    ],
+                    "example": "active"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "IN_REVIEW"
+                },
+                "read_only": {
+                    "description": "ReadOnly forbids changing the details of the tasks in this status.",
+                    "type": "boolean",
+                    "example": false
                 }
             }
+        },
+        "models.WorkflowTransitionRequest": {
+            "type": "object",
+            "required": [
+                "from",
+                "to"
+            ],
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "IN_PROGRESS"
+                },
+                "role": {
+                    "description": "Role is the workspace role required to make the transition; members can make it when omitted.",
+                    "type": "string",
+                    "enum": [
+                        "member",
+                        "admin"
+                    ],
+                    "example": "admin"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "IN_REVIEW"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "API key, as \"ApiKey \u003ckey\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "JWT bearer token, as \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware requires a valid bearer token on every route except publicRoutes, which are
+// matched against the route path such as "/swagger/*", and the requests a previous middleware
+// already authenticated, such as with an API key.
+// The principal of the token is set in the Echo context and in the request context.
+func Middleware(verifier *Verifier, publicRoutes ...string) echo.MiddlewareFunc {
+	public := make(map[string]bool, len(publicRoutes))
+	for _, route := range publicRoutes {
+		public[route] = true
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if public[c.Path()] || PrincipalFrom(c) != nil {
+				return next(c)
+			}
+			token, ok := bearerToken(c.Request().Header.Get(echo.HeaderAuthorization))
+			if !ok {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return errMissingToken
+			}
+			principal, err := verifier.Verify(token)
+			if err != nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+				return err
+			}
+			c.Set(ContextKeyPrincipal, principal)
+			c.SetRequest(c.Request().WithContext(ContextWithPrincipal(c.Request().Context(), principal)))
+			return next(c)
+		}
+	}
+}
+
+// bearerToken extracts the token of an "Authorization: Bearer <token>" header.
+func bearerToken(header string) (string, bool) {
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	token := strings.TrimSpace(header[len(prefix):])
+	return token, token != ""
+}
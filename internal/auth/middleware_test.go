@@ -0,0 +1,95 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/configs"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+)
+
+func TestMiddleware(t *testing.T) {
+	verifier, err := auth.NewVerifier(&configs.AuthConfig{HS256Secret: testSecret})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name                string
+		path                string
+		authorization       string
+		expectedSubject     string
+		expectedKind        domainerrors.Kind
+		expectedChallenge   string
+		expectedHandlerCall bool
+	}{
+		{"ValidToken", "/v1/tasks", "Bearer " + signHS256(t, validClaims(), testSecret), "alice", "", "", true},
+		{"LowercaseScheme", "/v1/tasks", "bearer " + signHS256(t, validClaims(), testSecret), "alice", "", "", true},
+		{"MissingHeader", "/v1/tasks", "", "", domainerrors.KindUnauthorized, "Bearer", false},
+		{"BasicScheme", "/v1/tasks", "Basic YWxpY2U6c2VjcmV0", "", domainerrors.KindUnauthorized, "Bearer", false},
+		{"InvalidToken", "/v1/tasks", "Bearer " + signHS256(t, validClaims(), "guess"), "", domainerrors.KindUnauthorized, `Bearer error="invalid_token"`, false},
+		{"PublicRoute", "/healthz", "", "", "", "", true},
+		{"PublicRouteIgnoresToken", "/healthz", "Bearer invalid", "", "", "", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if tc.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath(tc.path)
+
+			var called bool
+			var fromEcho, fromContext *auth.Principal
+			err := auth.Middleware(verifier, "/swagger/*", "/healthz")(func(c echo.Context) error {
+				called = true
+				fromEcho = auth.PrincipalFrom(c)
+				fromContext = auth.PrincipalFromContext(c.Request().Context())
+				return nil
+			})(c)
+
+			assert.Equal(t, tc.expectedHandlerCall, called)
+			assert.Equal(t, tc.expectedChallenge, rec.Header().Get(echo.HeaderWWWAuthenticate))
+			if tc.expectedKind != "" {
+				assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expectedSubject == "" {
+				assert.Nil(t, fromEcho)
+				return
+			}
+			assert.Equal(t, tc.expectedSubject, fromEcho.Subject)
+			assert.Same(t, fromEcho, fromContext)
+		})
+	}
+}
+
+func TestMiddleware_AlreadyAuthenticated(t *testing.T) {
+	verifier, err := auth.NewVerifier(&configs.AuthConfig{HS256Secret: testSecret})
+	require.NoError(t, err)
+
+	// A request authenticated with an API key carries no bearer token.
+	req := httptest.NewRequest(http.MethodGet, "/v1/tasks", nil)
+	req.Header.Set(echo.HeaderAuthorization, "ApiKey tsk_key")
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.SetPath("/v1/tasks")
+	bot := &auth.Principal{Subject: "alice", ApiKeyId: 1}
+	c.Set(auth.ContextKeyPrincipal, bot)
+
+	var principal *auth.Principal
+	err = auth.Middleware(verifier)(func(c echo.Context) error {
+		principal = auth.PrincipalFrom(c)
+		return nil
+	})(c)
+
+	assert.NoError(t, err)
+	assert.Same(t, bot, principal)
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+const (
+	// ContextKeyPrincipal holds the *Principal of an authenticated request in the Echo context.
+	ContextKeyPrincipal = "principal"
+	// RoleAdmin grants the admin privileges of the X-Admin-Key header.
+	RoleAdmin = "admin"
+)
+
+// Principal is the caller authenticated by a bearer token or an API key.
+type Principal struct {
+	// Subject is the sub claim of the token.
+	Subject string
+	// Roles is the roles claim of the token.
+	Roles []string
+	// Workspaces is the workspaces claim of the token, the IDs of the workspaces the subject is a member of.
+	// The first one is used when the request names no workspace.
+	Workspaces []uint
+	// Scopes limits what an API key can do; it is nil for bearer tokens, which can do anything.
+	Scopes entities.ApiKeyScopes
+	// ApiKeyId is the API key of the principal, zero for bearer tokens.
+	ApiKeyId uint
+}
+
+// HasRole reports whether the principal has the given role.
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasScope reports whether the principal was granted scope; bearer tokens have every scope.
+func (p *Principal) HasScope(scope entities.ApiKeyScope) bool {
+	return p.Scopes == nil || p.Scopes.Contains(scope)
+}
+
+type contextKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying the principal.
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal carried by ctx, or nil.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(contextKey{}).(*Principal)
+	return principal
+}
+
+// PrincipalFrom returns the principal of an authenticated request, or nil.
+func PrincipalFrom(c echo.Context) *Principal {
+	principal, _ := c.Get(ContextKeyPrincipal).(*Principal)
+	return principal
+}
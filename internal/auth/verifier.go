@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/supachai1998/task_services/internal/configs"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+)
+
+// leeway absorbs the clock skew between the token issuer and the service.
+const leeway = 30 * time.Second
+
+var (
+	errMissingToken = domainerrors.Unauthorized("missing bearer token")
+	errInvalidToken = domainerrors.Unauthorized("invalid bearer token")
+)
+
+type claims struct {
+	jwt.RegisteredClaims
+	Roles      []string `json:"roles"`
+	Workspaces []uint   `json:"workspaces"`
+}
+
+// Verifier verifies HS256 and RS256 bearer tokens.
+type Verifier struct {
+	secret []byte
+	// keys maps the kid of RSA public keys to the key; a PEM key has an empty kid.
+	keys   map[string]*rsa.PublicKey
+	parser *jwt.Parser
+}
+
+// NewVerifier loads the keys of config.
+// At least one of the HS256 secret, the RS256 public key file and the JWKS file must be set.
+func NewVerifier(config *configs.AuthConfig) (*Verifier, error) {
+	v := &Verifier{keys: map[string]*rsa.PublicKey{}}
+	var methods []string
+	if config.HS256Secret != "" {
+		v.secret = []byte(config.HS256Secret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if config.RS256PublicKeyFile != "" {
+		key, err := loadPublicKeyFile(config.RS256PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		v.keys[""] = key
+	}
+	if config.JWKSFile != "" {
+		keys, err := loadJWKSFile(config.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		for kid, key := range keys {
+			v.keys[kid] = key
+		}
+	}
+	if len(v.keys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	if len(methods) == 0 {
+		return nil, errors.New("auth: no JWT key configured")
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+	v.parser = jwt.NewParser(options...)
+	return v, nil
+}
+
+// Verify checks the signature and the claims of a token and returns its principal.
+func (v *Verifier) Verify(token string) (*Principal, error) {
+	var c claims
+	if _, err := v.parser.ParseWithClaims(token, &c, v.key); err != nil {
+		return nil, errInvalidToken.WithCause(err)
+	}
+	if c.Subject == "" {
+		return nil, errInvalidToken.WithCause(errors.New("token has no subject"))
+	}
+	return &Principal{Subject: c.Subject, Roles: c.Roles, Workspaces: c.Workspaces}, nil
+}
+
+// key returns the key verifying the signature of token.
+func (v *Verifier) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return v.secret, nil
+	case *jwt.SigningMethodRSA:
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := v.keys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(v.keys) == 1 {
+			for _, key := range v.keys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
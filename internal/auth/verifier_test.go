@@ -0,0 +1,177 @@
+package auth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/configs"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+)
+
+const testSecret = "test-secret"
+
+type testClaims struct {
+	jwt.RegisteredClaims
+	Roles      []string `json:"roles,omitempty"`
+	Workspaces []uint   `json:"workspaces,omitempty"`
+}
+
+func validClaims() testClaims {
+	return testClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "alice",
+			Issuer:    "https://issuer.test",
+			Audience:  jwt.ClaimStrings{"task-services"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles:      []string{auth.RoleAdmin},
+		Workspaces: []uint{2, 3},
+	}
+}
+
+func signHS256(t *testing.T, claims testClaims, secret string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func signRS256(t *testing.T, claims testClaims, key *rsa.PrivateKey, kid string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func writePublicKeyPEM(t *testing.T, key *rsa.PrivateKey) string {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "public.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func writeJWKS(t *testing.T, keys map[string]*rsa.PrivateKey) string {
+	var set struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for kid, key := range keys {
+		set.Keys = append(set.Keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	rsaKey := generateKey(t)
+	otherKey := generateKey(t)
+
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExpiry := validClaims()
+	noExpiry.ExpiresAt = nil
+	wrongAudience := validClaims()
+	wrongAudience.Audience = jwt.ClaimStrings{"someone-else"}
+	noSubject := validClaims()
+	noSubject.Subject = ""
+
+	hs256 := &configs.AuthConfig{HS256Secret: testSecret, Issuer: "https://issuer.test", Audience: "task-services"}
+	pemOnly := &configs.AuthConfig{RS256PublicKeyFile: writePublicKeyPEM(t, rsaKey)}
+	jwks := &configs.AuthConfig{JWKSFile: writeJWKS(t, map[string]*rsa.PrivateKey{"one": rsaKey, "two": otherKey})}
+
+	cases := []struct {
+		name            string
+		config          *configs.AuthConfig
+		token           string
+		expectedSubject string
+	}{
+		{"HS256", hs256, signHS256(t, validClaims(), testSecret), "alice"},
+		{"HS256WrongSecret", hs256, signHS256(t, validClaims(), "guess"), ""},
+		{"Expired", hs256, signHS256(t, expired, testSecret), ""},
+		{"NoExpiry", hs256, signHS256(t, noExpiry, testSecret), ""},
+		{"WrongAudience", hs256, signHS256(t, wrongAudience, testSecret), ""},
+		{"NoSubject", hs256, signHS256(t, noSubject, testSecret), ""},
+		{"RS256NotConfigured", hs256, signRS256(t, validClaims(), rsaKey, ""), ""},
+		{"RS256PEM", pemOnly, signRS256(t, validClaims(), rsaKey, ""), "alice"},
+		{"RS256PEMWrongKey", pemOnly, signRS256(t, validClaims(), otherKey, ""), ""},
+		{"HS256NotConfigured", pemOnly, signHS256(t, validClaims(), testSecret), ""},
+		{"JWKSByKid", jwks, signRS256(t, validClaims(), otherKey, "two"), "alice"},
+		{"JWKSWrongKid", jwks, signRS256(t, validClaims(), otherKey, "one"), ""},
+		{"JWKSUnknownKid", jwks, signRS256(t, validClaims(), rsaKey, "three"), ""},
+		{"JWKSNoKid", jwks, signRS256(t, validClaims(), rsaKey, ""), ""},
+		{"Malformed", hs256, "not-a-token", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier, err := auth.NewVerifier(tc.config)
+			require.NoError(t, err)
+
+			principal, err := verifier.Verify(tc.token)
+
+			if tc.expectedSubject == "" {
+				assert.True(t, domainerrors.IsKind(err, domainerrors.KindUnauthorized))
+				assert.Nil(t, principal)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedSubject, principal.Subject)
+			assert.True(t, principal.HasRole(auth.RoleAdmin))
+			assert.Equal(t, []uint{2, 3}, principal.Workspaces)
+		})
+	}
+}
+
+func TestNewVerifier_Errors(t *testing.T) {
+	notPEM := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a key"), 0o600))
+	emptyJWKS := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(emptyJWKS, []byte(`{"keys":[{"kty":"EC","kid":"ec"}]}`), 0o600))
+
+	cases := []struct {
+		name   string
+		config *configs.AuthConfig
+	}{
+		{"NoKey", &configs.AuthConfig{}},
+		{"MissingPEM", &configs.AuthConfig{RS256PublicKeyFile: filepath.Join(t.TempDir(), "missing.pem")}},
+		{"InvalidPEM", &configs.AuthConfig{RS256PublicKeyFile: notPEM}},
+		{"NoRSAKeyInJWKS", &configs.AuthConfig{JWKSFile: emptyJWKS}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := auth.NewVerifier(tc.config)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -2,13 +2,20 @@ package configs
 
 import (
 	"log"
+	"strings"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Auth       AuthConfig
+	Storage    StorageConfig
+	Webhook    WebhookConfig
+	Outbox     OutboxConfig
+	Stream     StreamConfig
+	Recurrence RecurrenceConfig
 }
 
 type ServerConfig struct {
@@ -17,6 +24,8 @@ type ServerConfig struct {
 	ReadTimeout  int
 	WriteTimeout int
 	IdleTimeout  int
+	// AdminKey authenticates admin requests through the X-Admin-Key header; admin access is disabled when empty.
+	AdminKey string
 }
 
 type DatabaseConfig struct {
@@ -26,6 +35,98 @@ type DatabaseConfig struct {
 	User     string
 	Password string
 	DbName   string
+	// QueryTimeout bounds every query, in seconds; zero means no timeout.
+	QueryTimeout int
+}
+
+// AuthConfig configures the verification of JWT bearer tokens.
+type AuthConfig struct {
+	// Enabled requires a bearer token on every route but the public ones.
+	Enabled bool
+	// HS256Secret verifies HS256 tokens.
+	HS256Secret string
+	// RS256PublicKeyFile is a PEM public key verifying RS256 tokens.
+	RS256PublicKeyFile string
+	// JWKSFile is a JSON Web Key Set of RSA keys verifying RS256 tokens by their kid.
+	JWKSFile string
+	// Issuer and Audience, when set, must match the iss and aud claims.
+	Issuer   string
+	Audience string
+	// TaskVisibility is "all" when every user can read every task, or "own" when users only see
+	// the tasks they own or are assigned to.
+	TaskVisibility string
+	// DefaultWorkspaceRole is the role of the users without a row in workspace_members:
+	// "viewer", "member" or "admin".
+	DefaultWorkspaceRole string
+	// WorkspaceRoleCacheTTL is how long the roles read from the database are cached, in seconds.
+	WorkspaceRoleCacheTTL int
+}
+
+// StorageConfig configures where task attachments are stored and which files are accepted.
+type StorageConfig struct {
+	// Driver is "local" to store the files in LocalDir, or "s3" to store them in an S3-compatible bucket.
+	Driver   string
+	LocalDir string
+	// S3Endpoint is the base URL of the S3 API, such as https://s3.eu-west-1.amazonaws.com or a MinIO server;
+	// the bucket is addressed in the path.
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	// MaxUploadSize is the largest accepted file, in bytes.
+	MaxUploadSize int64
+	// AllowedTypes are the accepted media types, detected from the content of the files.
+	AllowedTypes []string
+}
+
+// WebhookConfig configures the delivery of webhooks.
+type WebhookConfig struct {
+	// MaxAttempts is the number of attempts of a delivery before it is marked failed.
+	MaxAttempts int
+	// Timeout bounds each attempt, in seconds.
+	Timeout int
+	// PollInterval is how often due deliveries are sent, in seconds.
+	PollInterval int
+}
+
+// OutboxConfig configures the relay of the domain events and its sinks.
+type OutboxConfig struct {
+	// PollInterval is how often the outbox is relayed, in seconds.
+	PollInterval int
+	// RetentionHours is how long published events are kept, in hours.
+	RetentionHours int
+	// PublishTimeout bounds each publication to NATS or Kafka, in seconds.
+	PublishTimeout int
+	// NatsURL, when set, publishes the events to the subjects SubjectPrefix.<type> of a NATS server.
+	NatsURL           string
+	NatsSubjectPrefix string
+	// KafkaRestURL, when set, produces the events to KafkaTopic through a Kafka REST proxy.
+	KafkaRestURL string
+	KafkaTopic   string
+}
+
+// StreamConfig configures the task change streams.
+type StreamConfig struct {
+	// HeartbeatInterval is the interval of the heartbeats of idle streams, in seconds.
+	HeartbeatInterval int
+	// WriteTimeout bounds every write to a subscriber, in seconds.
+	WriteTimeout int
+	// BufferSize is the number of changes a subscriber can fall behind by before its stream ends.
+	BufferSize int
+	// ReplayLimit is the number of changes replayed to a resuming subscriber, which lists its tasks
+	// again when it missed more.
+	ReplayLimit int
+}
+
+// RecurrenceConfig configures the scheduler of the recurring tasks.
+type RecurrenceConfig struct {
+	// PollInterval is how often due recurrences are turned into tasks, in seconds.
+	PollInterval int
+	// BatchSize is the number of due recurrences an instance claims at once.
+	BatchSize int
+	// Lease is how long a claimed recurrence is skipped by the other instances, in seconds.
+	Lease int
 }
 
 var AppConfig *Config
@@ -43,6 +144,31 @@ func InitConfig() {
 	viper.SetDefault("READ_TIMEOUT", 5)
 	viper.SetDefault("WRITE_TIMEOUT", 30)
 	viper.SetDefault("IDLE_TIMEOUT", 120)
+	viper.SetDefault("DB_QUERY_TIMEOUT", 10)
+	viper.SetDefault("AUTH_ENABLED", true)
+	viper.SetDefault("TASK_VISIBILITY", "all")
+	viper.SetDefault("DEFAULT_WORKSPACE_ROLE", "member")
+	viper.SetDefault("WORKSPACE_ROLE_CACHE_TTL", 60)
+	viper.SetDefault("STORAGE_DRIVER", "local")
+	viper.SetDefault("STORAGE_LOCAL_DIR", "data/attachments")
+	viper.SetDefault("S3_REGION", "us-east-1")
+	viper.SetDefault("ATTACHMENT_MAX_SIZE", 10<<20)
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	viper.SetDefault("WEBHOOK_TIMEOUT", 10)
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL", 5)
+	viper.SetDefault("OUTBOX_POLL_INTERVAL", 1)
+	viper.SetDefault("OUTBOX_RETENTION_HOURS", 168)
+	viper.SetDefault("OUTBOX_PUBLISH_TIMEOUT", 10)
+	viper.SetDefault("NATS_SUBJECT_PREFIX", "tasks")
+	viper.SetDefault("KAFKA_TOPIC", "task-events")
+	viper.SetDefault("STREAM_HEARTBEAT_INTERVAL", 15)
+	viper.SetDefault("STREAM_WRITE_TIMEOUT", 10)
+	viper.SetDefault("STREAM_BUFFER_SIZE", 64)
+	viper.SetDefault("STREAM_REPLAY_LIMIT", 500)
+	viper.SetDefault("RECURRENCE_POLL_INTERVAL", 30)
+	viper.SetDefault("RECURRENCE_BATCH_SIZE", 20)
+	viper.SetDefault("RECURRENCE_LEASE", 60)
+	viper.SetDefault("ATTACHMENT_ALLOWED_TYPES", "image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain,application/zip,application/x-gzip")
 
 	AppConfig = &Config{
 		Server: ServerConfig{
@@ -50,17 +176,94 @@ func InitConfig() {
 			Port:         viper.GetString("SERVER_PORT"),
 			ReadTimeout:  viper.GetInt("READ_TIMEOUT"),
 			WriteTimeout: viper.GetInt("WRITE_TIMEOUT"),
+			AdminKey:     viper.GetString("ADMIN_API_KEY"),
 		},
 		Database: DatabaseConfig{
-			Driver:   viper.GetString("DB_DRIVER"),
-			Host:     viper.GetString("POSTGRES_HOST"),
-			Port:     viper.GetInt("POSTGRES_PORT"),
-			User:     viper.GetString("POSTGRES_USER"),
-			Password: viper.GetString("POSTGRES_PASSWORD"),
-			DbName:   viper.GetString("POSTGRES_DB"),
+			Driver:       viper.GetString("DB_DRIVER"),
+			Host:         viper.GetString("POSTGRES_HOST"),
+			Port:         viper.GetInt("POSTGRES_PORT"),
+			User:         viper.GetString("POSTGRES_USER"),
+			Password:     viper.GetString("POSTGRES_PASSWORD"),
+			DbName:       viper.GetString("POSTGRES_DB"),
+			QueryTimeout: viper.GetInt("DB_QUERY_TIMEOUT"),
+		},
+		Auth: AuthConfig{
+			Enabled:               viper.GetBool("AUTH_ENABLED"),
+			HS256Secret:           viper.GetString("JWT_HS256_SECRET"),
+			RS256PublicKeyFile:    viper.GetString("JWT_RS256_PUBLIC_KEY_FILE"),
+			JWKSFile:              viper.GetString("JWT_JWKS_FILE"),
+			Issuer:                viper.GetString("JWT_ISSUER"),
+			Audience:              viper.GetString("JWT_AUDIENCE"),
+			TaskVisibility:        viper.GetString("TASK_VISIBILITY"),
+			DefaultWorkspaceRole:  viper.GetString("DEFAULT_WORKSPACE_ROLE"),
+			WorkspaceRoleCacheTTL: viper.GetInt("WORKSPACE_ROLE_CACHE_TTL"),
+		},
+		Storage: StorageConfig{
+			Driver:            viper.GetString("STORAGE_DRIVER"),
+			LocalDir:          viper.GetString("STORAGE_LOCAL_DIR"),
+			S3Endpoint:        viper.GetString("S3_ENDPOINT"),
+			S3Region:          viper.GetString("S3_REGION"),
+			S3Bucket:          viper.GetString("S3_BUCKET"),
+			S3AccessKeyID:     viper.GetString("S3_ACCESS_KEY_ID"),
+			S3SecretAccessKey: viper.GetString("S3_SECRET_ACCESS_KEY"),
+			MaxUploadSize:     viper.GetInt64("ATTACHMENT_MAX_SIZE"),
+			AllowedTypes:      splitList(viper.GetString("ATTACHMENT_ALLOWED_TYPES")),
+		},
+		Webhook: WebhookConfig{
+			MaxAttempts:  viper.GetInt("WEBHOOK_MAX_ATTEMPTS"),
+			Timeout:      viper.GetInt("WEBHOOK_TIMEOUT"),
+			PollInterval: viper.GetInt("WEBHOOK_POLL_INTERVAL"),
+		},
+		Outbox: OutboxConfig{
+			PollInterval:      viper.GetInt("OUTBOX_POLL_INTERVAL"),
+			RetentionHours:    viper.GetInt("OUTBOX_RETENTION_HOURS"),
+			PublishTimeout:    viper.GetInt("OUTBOX_PUBLISH_TIMEOUT"),
+			NatsURL:           viper.GetString("NATS_URL"),
+			NatsSubjectPrefix: viper.GetString("NATS_SUBJECT_PREFIX"),
+			KafkaRestURL:      viper.GetString("KAFKA_REST_URL"),
+			KafkaTopic:        viper.GetString("KAFKA_TOPIC"),
+		},
+		Stream: StreamConfig{
+			HeartbeatInterval: viper.GetInt("STREAM_HEARTBEAT_INTERVAL"),
+			WriteTimeout:      viper.GetInt("STREAM_WRITE_TIMEOUT"),
+			BufferSize:        viper.GetInt("STREAM_BUFFER_SIZE"),
+			ReplayLimit:       viper.GetInt("STREAM_REPLAY_LIMIT"),
+		},
+		Recurrence: RecurrenceConfig{
+			PollInterval: viper.GetInt("RECURRENCE_POLL_INTERVAL"),
+			BatchSize:    viper.GetInt("RECURRENCE_BATCH_SIZE"),
+			Lease:        viper.GetInt("RECURRENCE_LEASE"),
 		},
 	}
 
 	// Log the loaded configuration (optional)
-	log.Printf("Configuration loaded: %+v\n", AppConfig)
+	log.Printf("Configuration loaded: %+v\n", AppConfig.Redacted())
+}
+
+// Redacted returns a copy of the config whose secrets are masked, for the logs.
+func (c Config) Redacted() Config {
+	c.Server.AdminKey = redact(c.Server.AdminKey)
+	c.Database.Password = redact(c.Database.Password)
+	c.Auth.HS256Secret = redact(c.Auth.HS256Secret)
+	c.Storage.S3SecretAccessKey = redact(c.Storage.S3SecretAccessKey)
+	return c
+}
+
+// redact masks a secret; an empty secret stays empty, so the logs still show it is not set.
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return "[REDACTED]"
+}
+
+// splitList splits a comma-separated list, dropping blank items.
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
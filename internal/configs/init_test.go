@@ -0,0 +1,29 @@
+package configs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/configs"
+)
+
+func TestRedacted(t *testing.T) {
+	config := configs.Config{
+		Server:   configs.ServerConfig{Port: "8080", AdminKey: "admin-api-key"},
+		Database: configs.DatabaseConfig{User: "postgres", Password: "db-password"},
+		Auth:     configs.AuthConfig{Issuer: "https://auth.example.com", HS256Secret: "jwt-signing-secret"},
+		Storage:  configs.StorageConfig{S3AccessKeyID: "AKIAEXAMPLE", S3SecretAccessKey: "s3-secret-access-key"},
+	}
+
+	logged := fmt.Sprintf("%+v", config.Redacted())
+
+	for _, secret := range []string{"admin-api-key", "db-password", "jwt-signing-secret", "s3-secret-access-key"} {
+		assert.NotContains(t, logged, secret)
+	}
+	assert.Contains(t, logged, "https://auth.example.com")
+	assert.Contains(t, logged, "HS256Secret:[REDACTED]")
+	// The config itself keeps its secrets
+	assert.Equal(t, "jwt-signing-secret", config.Auth.HS256Secret)
+	assert.Equal(t, "", configs.Config{}.Redacted().Auth.HS256Secret)
+}
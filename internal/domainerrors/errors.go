@@ -0,0 +1,126 @@
+package domainerrors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies a domain error; the HTTP layer maps each kind to a status code.
+type Kind string
+
+const (
+	KindNotFound           Kind = "not_found"
+	KindConflict           Kind = "conflict"
+	KindValidation         Kind = "validation"
+	KindUnauthorized       Kind = "unauthorized"
+	KindForbidden          Kind = "forbidden"
+	KindPreconditionFailed Kind = "precondition_failed"
+	KindFailedDependency   Kind = "failed_dependency"
+	KindTooLarge           Kind = "too_large"
+	KindUnsupportedMedia   Kind = "unsupported_media"
+	KindUnavailable        Kind = "unavailable"
+	KindInternal           Kind = "internal"
+)
+
+// Error is an error produced by the usecases and repositories.
+type Error struct {
+	Kind    Kind
+	Message string
+	// Details are extra members added to the problem response body.
+	Details map[string]any
+	// Err is the underlying cause, never shown to clients.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// WithDetail returns a copy of the error carrying an extra detail member.
+func (e *Error) WithDetail(key string, value any) *Error {
+	details := make(map[string]any, len(e.Details)+1)
+	for k, v := range e.Details {
+		details[k] = v
+	}
+	details[key] = value
+	clone := *e
+	clone.Details = details
+	return &clone
+}
+
+// WithCause returns a copy of the error wrapping err.
+func (e *Error) WithCause(err error) *Error {
+	clone := *e
+	clone.Err = err
+	return &clone
+}
+
+func NotFound(message string) *Error {
+	return &Error{Kind: KindNotFound, Message: message}
+}
+
+func Conflict(message string) *Error {
+	return &Error{Kind: KindConflict, Message: message}
+}
+
+func Validation(message string) *Error {
+	return &Error{Kind: KindValidation, Message: message}
+}
+
+func Unauthorized(message string) *Error {
+	return &Error{Kind: KindUnauthorized, Message: message}
+}
+
+func Forbidden(message string) *Error {
+	return &Error{Kind: KindForbidden, Message: message}
+}
+
+func PreconditionFailed(message string) *Error {
+	return &Error{Kind: KindPreconditionFailed, Message: message}
+}
+
+// FailedDependency reports an operation that was not applied because another one failed.
+func FailedDependency(message string) *Error {
+	return &Error{Kind: KindFailedDependency, Message: message}
+}
+
+// TooLarge reports a request body, such as an uploaded file, over its size limit.
+func TooLarge(message string) *Error {
+	return &Error{Kind: KindTooLarge, Message: message}
+}
+
+// UnsupportedMedia reports content of a media type that is not accepted.
+func UnsupportedMedia(message string) *Error {
+	return &Error{Kind: KindUnsupportedMedia, Message: message}
+}
+
+// Unavailable reports a request that could not be served in time, such as a canceled or timed out query.
+func Unavailable(message string) *Error {
+	return &Error{Kind: KindUnavailable, Message: message}
+}
+
+func Internal(err error) *Error {
+	return &Error{Kind: KindInternal, Message: "internal server error", Err: err}
+}
+
+// KindOf returns the kind of a domain error, or KindInternal for any other error.
+func KindOf(err error) Kind {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Kind
+	}
+	return KindInternal
+}
+
+// IsKind reports whether err is a domain error of the given kind.
+func IsKind(err error, kind Kind) bool {
+	var domainErr *Error
+	return errors.As(err, &domainErr) && domainErr.Kind == kind
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+
+	"gorm.io/gorm"
+)
+
+var (
+	errApiKeyNotFound = domainerrors.NotFound("API key not found")
+	errNoWorkspace    = domainerrors.Internal(errors.New("repository: the context has no workspace"))
+)
+
+// Options tunes the API key repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type repository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewApiKeyRepository(db *gorm.DB, options Options) interfaces.ApiKeyRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) Create(ctx context.Context, key *entities.ApiKey) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	key.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(key).Error)
+}
+
+func (r *repository) GetByID(ctx context.Context, id uint) (*entities.ApiKey, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var key entities.ApiKey
+	if err := db.Take(&key, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &key, nil
+}
+
+func (r *repository) List(ctx context.Context, createdBy string) ([]entities.ApiKey, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	if createdBy != "" {
+		db = db.Where("created_by = ?", createdBy)
+	}
+	var keys []entities.ApiKey
+	if err := db.Order("id").Find(&keys).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return keys, nil
+}
+
+func (r *repository) Revoke(ctx context.Context, id uint, at time.Time) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Model(&entities.ApiKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		UpdateColumn("revoked_at", at)
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errApiKeyNotFound
+	}
+	return nil
+}
+
+func (r *repository) GetByHash(ctx context.Context, hash string) (*entities.ApiKey, error) {
+	db, cancel := r.unscoped(ctx)
+	defer cancel()
+	var key entities.ApiKey
+	if err := db.Where("key_hash = ?", hash).Take(&key).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &key, nil
+}
+
+func (r *repository) TouchLastUsed(ctx context.Context, id uint, at time.Time, olderThan time.Time) error {
+	db, cancel := r.unscoped(ctx)
+	defer cancel()
+	err := db.Model(&entities.ApiKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, olderThan).
+		UpdateColumn("last_used_at", at).Error
+	return wrapError(err)
+}
+
+// withContext binds the queries to ctx and to the workspace of ctx, bounded by the query timeout.
+func (r *repository) withContext(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	db, cancel := r.unscoped(ctx)
+	workspaceID, ok := helpers.WorkspaceIDFromContext(ctx)
+	if !ok {
+		_ = db.AddError(errNoWorkspace)
+		return db, cancel
+	}
+	return db.Where("workspace_id = ?", workspaceID).Session(&gorm.Session{}), cancel
+}
+
+// unscoped binds the queries to ctx, bounded by the query timeout, in every workspace.
+func (r *repository) unscoped(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	return r.db.WithContext(ctx).Session(&gorm.Session{}), cancel
+}
+
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errApiKeyNotFound
+	default:
+		return domainerrors.Internal(err)
+	}
+}
@@ -0,0 +1,107 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newRepository(t *testing.T) (interfaces.ApiKeyRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewApiKeyRepository(db, repository.Options{}), mock
+}
+
+func TestApiKeyRepository(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 2)
+
+	t.Run("CreateUsesContextWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "api_keys" ("workspace_id","name","prefix","key_hash","scopes","created_by"`)).
+			WithArgs(2, "CI bot", "tsk_abcdefgh", "hash", "tasks:read tasks:write", "alice", sqlmock.AnyArg(), nil, nil, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+		key := &entities.ApiKey{
+			Name:      "CI bot",
+			Prefix:    "tsk_abcdefgh",
+			KeyHash:   "hash",
+			Scopes:    entities.ApiKeyScopes{entities.ScopeTasksRead, entities.ScopeTasksWrite},
+			CreatedBy: "alice",
+		}
+		if assert.NoError(t, repo.Create(ctx, key)) {
+			assert.Equal(t, uint(2), key.WorkspaceId)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "api_keys" WHERE workspace_id = $1 AND created_by = $2 ORDER BY id`)).
+			WithArgs(2, "alice").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "scopes"}).AddRow(1, "tasks:read tasks:delete"))
+
+		keys, err := repo.List(ctx, "alice")
+
+		if assert.NoError(t, err) && assert.Len(t, keys, 1) {
+			assert.Equal(t, entities.ApiKeyScopes{entities.ScopeTasksRead, entities.ScopeTasksDelete}, keys[0].Scopes)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RevokeOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "api_keys" SET "revoked_at"=$1 WHERE workspace_id = $2 AND (id = $3 AND revoked_at IS NULL)`)).
+			WithArgs(sqlmock.AnyArg(), 2, 7).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Revoke(ctx, 7, time.Now())
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetByHashSearchesEveryWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "api_keys" WHERE key_hash = $1 LIMIT 1`)).
+			WithArgs("hash").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workspace_id", "scopes"}).AddRow(7, 3, "tasks:read"))
+
+		key, err := repo.GetByHash(context.Background(), "hash")
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, uint(3), key.WorkspaceId)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoWorkspaceRunsNoQuery", func(t *testing.T) {
+		repo, mock := newRepository(t)
+
+		_, err := repo.List(context.Background(), "")
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+
+		err = repo.Create(context.Background(), &entities.ApiKey{Name: "CI bot"})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
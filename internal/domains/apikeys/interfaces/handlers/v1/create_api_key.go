@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// CreateApiKey creates an API key
+// @Summary Create an API key
+// @Description Create an API key acting on your behalf in the workspace, limited to its scopes.
+// @Description The key is only returned in this response; store it, it cannot be read again.
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Param key body models.CreateApiKeyRequest true "API key"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=models.CreatedApiKey} "API key created"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token"
+// @Failure 403 {object} models.ProblemDetails "Requested with an API key"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Router /v1/api-keys [post]
+func (h *Handler) CreateApiKey(c echo.Context) error {
+	req := new(models.CreateApiKeyRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	key := &entities.ApiKey{
+		Name:      req.Name,
+		Scopes:    lo.Uniq(lo.Map(req.Scopes, func(scope string, _ int) entities.ApiKeyScope { return entities.ApiKeyScope(scope) })),
+		ExpiresAt: req.ExpiresAt,
+	}
+	created, err := h.ApiKeyUsecase.CreateApiKey(c.Request().Context(), interfaces.ActorFrom(c), key)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("API key created", created))
+}
@@ -0,0 +1,91 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/apikeys/interfaces/handlers/v1"
+	apiKeyModels "github.com/supachai1998/task_services/internal/domains/apikeys/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/apikeys/usecases"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockApiKeyUsecase(ctrl)
+	handler := &handlers.Handler{ApiKeyUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(body string, principal *auth.Principal) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/api-keys", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/api-keys")
+		c.Set(auth.ContextKeyPrincipal, principal)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().CreateApiKey(gomock.Any(), alice, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, key *entities.ApiKey) (*apiKeyModels.CreatedApiKey, error) {
+				assert.Equal(t, "CI bot", key.Name)
+				// Repeated scopes are stored once.
+				assert.Equal(t, entities.ApiKeyScopes{entities.ScopeTasksRead, entities.ScopeTasksWrite}, key.Scopes)
+				key.Id = 1
+				key.Prefix = "tsk_abcdefgh"
+				return &apiKeyModels.CreatedApiKey{ApiKey: *key, Key: "tsk_abcdefghijkl"}, nil
+			},
+		)
+
+		c, rec := newContext(`{"name": "CI bot", "scopes": ["tasks:read", "tasks:write", "tasks:read"]}`, &auth.Principal{Subject: "alice"})
+		if assert.NoError(t, handler.CreateApiKey(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+
+			var response struct {
+				Data map[string]any `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "tsk_abcdefghijkl", response.Data["key"])
+			assert.Equal(t, "tsk_abcdefgh", response.Data["prefix"])
+			assert.NotContains(t, response.Data, "key_hash")
+		}
+	})
+
+	t.Run("UnknownScope", func(t *testing.T) {
+		c, rec := newContext(`{"name": "CI bot", "scopes": ["tasks:admin"]}`, &auth.Principal{Subject: "alice"})
+		if assert.Error(t, invoke(handler.CreateApiKey, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("NoScopes", func(t *testing.T) {
+		c, rec := newContext(`{"name": "CI bot", "scopes": []}`, &auth.Principal{Subject: "alice"})
+		if assert.Error(t, invoke(handler.CreateApiKey, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("WithApiKey", func(t *testing.T) {
+		bot := entities.Actor{Name: "alice", UserId: "alice", ApiKeyId: 3}
+		mockUsecase.EXPECT().CreateApiKey(gomock.Any(), bot, gomock.Any()).Return(nil, domainerrors.Forbidden("API keys cannot manage API keys"))
+
+		c, rec := newContext(`{"name": "CI bot", "scopes": ["tasks:read"]}`, &auth.Principal{Subject: "alice", Scopes: entities.ApiKeyScopes{}, ApiKeyId: 3})
+		if assert.Error(t, invoke(handler.CreateApiKey, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	})
+}
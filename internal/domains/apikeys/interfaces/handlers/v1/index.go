@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+type Handler struct {
+	ApiKeyUsecase usecases.ApiKeyUsecase
+}
+
+func NewApiKeyHandler(e *echo.Echo, apiKeyUsecase usecases.ApiKeyUsecase) {
+	handler := &Handler{
+		ApiKeyUsecase: apiKeyUsecase,
+	}
+	// Any member of the workspace manages its own keys; the usecases restrict the rest.
+	viewer := interfaces.RequireRole(entities.WorkspaceRoleViewer)
+
+	e.POST("/v1/api-keys", handler.CreateApiKey, viewer)
+	e.GET("/v1/api-keys", handler.ListApiKeys, viewer)
+	e.DELETE("/v1/api-keys/:id", handler.RevokeApiKey, viewer)
+}
+
+// parseApiKeyID reads the API key ID path parameter.
+func parseApiKeyID(c echo.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		return 0, domainerrors.Validation("Invalid ID format")
+	}
+	return uint(id), nil
+}
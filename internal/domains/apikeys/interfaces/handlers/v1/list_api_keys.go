@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// ListApiKeys lists API keys
+// @Summary List API keys
+// @Description List your API keys in the workspace, or every key of the workspace for admins. Keys are never returned.
+// @Tags api-keys
+// @Produce json
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=[]entities.ApiKey} "API keys"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token"
+// @Failure 403 {object} models.ProblemDetails "Requested with an API key"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Router /v1/api-keys [get]
+func (h *Handler) ListApiKeys(c echo.Context) error {
+	keys, err := h.ApiKeyUsecase.ListApiKeys(c.Request().Context(), interfaces.ActorFrom(c))
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("API keys retrieved", keys))
+}
@@ -0,0 +1,46 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	handlers "github.com/supachai1998/task_services/internal/domains/apikeys/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/apikeys/usecases"
+)
+
+func TestListApiKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockApiKeyUsecase(ctrl)
+	handler := &handlers.Handler{ApiKeyUsecase: mockUsecase}
+	e := echo.New()
+
+	mockUsecase.EXPECT().ListApiKeys(gomock.Any(), alice).Return([]entities.ApiKey{
+		{Id: 1, Name: "CI bot", Prefix: "tsk_abcdefgh", KeyHash: "secret-hash", Scopes: entities.ApiKeyScopes{entities.ScopeTasksRead}},
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/api-keys", nil), rec)
+	c.Set(auth.ContextKeyPrincipal, &auth.Principal{Subject: "alice"})
+
+	if assert.NoError(t, handler.ListApiKeys(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "secret-hash")
+
+		var response struct {
+			Data []map[string]any `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		if assert.Len(t, response.Data, 1) {
+			assert.Equal(t, []any{"tasks:read"}, response.Data[0]["scopes"])
+		}
+	}
+}
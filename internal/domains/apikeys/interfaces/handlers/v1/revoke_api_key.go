@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// RevokeApiKey revokes an API key
+// @Summary Revoke an API key
+// @Description Revoke one of your API keys, or any key of the workspace for admins. Revoked keys stop working at once.
+// @Tags api-keys
+// @Produce json
+// @Param id path int true "API key ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 204 "API key revoked"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token"
+// @Failure 403 {object} models.ProblemDetails "Requested with an API key"
+// @Failure 404 {object} models.ProblemDetails "API key not found"
+// @Failure 409 {object} models.ProblemDetails "API key already revoked"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Router /v1/api-keys/{id} [delete]
+func (h *Handler) RevokeApiKey(c echo.Context) error {
+	id, err := parseApiKeyID(c)
+	if err != nil {
+		return err
+	}
+	if err := h.ApiKeyUsecase.RevokeApiKey(c.Request().Context(), interfaces.ActorFrom(c), id); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,59 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/apikeys/interfaces/handlers/v1"
+	mocks "github.com/supachai1998/task_services/internal/mocks/apikeys/usecases"
+)
+
+func TestRevokeApiKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockApiKeyUsecase(ctrl)
+	handler := &handlers.Handler{ApiKeyUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodDelete, "/v1/api-keys/"+id, nil), rec)
+		c.SetPath("/v1/api-keys/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		c.Set(auth.ContextKeyPrincipal, &auth.Principal{Subject: "alice"})
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().RevokeApiKey(gomock.Any(), alice, uint(1)).Return(nil)
+
+		c, rec := newContext("1")
+		if assert.NoError(t, handler.RevokeApiKey(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("AlreadyRevoked", func(t *testing.T) {
+		mockUsecase.EXPECT().RevokeApiKey(gomock.Any(), alice, uint(2)).Return(domainerrors.Conflict("API key is already revoked"))
+
+		c, rec := newContext("2")
+		if assert.Error(t, invoke(handler.RevokeApiKey, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		c, rec := newContext("invalid")
+		if assert.Error(t, invoke(handler.RevokeApiKey, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// ApiKeyRepository stores API keys. Every method but GetByHash and TouchLastUsed, which run
+// before the workspace of a request is known, is restricted to the workspace of ctx.
+type ApiKeyRepository interface {
+	Create(ctx context.Context, key *entities.ApiKey) error
+	GetByID(ctx context.Context, id uint) (*entities.ApiKey, error)
+	// List returns the keys created by createdBy, or every key when it is empty, oldest first.
+	List(ctx context.Context, createdBy string) ([]entities.ApiKey, error)
+	// Revoke sets the revoked_at of a key that is not revoked yet.
+	Revoke(ctx context.Context, id uint, at time.Time) error
+	// GetByHash finds a key of any workspace by the hash of its key.
+	GetByHash(ctx context.Context, hash string) (*entities.ApiKey, error)
+	// TouchLastUsed sets the last_used_at of a key when it is older than olderThan.
+	TouchLastUsed(ctx context.Context, id uint, at time.Time, olderThan time.Time) error
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// ApiKeyPrefix starts every API key, to tell them apart from other secrets.
+const ApiKeyPrefix = "tsk_"
+
+type CreateApiKeyRequest struct {
+	Name   string   `json:"name" validate:"required,min=1,max=100" example:"CI bot"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=tasks:read tasks:write tasks:delete" example:"tasks:read,tasks:write"`
+	// ExpiresAt is when the key stops working; the key never expires when it is omitted.
+	ExpiresAt *time.Time `json:"expires_at" example:"2030-01-01T00:00:00Z"`
+}
+
+// CreatedApiKey is a new API key with the raw key, which is only ever returned once.
+type CreatedApiKey struct {
+	entities.ApiKey
+	Key string `json:"key" example:"tsk_3q2-7wEXAMPLEb0Jx9f_mHwV5o0i3Q9wSQ2kR4lYzA"`
+}
@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// Authenticate returns a principal acting as the creator of the key, in the workspace of the key
+// and limited to its scopes. Unknown, revoked and expired keys are unauthorized.
+func (u *usecase) Authenticate(ctx context.Context, rawKey string) (*auth.Principal, error) {
+	if !strings.HasPrefix(rawKey, models.ApiKeyPrefix) {
+		return nil, errInvalidApiKey
+	}
+	key, err := u.apiKeyRepo.GetByHash(ctx, hashKey(rawKey))
+	if domainerrors.IsKind(err, domainerrors.KindNotFound) {
+		return nil, errInvalidApiKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if key.RevokedAt != nil {
+		return nil, domainerrors.Unauthorized("API key revoked")
+	}
+	if key.ExpiresAt != nil && !now.Before(*key.ExpiresAt) {
+		return nil, domainerrors.Unauthorized("API key expired")
+	}
+	if err := u.apiKeyRepo.TouchLastUsed(ctx, key.Id, now, now.Add(-lastUsedResolution)); err != nil {
+		return nil, err
+	}
+	return &auth.Principal{
+		Subject:    key.CreatedBy,
+		Workspaces: []uint{key.WorkspaceId},
+		// A key always has scopes: nil scopes would grant every scope.
+		Scopes:   append(entities.ApiKeyScopes{}, key.Scopes...),
+		ApiKeyId: key.Id,
+	}, nil
+}
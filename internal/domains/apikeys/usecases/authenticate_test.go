@@ -0,0 +1,85 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/apikeys/interfaces"
+)
+
+func TestAuthenticate(t *testing.T) {
+	const rawKey = "tsk_3q2-7wEXAMPLEb0Jx9f_mHwV5o0i3Q9wSQ2kR4lYzA"
+	ctx := context.Background()
+	active := entities.ApiKey{
+		Id:          7,
+		WorkspaceId: 2,
+		CreatedBy:   "alice",
+		Scopes:      entities.ApiKeyScopes{entities.ScopeTasksRead, entities.ScopeTasksWrite},
+		ExpiresAt:   lo.ToPtr(time.Now().Add(time.Hour)),
+	}
+	revoked := active
+	revoked.RevokedAt = lo.ToPtr(time.Now().Add(-time.Minute))
+	expired := active
+	expired.ExpiresAt = lo.ToPtr(time.Now().Add(-time.Minute))
+	noScopes := active
+	noScopes.Scopes = nil
+
+	cases := []struct {
+		name         string
+		rawKey       string
+		stored       *entities.ApiKey
+		storedErr    error
+		expectedKind domainerrors.Kind
+	}{
+		{"Active", rawKey, &active, nil, ""},
+		{"NoScopes", rawKey, &noScopes, nil, ""},
+		{"Unknown", rawKey, nil, domainerrors.NotFound("API key not found"), domainerrors.KindUnauthorized},
+		{"Revoked", rawKey, &revoked, nil, domainerrors.KindUnauthorized},
+		{"Expired", rawKey, &expired, nil, domainerrors.KindUnauthorized},
+		{"NotAnApiKey", "ghp_somethingelse", nil, nil, domainerrors.KindUnauthorized},
+		{"DatabaseDown", rawKey, nil, domainerrors.Internal(errors.New("connection refused")), domainerrors.KindInternal},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockApiKeyRepository(ctrl)
+			usecase := usecases.NewApiKeyUsecase(mockRepo)
+			if tc.stored != nil || tc.storedErr != nil {
+				mockRepo.EXPECT().GetByHash(ctx, sha256Hex(tc.rawKey)).Return(tc.stored, tc.storedErr)
+			}
+			if tc.expectedKind == "" {
+				mockRepo.EXPECT().TouchLastUsed(ctx, uint(7), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ uint, at, olderThan time.Time) error {
+					assert.Equal(t, time.Minute, at.Sub(olderThan))
+					return nil
+				})
+			}
+
+			principal, err := usecase.Authenticate(ctx, tc.rawKey)
+
+			if tc.expectedKind != "" {
+				assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+				assert.Nil(t, principal)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, "alice", principal.Subject)
+				assert.Equal(t, []uint{2}, principal.Workspaces)
+				assert.Equal(t, uint(7), principal.ApiKeyId)
+				assert.Empty(t, principal.Roles)
+				assert.Equal(t, len(tc.stored.Scopes) > 0, principal.HasScope(entities.ScopeTasksRead))
+				assert.False(t, principal.HasScope(entities.ScopeTasksDelete))
+			}
+		})
+	}
+}
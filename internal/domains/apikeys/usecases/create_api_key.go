@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+const (
+	// keyBytes is the entropy of a key, which makes a fast hash such as SHA-256 safe to store.
+	keyBytes = 32
+	// prefixLength is the length of the start of the key kept in clear to recognize it.
+	prefixLength = len(models.ApiKeyPrefix) + 8
+)
+
+func (u *usecase) CreateApiKey(ctx context.Context, actor entities.Actor, key *entities.ApiKey) (*models.CreatedApiKey, error) {
+	if err := authorizeManagement(actor); err != nil {
+		return nil, err
+	}
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(time.Now()) {
+		return nil, domainerrors.Validation("expires_at must be in the future")
+	}
+
+	rawKey, err := generateKey()
+	if err != nil {
+		return nil, domainerrors.Internal(err)
+	}
+	key.Prefix = rawKey[:prefixLength]
+	key.KeyHash = hashKey(rawKey)
+	key.CreatedBy = actor.UserId
+	if err := u.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+	return &models.CreatedApiKey{ApiKey: *key, Key: rawKey}, nil
+}
+
+// generateKey returns a new random key.
+func generateKey() (string, error) {
+	b := make([]byte, keyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return models.ApiKeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashKey returns the hash of a key stored in key_hash.
+func hashKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,79 @@
+package usecases_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/apikeys/interfaces"
+)
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestCreateApiKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockApiKeyRepository(ctrl)
+	usecase := usecases.NewApiKeyUsecase(mockRepo)
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+
+	t.Run("Success", func(t *testing.T) {
+		var stored *entities.ApiKey
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, key *entities.ApiKey) error {
+			key.Id = 1
+			stored = key
+			return nil
+		})
+
+		created, err := usecase.CreateApiKey(ctx, alice, &entities.ApiKey{Name: "CI bot", Scopes: entities.ApiKeyScopes{entities.ScopeTasksRead}})
+
+		if assert.NoError(t, err) {
+			assert.True(t, strings.HasPrefix(created.Key, "tsk_"))
+			assert.Equal(t, created.Key[:12], created.Prefix)
+			assert.Equal(t, "alice", stored.CreatedBy)
+			// Only the hash of the key is stored.
+			assert.Equal(t, sha256Hex(created.Key), stored.KeyHash)
+			assert.NotContains(t, stored.KeyHash, created.Key)
+		}
+	})
+
+	t.Run("KeysAreUnique", func(t *testing.T) {
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).Times(2).Return(nil)
+
+		first, err := usecase.CreateApiKey(ctx, alice, &entities.ApiKey{Name: "first"})
+		assert.NoError(t, err)
+		second, err := usecase.CreateApiKey(ctx, alice, &entities.ApiKey{Name: "second"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, first.Key, second.Key)
+	})
+
+	t.Run("ExpiredAlready", func(t *testing.T) {
+		_, err := usecase.CreateApiKey(ctx, alice, &entities.ApiKey{Name: "CI bot", ExpiresAt: lo.ToPtr(time.Now().Add(-time.Hour))})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		_, err := usecase.CreateApiKey(ctx, entities.Actor{Name: "anonymous"}, &entities.ApiKey{Name: "CI bot"})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindUnauthorized))
+	})
+
+	t.Run("WithApiKey", func(t *testing.T) {
+		bot := entities.Actor{Name: "alice", UserId: "alice", ApiKeyId: 1}
+		_, err := usecase.CreateApiKey(ctx, bot, &entities.ApiKey{Name: "CI bot"})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
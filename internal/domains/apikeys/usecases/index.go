@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type ApiKeyUsecase interface {
+	// CreateApiKey creates a key in the workspace of ctx acting on behalf of actor.
+	CreateApiKey(ctx context.Context, actor entities.Actor, key *entities.ApiKey) (*models.CreatedApiKey, error)
+	// ListApiKeys returns the keys of actor, or every key of the workspace for admins.
+	ListApiKeys(ctx context.Context, actor entities.Actor) ([]entities.ApiKey, error)
+	RevokeApiKey(ctx context.Context, actor entities.Actor, id uint) error
+	// Authenticate returns the principal of a raw key and records that the key was used.
+	Authenticate(ctx context.Context, rawKey string) (*auth.Principal, error)
+}
+
+// lastUsedResolution is how stale last_used_at may get, so a busy key does not write on every request.
+const lastUsedResolution = time.Minute
+
+var (
+	errApiKeyNotFound         = domainerrors.NotFound("API key not found")
+	errInvalidApiKey          = domainerrors.Unauthorized("invalid API key")
+	errAuthenticationRequired = domainerrors.Unauthorized("authentication required")
+	errApiKeyCannotManageKeys = domainerrors.Forbidden("API keys cannot manage API keys")
+)
+
+type usecase struct {
+	apiKeyRepo interfaces.ApiKeyRepository
+}
+
+func NewApiKeyUsecase(apiKeyRepo interfaces.ApiKeyRepository) ApiKeyUsecase {
+	return &usecase{apiKeyRepo}
+}
+
+// authorizeManagement fails unless actor is a user authenticated with a bearer token.
+func authorizeManagement(actor entities.Actor) error {
+	if actor.UserId == "" {
+		return errAuthenticationRequired
+	}
+	if actor.ApiKeyId != 0 {
+		return errApiKeyCannotManageKeys
+	}
+	return nil
+}
+
+// isAdmin reports whether actor has admin privileges or is an admin of the workspace.
+func isAdmin(actor entities.Actor) bool {
+	return actor.Admin || actor.Role == entities.WorkspaceRoleAdmin
+}
@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListApiKeys(ctx context.Context, actor entities.Actor) ([]entities.ApiKey, error) {
+	if err := authorizeManagement(actor); err != nil {
+		return nil, err
+	}
+	createdBy := actor.UserId
+	if isAdmin(actor) {
+		createdBy = ""
+	}
+	return u.apiKeyRepo.List(ctx, createdBy)
+}
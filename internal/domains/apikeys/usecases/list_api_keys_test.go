@@ -0,0 +1,32 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/apikeys/interfaces"
+)
+
+func TestListApiKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockApiKeyRepository(ctrl)
+	usecase := usecases.NewApiKeyUsecase(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().List(ctx, "alice").Return([]entities.ApiKey{{Id: 1}}, nil)
+	keys, err := usecase.ListApiKeys(ctx, entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	// Admins list the keys of every user of the workspace.
+	mockRepo.EXPECT().List(ctx, "").Return([]entities.ApiKey{{Id: 1}, {Id: 2}}, nil)
+	keys, err = usecase.ListApiKeys(ctx, entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleAdmin})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+}
@@ -0,0 +1,28 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// RevokeApiKey stops a key from working; users revoke their own keys, admins any key of the workspace.
+func (u *usecase) RevokeApiKey(ctx context.Context, actor entities.Actor, id uint) error {
+	if err := authorizeManagement(actor); err != nil {
+		return err
+	}
+	key, err := u.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	// The keys of other users are not found, so their IDs do not leak.
+	if key.CreatedBy != actor.UserId && !isAdmin(actor) {
+		return errApiKeyNotFound
+	}
+	if key.RevokedAt != nil {
+		return domainerrors.Conflict("API key is already revoked")
+	}
+	return u.apiKeyRepo.Revoke(ctx, id, time.Now())
+}
@@ -0,0 +1,61 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/apikeys/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/apikeys/interfaces"
+)
+
+func TestRevokeApiKey(t *testing.T) {
+	ctx := context.Background()
+	key := &entities.ApiKey{Id: 7, CreatedBy: "alice"}
+	revoked := &entities.ApiKey{Id: 7, CreatedBy: "alice", RevokedAt: lo.ToPtr(time.Now())}
+
+	var (
+		owner          = entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+		other          = entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleMember}
+		workspaceAdmin = entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleAdmin}
+	)
+
+	cases := []struct {
+		name         string
+		actor        entities.Actor
+		stored       *entities.ApiKey
+		expectedKind domainerrors.Kind
+	}{
+		{"Owner", owner, key, ""},
+		{"WorkspaceAdmin", workspaceAdmin, key, ""},
+		{"OtherUser", other, key, domainerrors.KindNotFound},
+		{"AlreadyRevoked", owner, revoked, domainerrors.KindConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockApiKeyRepository(ctrl)
+			usecase := usecases.NewApiKeyUsecase(mockRepo)
+			mockRepo.EXPECT().GetByID(ctx, uint(7)).Return(tc.stored, nil)
+			if tc.expectedKind == "" {
+				mockRepo.EXPECT().Revoke(ctx, uint(7), gomock.Any()).Return(nil)
+			}
+
+			err := usecase.RevokeApiKey(ctx, tc.actor, 7)
+
+			if tc.expectedKind == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+		})
+	}
+}
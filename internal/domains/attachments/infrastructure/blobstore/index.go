@@ -0,0 +1,45 @@
+package blobstore
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/supachai1998/task_services/internal/configs"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/interfaces"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var errBlobNotFound = domainerrors.NotFound("the content of the attachment is missing")
+
+// NewBlobStore returns the blob store selected by config.Driver.
+func NewBlobStore(config *configs.StorageConfig) (interfaces.BlobStore, error) {
+	switch config.Driver {
+	case DriverLocal:
+		return NewLocalStore(config.LocalDir)
+	case DriverS3:
+		return NewS3Store(S3Options{
+			Endpoint:        config.S3Endpoint,
+			Region:          config.S3Region,
+			Bucket:          config.S3Bucket,
+			AccessKeyID:     config.S3AccessKeyID,
+			SecretAccessKey: config.S3SecretAccessKey,
+		})
+	default:
+		return nil, fmt.Errorf("blobstore: unknown storage driver %q, expected %q or %q", config.Driver, DriverLocal, DriverS3)
+	}
+}
+
+// checkKey fails unless key is a relative slash-separated path without . or .. elements, so it
+// cannot escape the directory or the bucket of the store.
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.HasPrefix(key, "../") || key == ".." {
+		return domainerrors.Internal(fmt.Errorf("blobstore: invalid key %q", key))
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/interfaces"
+)
+
+type localStore struct {
+	dir string
+}
+
+// NewLocalStore stores the blobs as files under dir, creating it when missing.
+func NewLocalStore(dir string) (interfaces.BlobStore, error) {
+	if dir == "" {
+		return nil, errors.New("blobstore: the local directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("blobstore: %w", err)
+	}
+	return &localStore{dir}, nil
+}
+
+func (s *localStore) Put(ctx context.Context, key string, content io.Reader, size int64) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return domainerrors.Unavailable("the upload was canceled or timed out").WithCause(err)
+	}
+	name := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return domainerrors.Internal(err)
+	}
+
+	// Write a temporary file renamed once complete, so readers never see a partial blob
+	file, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return domainerrors.Internal(err)
+	}
+	defer os.Remove(file.Name())
+	n, err := io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && n != size {
+		err = fmt.Errorf("blobstore: wrote %d bytes of %d", n, size)
+	}
+	if err != nil {
+		return domainerrors.Internal(err)
+	}
+	if err := os.Rename(file.Name(), name); err != nil {
+		return domainerrors.Internal(err)
+	}
+	return nil
+}
+
+func (s *localStore) Exists(ctx context.Context, key string) (bool, error) {
+	if err := checkKey(key); err != nil {
+		return false, err
+	}
+	_, err := os.Stat(s.path(key))
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	default:
+		return false, domainerrors.Internal(err)
+	}
+}
+
+func (s *localStore) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(s.path(key))
+	switch {
+	case err == nil:
+		return file, nil
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, errBlobNotFound
+	default:
+		return nil, domainerrors.Internal(err)
+	}
+}
+
+func (s *localStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
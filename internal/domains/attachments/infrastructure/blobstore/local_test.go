@@ -0,0 +1,66 @@
+package blobstore_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/infrastructure/blobstore"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := blobstore.NewLocalStore(dir)
+	require.NoError(t, err)
+
+	t.Run("PutOpen", func(t *testing.T) {
+		require.NoError(t, store.Put(ctx, "sha256/ab/abc", strings.NewReader("hello world"), 11))
+
+		exists, err := store.Exists(ctx, "sha256/ab/abc")
+		if assert.NoError(t, err) {
+			assert.True(t, exists)
+		}
+		blob, err := store.Open(ctx, "sha256/ab/abc")
+		require.NoError(t, err)
+		defer blob.Close()
+		_, err = blob.Seek(6, io.SeekStart)
+		require.NoError(t, err)
+		content, err := io.ReadAll(blob)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "world", string(content))
+		}
+		assert.FileExists(t, filepath.Join(dir, "sha256", "ab", "abc"))
+	})
+
+	t.Run("ShortContentIsNotStored", func(t *testing.T) {
+		err := store.Put(ctx, "sha256/cd/cde", strings.NewReader("hello"), 11)
+
+		assert.Error(t, err)
+		entries, _ := os.ReadDir(filepath.Join(dir, "sha256", "cd"))
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		exists, err := store.Exists(ctx, "sha256/ef/efg")
+		if assert.NoError(t, err) {
+			assert.False(t, exists)
+		}
+		_, err = store.Open(ctx, "sha256/ef/efg")
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+	})
+
+	t.Run("KeyOutsideTheDirectory", func(t *testing.T) {
+		for _, key := range []string{"../escape", "/etc/passwd", "a/../../escape", ""} {
+			err := store.Put(ctx, key, strings.NewReader("x"), 1)
+			assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal), key)
+		}
+		assert.NoFileExists(t, filepath.Join(filepath.Dir(dir), "escape"))
+	})
+}
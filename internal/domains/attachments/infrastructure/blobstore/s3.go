@@ -0,0 +1,278 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/interfaces"
+)
+
+// unsignedPayload is the payload hash of requests whose body is not signed, so uploads are
+// streamed without being read twice.
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// S3Options configures a store in a bucket of an S3-compatible API, such as AWS S3 or MinIO.
+type S3Options struct {
+	// Endpoint is the base URL of the API; the bucket is addressed in the path.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Client sends the requests, http.DefaultClient when nil.
+	Client *http.Client
+	// Now is the clock of the request signatures, time.Now when nil.
+	Now func() time.Time
+}
+
+type s3Store struct {
+	endpoint *url.URL
+	options  S3Options
+}
+
+// NewS3Store stores the blobs as objects of a bucket, signing the requests with AWS Signature Version 4.
+func NewS3Store(options S3Options) (interfaces.BlobStore, error) {
+	if options.Endpoint == "" || options.Region == "" || options.Bucket == "" {
+		return nil, errors.New("blobstore: the S3 endpoint, region and bucket are required")
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(options.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("blobstore: invalid S3 endpoint %q", options.Endpoint)
+	}
+	if options.Client == nil {
+		options.Client = http.DefaultClient
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return &s3Store{endpoint, options}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, content io.Reader, size int64) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	if size == 0 {
+		content = http.NoBody
+	}
+	resp, err := s.do(ctx, http.MethodPut, key, content, size, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s.statusError(http.MethodPut, key, resp)
+	}
+	return nil
+}
+
+func (s *s3Store) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.head(ctx, key)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, errBlobNotFound):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (s *s3Store) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	size, err := s.head(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Object{ctx: ctx, store: s, key: key, size: size}, nil
+}
+
+// head returns the size of the object key.
+func (s *s3Store) head(ctx context.Context, key string) (int64, error) {
+	if err := checkKey(key); err != nil {
+		return 0, err
+	}
+	resp, err := s.do(ctx, http.MethodHead, key, nil, 0, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.ContentLength, nil
+	case http.StatusNotFound:
+		return 0, errBlobNotFound
+	default:
+		return 0, s.statusError(http.MethodHead, key, resp)
+	}
+}
+
+// do sends a signed request on the object key.
+func (s *s3Store) do(ctx context.Context, method, key string, body io.Reader, size int64, header http.Header) (*http.Response, error) {
+	target := *s.endpoint
+	target.Path = s.endpoint.Path + "/" + s.options.Bucket + "/" + key
+	target.RawPath = s.endpoint.EscapedPath() + "/" + uriEncode(s.options.Bucket) + "/" + uriEncode(key)
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, domainerrors.Internal(err)
+	}
+	req.ContentLength = size
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	s.sign(req)
+
+	resp, err := s.options.Client.Do(req)
+	switch {
+	case err == nil:
+		return resp, nil
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return nil, domainerrors.Unavailable("the storage request was canceled or timed out").WithCause(err)
+	default:
+		return nil, domainerrors.Internal(err)
+	}
+}
+
+// sign adds the Authorization header of Signature Version 4 to req, signing the host, range,
+// content type and x-amz-* headers but not the body.
+func (s *s3Store) sign(req *http.Request) {
+	amzDate := s.options.Now().UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+	names := []string{"host"}
+	values := map[string]string{"host": req.URL.Host}
+	for name, value := range req.Header {
+		name = strings.ToLower(name)
+		if name == "range" || name == "content-type" || strings.HasPrefix(name, "x-amz-") {
+			names = append(names, name)
+			values[name] = strings.TrimSpace(strings.Join(value, ","))
+		}
+	}
+	sort.Strings(names)
+	var headers strings.Builder
+	for _, name := range names {
+		headers.WriteString(name + ":" + values[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	// The store sends no query string, so the canonical query is empty
+	canonicalRequest := strings.Join([]string{
+		req.Method, req.URL.EscapedPath(), "", headers.String(), signedHeaders, unsignedPayload,
+	}, "\n")
+	scope := date + "/" + s.options.Region + "/s3/aws4_request"
+	digest := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(digest[:])
+
+	key := []byte("AWS4" + s.options.SecretAccessKey)
+	for _, part := range []string{date, s.options.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.options.AccessKeyID, scope, signedHeaders, hex.EncodeToString(hmacSHA256(key, stringToSign)),
+	))
+}
+
+// statusError reads the error of a failed request, whose body is an S3 XML error.
+func (s *s3Store) statusError(method, key string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return domainerrors.Internal(fmt.Errorf("blobstore: %s %s: %s: %s", method, key, resp.Status, strings.TrimSpace(string(body))))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// uriEncode escapes a path as Signature Version 4 does: every byte but the unreserved characters
+// and the slashes.
+func uriEncode(path string) string {
+	var encoded strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte("-_.~/", c) >= 0 {
+			encoded.WriteByte(c)
+		} else {
+			fmt.Fprintf(&encoded, "%%%02X", c)
+		}
+	}
+	return encoded.String()
+}
+
+// s3Object reads an object with ranged GET requests, sent lazily so seeking is free until the
+// next read.
+type s3Object struct {
+	ctx    context.Context
+	store  *s3Store
+	key    string
+	size   int64
+	offset int64
+	body   io.ReadCloser
+}
+
+func (o *s3Object) Read(p []byte) (int, error) {
+	if o.offset >= o.size {
+		return 0, io.EOF
+	}
+	if o.body == nil {
+		header := http.Header{}
+		header.Set("Range", fmt.Sprintf("bytes=%d-", o.offset))
+		resp, err := o.store.do(o.ctx, http.MethodGet, o.key, nil, 0, header)
+		if err != nil {
+			return 0, err
+		}
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+			defer resp.Body.Close()
+			return 0, o.store.statusError(http.MethodGet, o.key, resp)
+		}
+		o.body = resp.Body
+	}
+	n, err := o.body.Read(p)
+	o.offset += int64(n)
+	if err == io.EOF && o.offset < o.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (o *s3Object) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += o.offset
+	case io.SeekEnd:
+		offset += o.size
+	}
+	if offset < 0 {
+		return 0, errors.New("blobstore: negative position")
+	}
+	if offset != o.offset {
+		o.closeBody()
+		o.offset = offset
+	}
+	return offset, nil
+}
+
+func (o *s3Object) Close() error {
+	o.closeBody()
+	return nil
+}
+
+func (o *s3Object) closeBody() {
+	if o.body != nil {
+		o.body.Close()
+		o.body = nil
+	}
+}
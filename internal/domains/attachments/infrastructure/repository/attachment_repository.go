@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+
+	"gorm.io/gorm"
+)
+
+var (
+	errAttachmentNotFound = domainerrors.NotFound("attachment not found")
+	errNoWorkspace        = domainerrors.Internal(errors.New("repository: the context has no workspace"))
+)
+
+// Options tunes the attachment repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type repository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewAttachmentRepository(db *gorm.DB, options Options) interfaces.AttachmentRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) Create(ctx context.Context, attachment *entities.Attachment) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	attachment.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(attachment).Error)
+}
+
+func (r *repository) GetByID(ctx context.Context, taskID, id uint) (*entities.Attachment, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var attachment entities.Attachment
+	if err := db.Where("task_id = ?", taskID).Take(&attachment, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &attachment, nil
+}
+
+func (r *repository) List(ctx context.Context, taskID uint) ([]entities.Attachment, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	attachments := []entities.Attachment{}
+	if err := db.Where("task_id = ?", taskID).Order("id").Find(&attachments).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return attachments, nil
+}
+
+// withContext binds the queries to ctx and to the workspace of ctx, bounded by the query timeout.
+func (r *repository) withContext(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	db := r.db.WithContext(ctx).Session(&gorm.Session{})
+	workspaceID, ok := helpers.WorkspaceIDFromContext(ctx)
+	if !ok {
+		_ = db.AddError(errNoWorkspace)
+		return db, cancel
+	}
+	return db.Where("workspace_id = ?", workspaceID).Session(&gorm.Session{}), cancel
+}
+
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errAttachmentNotFound
+	default:
+		return domainerrors.Internal(err)
+	}
+}
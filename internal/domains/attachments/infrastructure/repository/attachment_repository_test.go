@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/attachments/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newRepository(t *testing.T) (interfaces.AttachmentRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewAttachmentRepository(db, repository.Options{}), mock
+}
+
+func TestAttachmentRepository(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 2)
+	hash := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	t.Run("CreateUsesContextWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attachments" ("workspace_id","task_id","file_name","content_type","size","sha256","uploaded_by","created_at")`)).
+			WithArgs(2, 15, "hello.txt", "text/plain; charset=utf-8", 5, hash, "alice", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+
+		attachment := &entities.Attachment{TaskId: 15, FileName: "hello.txt", ContentType: "text/plain; charset=utf-8", Size: 5, Sha256: hash, UploadedBy: "alice"}
+		if assert.NoError(t, repo.Create(ctx, attachment)) {
+			assert.Equal(t, uint(2), attachment.WorkspaceId)
+			assert.Equal(t, uint(4), attachment.Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("List", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attachments" WHERE workspace_id = $1 AND task_id = $2 ORDER BY id`)).
+			WithArgs(2, 15).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "task_id"}).AddRow(4, 15).AddRow(5, 15))
+
+		attachments, err := repo.List(ctx, 15)
+
+		if assert.NoError(t, err) && assert.Len(t, attachments, 2) {
+			assert.Equal(t, uint(5), attachments[1].Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetByIDOfAnotherTask", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attachments" WHERE workspace_id = $1 AND task_id = $2 AND "attachments"."id" = $3 LIMIT 1`)).
+			WithArgs(2, 16, 4).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetByID(ctx, 16, 4)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoWorkspace", func(t *testing.T) {
+		repo, _ := newRepository(t)
+
+		_, err := repo.List(context.Background(), 15)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+	})
+}
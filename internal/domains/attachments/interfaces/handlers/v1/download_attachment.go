@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// DownloadAttachment downloads an attached file
+// @Summary Download an attached file
+// @Description Stream the content of an attachment. A Range header reads part of it, answered with
+// @Description 206 Partial Content; the ETag is the SHA-256 of the content.
+// @Tags attachments
+// @Produce octet-stream
+// @Param id path int true "Task ID"
+// @Param attachment_id path int true "Attachment ID"
+// @Param Range header string false "Byte range, such as bytes=0-1023"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {file} file "Content of the file"
+// @Success 206 {file} file "Requested range of the file"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Task or attachment not found"
+// @Failure 416 {string} string "Range not satisfiable"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/attachments/{attachment_id} [get]
+func (h *Handler) DownloadAttachment(c echo.Context) error {
+	taskID, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+	id, err := parseIDParam(c, "attachment_id")
+	if err != nil {
+		return err
+	}
+
+	attachment, content, err := h.AttachmentUsecase.OpenAttachment(c.Request().Context(), interfaces.ActorFrom(c), taskID, id)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, attachment.ContentType)
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	header.Set("ETag", `"`+attachment.Sha256+`"`)
+	// ServeContent answers Range, If-Range and If-None-Match, seeking in the content
+	http.ServeContent(c.Response(), c.Request(), attachment.FileName, attachment.CreatedAt, content)
+	return nil
+}
@@ -0,0 +1,92 @@
+package handlers_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/attachments/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/attachments/usecases"
+)
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
+func TestDownloadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockAttachmentUsecase(ctrl)
+	handler := &handlers.Handler{AttachmentUsecase: mockUsecase}
+	e := echo.New()
+	attachment := &entities.Attachment{
+		Id:          4,
+		TaskId:      15,
+		FileName:    "app.log",
+		ContentType: "text/plain; charset=utf-8",
+		Size:        11,
+		Sha256:      "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		CreatedAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	newContext := func(attachmentID, byteRange string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/15/attachments/"+attachmentID, nil)
+		if byteRange != "" {
+			req.Header.Set("Range", byteRange)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/attachments/:attachment_id")
+		c.SetParamNames("id", "attachment_id")
+		c.SetParamValues("15", attachmentID)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().OpenAttachment(gomock.Any(), anonymous, uint(15), uint(4)).
+			Return(attachment, nopSeekCloser{strings.NewReader("panic: boom")}, nil)
+
+		c, rec := newContext("4", "")
+		if assert.NoError(t, handler.DownloadAttachment(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "panic: boom", rec.Body.String())
+			assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, `attachment; filename=app.log`, rec.Header().Get(echo.HeaderContentDisposition))
+			assert.Equal(t, `"`+attachment.Sha256+`"`, rec.Header().Get("ETag"))
+			assert.Equal(t, "bytes", rec.Header().Get("Accept-Ranges"))
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		mockUsecase.EXPECT().OpenAttachment(gomock.Any(), anonymous, uint(15), uint(4)).
+			Return(attachment, nopSeekCloser{strings.NewReader("panic: boom")}, nil)
+
+		c, rec := newContext("4", "bytes=7-")
+		if assert.NoError(t, handler.DownloadAttachment(c)) {
+			assert.Equal(t, http.StatusPartialContent, rec.Code)
+			assert.Equal(t, "boom", rec.Body.String())
+			assert.Equal(t, "bytes 7-10/11", rec.Header().Get("Content-Range"))
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().OpenAttachment(gomock.Any(), anonymous, uint(15), uint(9)).
+			Return(nil, nil, domainerrors.NotFound("attachment not found"))
+
+		c, rec := newContext("9", "")
+		if assert.Error(t, invoke(handler.DownloadAttachment, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+}
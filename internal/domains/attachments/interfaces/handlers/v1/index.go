@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// formFieldFile is the multipart form field of the uploaded file.
+const formFieldFile = "file"
+
+type Handler struct {
+	AttachmentUsecase usecases.AttachmentUsecase
+}
+
+func NewAttachmentHandler(e *echo.Echo, attachmentUsecase usecases.AttachmentUsecase) {
+	handler := &Handler{
+		AttachmentUsecase: attachmentUsecase,
+	}
+	// Attachments are a subresource of tasks, so they take the task scopes of API keys; the
+	// usecases check that the task can be read.
+	read := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleViewer),
+		interfaces.RequireScope(entities.ScopeTasksRead),
+	}
+	write := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleMember),
+		interfaces.RequireScope(entities.ScopeTasksWrite),
+	}
+
+	e.POST("/v1/tasks/:id/attachments", handler.UploadAttachment, write...)
+	e.GET("/v1/tasks/:id/attachments", handler.ListAttachments, read...)
+	e.GET("/v1/tasks/:id/attachments/:attachment_id", handler.DownloadAttachment, read...)
+}
+
+// parseIDParam reads an ID path parameter named name.
+func parseIDParam(c echo.Context, name string) (uint, error) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 0)
+	if err != nil {
+		return 0, domainerrors.Validation("Invalid ID format")
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,52 @@
+package handlers_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/attachments/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/attachments/usecases"
+)
+
+func TestNewAttachmentHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e := echo.New()
+	handlers.NewAttachmentHandler(e, mocks.NewMockAttachmentUsecase(ctrl))
+
+	expectedRoutes := []struct {
+		Method string
+		Path   string
+	}{
+		{"POST", "/v1/tasks/:id/attachments"},
+		{"GET", "/v1/tasks/:id/attachments"},
+		{"GET", "/v1/tasks/:id/attachments/:attachment_id"},
+	}
+	for _, er := range expectedRoutes {
+		found := false
+		for _, r := range e.Routes() {
+			if r.Method == er.Method && r.Path == er.Path {
+				found = true
+				break
+			}
+		}
+		assert.True(t, found, "Route not registered: %s %s", er.Method, er.Path)
+	}
+}
+
+// anonymous is the actor of requests without authentication.
+var anonymous = entities.Actor{Name: "anonymous"}
+
+// invoke runs a handler and, like Echo, writes a returned error through the central error handler.
+func invoke(handler echo.HandlerFunc, c echo.Context) error {
+	err := handler(c)
+	if err != nil {
+		interfaces.HTTPErrorHandler(err, c)
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// ListAttachments lists the attachments of a task
+// @Summary List the attachments of a task
+// @Description List the metadata of the files attached to a task, oldest first
+// @Tags attachments
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=[]entities.Attachment} "Attachments listed"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/attachments [get]
+func (h *Handler) ListAttachments(c echo.Context) error {
+	taskID, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+
+	attachments, err := h.AttachmentUsecase.ListAttachments(c.Request().Context(), interfaces.ActorFrom(c), taskID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Attachments listed", attachments))
+}
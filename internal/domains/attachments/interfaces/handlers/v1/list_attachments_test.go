@@ -0,0 +1,37 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/attachments/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/attachments/usecases"
+)
+
+func TestListAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockAttachmentUsecase(ctrl)
+	handler := &handlers.Handler{AttachmentUsecase: mockUsecase}
+	e := echo.New()
+
+	mockUsecase.EXPECT().ListAttachments(gomock.Any(), anonymous, uint(15)).
+		Return([]entities.Attachment{{Id: 4, FileName: "app.log"}}, nil)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/tasks/15/attachments", nil), rec)
+	c.SetPath("/v1/tasks/:id/attachments")
+	c.SetParamNames("id")
+	c.SetParamValues("15")
+
+	if assert.NoError(t, handler.ListAttachments(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"file_name":"app.log"`)
+	}
+}
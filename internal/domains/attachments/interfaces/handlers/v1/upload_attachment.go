@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// UploadAttachment attaches a file to a task
+// @Summary Attach a file to a task
+// @Description Upload a file as the "file" field of a multipart form. The file is streamed to the
+// @Description storage, its media type is detected from its content, and files with the same
+// @Description content are stored once.
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param file formData file true "File"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=entities.Attachment} "Attachment created"
+// @Failure 400 {object} models.ProblemDetails "Invalid input or empty file"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 413 {object} models.ProblemDetails "File larger than the size limit"
+// @Failure 415 {object} models.ProblemDetails "Media type not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/attachments [post]
+func (h *Handler) UploadAttachment(c echo.Context) error {
+	taskID, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+	// Read the parts as a stream rather than parsing the whole form into memory
+	reader, err := c.Request().MultipartReader()
+	if err != nil {
+		return domainerrors.Validation("a multipart/form-data body is required")
+	}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return domainerrors.Validation("the file field is required")
+		}
+		if err != nil {
+			return domainerrors.Validation("invalid multipart body").WithCause(err)
+		}
+		if part.FormName() != formFieldFile {
+			continue
+		}
+
+		attachment := &entities.Attachment{TaskId: taskID, FileName: part.FileName()}
+		if err := h.AttachmentUsecase.UploadAttachment(c.Request().Context(), interfaces.ActorFrom(c), attachment, part); err != nil {
+			return err
+		}
+		return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Attachment created", attachment))
+	}
+}
@@ -0,0 +1,99 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/attachments/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/attachments/usecases"
+)
+
+func TestUploadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockAttachmentUsecase(ctrl)
+	handler := &handlers.Handler{AttachmentUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(field, fileName, content string) (echo.Context, *httptest.ResponseRecorder) {
+		body := new(bytes.Buffer)
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writer.WriteField("note", "ignored"))
+		part, err := writer.CreateFormFile(field, fileName)
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks/15/attachments", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/attachments")
+		c.SetParamNames("id")
+		c.SetParamValues("15")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().UploadAttachment(gomock.Any(), anonymous, &entities.Attachment{TaskId: 15, FileName: "app.log"}, gomock.Any()).DoAndReturn(
+			func(_ context.Context, actor entities.Actor, attachment *entities.Attachment, content io.Reader) error {
+				uploaded, err := io.ReadAll(content)
+				assert.NoError(t, err)
+				assert.Equal(t, "panic: boom", string(uploaded))
+				attachment.Id = 4
+				attachment.Size = int64(len(uploaded))
+				return nil
+			},
+		)
+
+		c, rec := newContext("file", "app.log", "panic: boom")
+		if assert.NoError(t, handler.UploadAttachment(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"size":11`)
+		}
+	})
+
+	t.Run("BadRequest_NoFileField", func(t *testing.T) {
+		c, rec := newContext("upload", "app.log", "panic: boom")
+		if assert.Error(t, invoke(handler.UploadAttachment, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_NotMultipart", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks/15/attachments", strings.NewReader(`{}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("15")
+
+		if assert.Error(t, invoke(handler.UploadAttachment, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		mockUsecase.EXPECT().UploadAttachment(gomock.Any(), anonymous, gomock.Any(), gomock.Any()).
+			Return(domainerrors.TooLarge("the file is larger than 10485760 bytes"))
+
+		c, rec := newContext("file", "dump.zip", "PK")
+		if assert.Error(t, invoke(handler.UploadAttachment, c)) {
+			assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// AttachmentRepository stores the metadata of the files attached to the tasks of the workspace of ctx.
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *entities.Attachment) error
+	// GetByID reads an attachment of the task taskID.
+	GetByID(ctx context.Context, taskID, id uint) (*entities.Attachment, error)
+	// List returns the attachments of a task, oldest first.
+	List(ctx context.Context, taskID uint) ([]entities.Attachment, error)
+}
+
+// BlobStore stores the contents of the attached files by key. Keys are slash-separated paths.
+type BlobStore interface {
+	// Put stores size bytes of content under key, replacing what was stored under it.
+	Put(ctx context.Context, key string, content io.Reader, size int64) error
+	// Exists reports whether a content is stored under key.
+	Exists(ctx context.Context, key string) (bool, error)
+	// Open reads the content stored under key; it fails with a not found error when there is none.
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+}
+
+// TaskReader reads the task attachments belong to, failing when the actor cannot read it.
+type TaskReader interface {
+	GetTaskByID(ctx context.Context, actor entities.Actor, id uint) (*entities.Task, error)
+}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type AttachmentUsecase interface {
+	// UploadAttachment stores content as a file attached by actor to the task attachment.TaskId,
+	// filling in the size, the hash and the media type of the content.
+	UploadAttachment(ctx context.Context, actor entities.Actor, attachment *entities.Attachment, content io.Reader) error
+	// ListAttachments returns the attachments of a task, oldest first.
+	ListAttachments(ctx context.Context, actor entities.Actor, taskID uint) ([]entities.Attachment, error)
+	// OpenAttachment returns an attachment and its content, which the caller closes.
+	OpenAttachment(ctx context.Context, actor entities.Actor, taskID, id uint) (*entities.Attachment, io.ReadSeekCloser, error)
+}
+
+// Options are the limits of the uploaded files.
+type Options struct {
+	// MaxSize is the largest accepted file, in bytes.
+	MaxSize int64
+	// AllowedTypes are the accepted media types, without parameters, such as "image/png".
+	AllowedTypes []string
+	// TempDir holds the uploads while they are hashed; the default temporary directory when empty.
+	TempDir string
+}
+
+type usecase struct {
+	attachmentRepo interfaces.AttachmentRepository
+	blobs          interfaces.BlobStore
+	tasks          interfaces.TaskReader
+	options        Options
+}
+
+func NewAttachmentUsecase(attachmentRepo interfaces.AttachmentRepository, blobs interfaces.BlobStore, tasks interfaces.TaskReader, options Options) AttachmentUsecase {
+	return &usecase{attachmentRepo, blobs, tasks, options}
+}
+
+// Authorization policy: reading the attachments of a task takes reading the task, and attaching a
+// file also takes the member role, unless the actor is an admin or anonymous, which only happens
+// when authentication is disabled.
+
+// authorizeRole fails when the workspace role of actor does not include required.
+func authorizeRole(actor entities.Actor, required entities.WorkspaceRole, action string) error {
+	if actor.Admin || actor.UserId == "" || actor.Role.Includes(required) {
+		return nil
+	}
+	return domainerrors.Forbidden(fmt.Sprintf("the %s role is required to %s", required, action)).
+		WithDetail("role", actor.Role).
+		WithDetail("required_role", required)
+}
+
+// blobKey names the blob of a content by its SHA-256, so identical files are stored once.
+func blobKey(sha256 string) string {
+	return "sha256/" + sha256[:2] + "/" + sha256
+}
@@ -0,0 +1,14 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListAttachments(ctx context.Context, actor entities.Actor, taskID uint) ([]entities.Attachment, error) {
+	if _, err := u.tasks.GetTaskByID(ctx, actor, taskID); err != nil {
+		return nil, err
+	}
+	return u.attachmentRepo.List(ctx, taskID)
+}
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+	"io"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) OpenAttachment(ctx context.Context, actor entities.Actor, taskID, id uint) (*entities.Attachment, io.ReadSeekCloser, error) {
+	if _, err := u.tasks.GetTaskByID(ctx, actor, taskID); err != nil {
+		return nil, nil, err
+	}
+	attachment, err := u.attachmentRepo.GetByID(ctx, taskID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := u.blobs.Open(ctx, blobKey(attachment.Sha256))
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
@@ -0,0 +1,46 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
+func TestOpenAttachment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		usecase, mockRepo, mockBlobs, mockTasks := newUsecase(t)
+		mockTasks.EXPECT().GetTaskByID(ctx, viewer, uint(15)).Return(&entities.Task{Id: 15}, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(15), uint(4)).Return(&entities.Attachment{Id: 4, TaskId: 15, Sha256: helloHash}, nil)
+		mockBlobs.EXPECT().Open(ctx, "sha256/2c/"+helloHash).Return(nopSeekCloser{strings.NewReader("hello")}, nil)
+
+		attachment, content, err := usecase.OpenAttachment(ctx, viewer, 15, 4)
+
+		if assert.NoError(t, err) {
+			defer content.Close()
+			assert.Equal(t, uint(4), attachment.Id)
+		}
+	})
+
+	t.Run("TaskNotFound", func(t *testing.T) {
+		usecase, _, _, mockTasks := newUsecase(t)
+		notFound := domainerrors.NotFound("task not found")
+		mockTasks.EXPECT().GetTaskByID(ctx, viewer, uint(16)).Return(nil, notFound)
+
+		_, _, err := usecase.OpenAttachment(ctx, viewer, 16, 4)
+		assert.True(t, errors.Is(err, notFound))
+	})
+}
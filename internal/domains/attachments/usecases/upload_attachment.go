@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// maxFileNameLength is the length of the file_name column.
+const maxFileNameLength = 255
+
+func (u *usecase) UploadAttachment(ctx context.Context, actor entities.Actor, attachment *entities.Attachment, content io.Reader) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "attach files to tasks"); err != nil {
+		return err
+	}
+	if _, err := u.tasks.GetTaskByID(ctx, actor, attachment.TaskId); err != nil {
+		return err
+	}
+	attachment.FileName = strings.TrimSpace(attachment.FileName)
+	if attachment.FileName == "" || len(attachment.FileName) > maxFileNameLength {
+		return domainerrors.Validation(fmt.Sprintf("the file name must have 1 to %d characters", maxFileNameLength))
+	}
+
+	// Spool the upload to a temporary file: its hash names the blob, so it is known only once
+	// the whole content is read
+	file, err := os.CreateTemp(u.options.TempDir, "attachment-*")
+	if err != nil {
+		return domainerrors.Internal(err)
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), io.LimitReader(content, u.options.MaxSize+1))
+	if err != nil {
+		var pathErr *fs.PathError
+		if errors.As(err, &pathErr) {
+			return domainerrors.Internal(err)
+		}
+		return domainerrors.Validation("the file could not be read").WithCause(err)
+	}
+	if size > u.options.MaxSize {
+		return domainerrors.TooLarge(fmt.Sprintf("the file is larger than %d bytes", u.options.MaxSize)).
+			WithDetail("max_size", u.options.MaxSize)
+	}
+	if size == 0 {
+		return domainerrors.Validation("the file is empty")
+	}
+
+	// Trust the content, not the media type sent by the client
+	head := make([]byte, 512)
+	n, err := file.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return domainerrors.Internal(err)
+	}
+	contentType := http.DetectContentType(head[:n])
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if !lo.Contains(u.options.AllowedTypes, mediaType) {
+		return domainerrors.UnsupportedMedia(fmt.Sprintf("%s files are not allowed", mediaType)).
+			WithDetail("allowed_types", u.options.AllowedTypes)
+	}
+
+	attachment.Sha256 = hex.EncodeToString(hash.Sum(nil))
+	attachment.Size = size
+	attachment.ContentType = contentType
+	attachment.UploadedBy = actor.Name
+	key := blobKey(attachment.Sha256)
+	exists, err := u.blobs.Exists(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return domainerrors.Internal(err)
+		}
+		if err := u.blobs.Put(ctx, key, file, size); err != nil {
+			return err
+		}
+	}
+	return u.attachmentRepo.Create(ctx, attachment)
+}
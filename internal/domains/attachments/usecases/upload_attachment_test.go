@@ -0,0 +1,104 @@
+package usecases_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/attachments/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/attachments/interfaces"
+)
+
+var (
+	alice  = entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	viewer = entities.Actor{Name: "victor", UserId: "victor", Role: entities.WorkspaceRoleViewer}
+)
+
+// helloHash is the SHA-256 of "hello".
+const helloHash = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func newUsecase(t *testing.T) (usecases.AttachmentUsecase, *mocks.MockAttachmentRepository, *mocks.MockBlobStore, *mocks.MockTaskReader) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobs := mocks.NewMockBlobStore(ctrl)
+	mockTasks := mocks.NewMockTaskReader(ctrl)
+	usecase := usecases.NewAttachmentUsecase(mockRepo, mockBlobs, mockTasks, usecases.Options{
+		MaxSize:      16,
+		AllowedTypes: []string{"text/plain", "image/png"},
+		TempDir:      t.TempDir(),
+	})
+	return usecase, mockRepo, mockBlobs, mockTasks
+}
+
+func TestUploadAttachment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		usecase, mockRepo, mockBlobs, mockTasks := newUsecase(t)
+		mockTasks.EXPECT().GetTaskByID(ctx, alice, uint(15)).Return(&entities.Task{Id: 15}, nil)
+		mockBlobs.EXPECT().Exists(ctx, "sha256/2c/"+helloHash).Return(false, nil)
+		mockBlobs.EXPECT().Put(ctx, "sha256/2c/"+helloHash, gomock.Any(), int64(5)).DoAndReturn(
+			func(_ context.Context, _ string, content io.Reader, _ int64) error {
+				stored, err := io.ReadAll(content)
+				assert.NoError(t, err)
+				assert.Equal(t, "hello", string(stored))
+				return nil
+			},
+		)
+		mockRepo.EXPECT().Create(ctx, &entities.Attachment{
+			TaskId:      15,
+			FileName:    "hello.txt",
+			ContentType: "text/plain; charset=utf-8",
+			Size:        5,
+			Sha256:      helloHash,
+			UploadedBy:  "alice",
+		}).Return(nil)
+
+		err := usecase.UploadAttachment(ctx, alice, &entities.Attachment{TaskId: 15, FileName: "hello.txt"}, strings.NewReader("hello"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("SameContentIsStoredOnce", func(t *testing.T) {
+		usecase, mockRepo, mockBlobs, mockTasks := newUsecase(t)
+		mockTasks.EXPECT().GetTaskByID(ctx, alice, uint(15)).Return(&entities.Task{Id: 15}, nil)
+		mockBlobs.EXPECT().Exists(ctx, "sha256/2c/"+helloHash).Return(true, nil)
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		err := usecase.UploadAttachment(ctx, alice, &entities.Attachment{TaskId: 15, FileName: "copy.txt"}, strings.NewReader("hello"))
+		assert.NoError(t, err)
+	})
+
+	cases := []struct {
+		name         string
+		fileName     string
+		content      string
+		expectedKind domainerrors.Kind
+	}{
+		{"TooLarge", "big.txt", strings.Repeat("a", 17), domainerrors.KindTooLarge},
+		{"Empty", "empty.txt", "", domainerrors.KindValidation},
+		{"NoFileName", " ", "hello", domainerrors.KindValidation},
+		{"TypeNotAllowed", "page.html", "<html>hi</html>", domainerrors.KindUnsupportedMedia},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			usecase, _, _, mockTasks := newUsecase(t)
+			mockTasks.EXPECT().GetTaskByID(ctx, alice, uint(15)).Return(&entities.Task{Id: 15}, nil)
+
+			err := usecase.UploadAttachment(ctx, alice, &entities.Attachment{TaskId: 15, FileName: tc.fileName}, strings.NewReader(tc.content))
+			assert.True(t, domainerrors.IsKind(err, tc.expectedKind), err)
+		})
+	}
+
+	t.Run("Viewer", func(t *testing.T) {
+		usecase, _, _, _ := newUsecase(t)
+
+		err := usecase.UploadAttachment(ctx, viewer, &entities.Attachment{TaskId: 15, FileName: "hello.txt"}, strings.NewReader("hello"))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/comments/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+
+	"gorm.io/gorm"
+)
+
+var (
+	errCommentNotFound = domainerrors.NotFound("comment not found")
+	errNoWorkspace     = domainerrors.Internal(errors.New("repository: the context has no workspace"))
+)
+
+// Options tunes the comment repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type repository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewCommentRepository(db *gorm.DB, options Options) interfaces.CommentRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) Create(ctx context.Context, comment *entities.Comment) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	comment.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(comment).Error)
+}
+
+func (r *repository) GetByID(ctx context.Context, taskID, id uint) (*entities.Comment, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var comment entities.Comment
+	if err := db.Where("task_id = ?", taskID).Take(&comment, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &comment, nil
+}
+
+func (r *repository) List(ctx context.Context, taskID uint, query *models.ListCommentsQuery) ([]entities.Comment, string, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	tx := db.Where("task_id = ?", taskID)
+	if query.Cursor != "" {
+		_, id, err := helpers.DecodeCursor(query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		tx = tx.Where("id > ?", id)
+	}
+
+	// Fetch one extra row to know whether there is a next page.
+	comments := []entities.Comment{}
+	if err := tx.Order("id").Limit(query.Limit + 1).Find(&comments).Error; err != nil {
+		return nil, "", wrapError(err)
+	}
+	if len(comments) <= query.Limit {
+		return comments, "", nil
+	}
+	comments = comments[:query.Limit]
+	return comments, helpers.EncodeCursor("", comments[len(comments)-1].Id), nil
+}
+
+func (r *repository) UpdateBody(ctx context.Context, comment *entities.Comment) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Model(&entities.Comment{}).
+		Where("task_id = ? AND id = ?", comment.TaskId, comment.Id).
+		Updates(map[string]interface{}{"body": comment.Body, "edited_at": comment.EditedAt, "updated_at": comment.UpdatedAt})
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errCommentNotFound
+	}
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, taskID, id uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Where("task_id = ?", taskID).Delete(&entities.Comment{}, id)
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errCommentNotFound
+	}
+	return nil
+}
+
+// withContext binds the queries to ctx and to the workspace of ctx, bounded by the query timeout.
+func (r *repository) withContext(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	db := r.db.WithContext(ctx).Session(&gorm.Session{})
+	workspaceID, ok := helpers.WorkspaceIDFromContext(ctx)
+	if !ok {
+		_ = db.AddError(errNoWorkspace)
+		return db, cancel
+	}
+	return db.Where("workspace_id = ?", workspaceID).Session(&gorm.Session{}), cancel
+}
+
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errCommentNotFound
+	default:
+		return domainerrors.Internal(err)
+	}
+}
@@ -0,0 +1,101 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/comments/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/comments/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newRepository(t *testing.T) (interfaces.CommentRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewCommentRepository(db, repository.Options{}), mock
+}
+
+func TestCommentRepository(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 2)
+
+	t.Run("CreateUsesContextWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "comments" ("workspace_id","task_id","author","body","created_at","updated_at","edited_at","deleted_at")`)).
+			WithArgs(2, 15, "alice", "Looks good", sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+
+		comment := &entities.Comment{TaskId: 15, Author: "alice", Body: "Looks good"}
+		if assert.NoError(t, repo.Create(ctx, comment)) {
+			assert.Equal(t, uint(2), comment.WorkspaceId)
+			assert.Equal(t, uint(4), comment.Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListPage", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "comments" WHERE workspace_id = $1 AND task_id = $2 AND id > $3 AND "comments"."deleted_at" IS NULL ORDER BY id LIMIT 3`)).
+			WithArgs(2, 15, 4).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "task_id"}).AddRow(5, 15).AddRow(6, 15).AddRow(7, 15))
+
+		comments, nextCursor, err := repo.List(ctx, 15, &models.ListCommentsQuery{Limit: 2, Cursor: helpers.EncodeCursor("", 4)})
+
+		if assert.NoError(t, err) && assert.Len(t, comments, 2) {
+			assert.Equal(t, uint(6), comments[1].Id)
+			assert.Equal(t, helpers.EncodeCursor("", 6), nextCursor)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateBodyOfAnotherTask", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "comments" SET "body"=$1,"edited_at"=$2,"updated_at"=$3 WHERE workspace_id = $4 AND (task_id = $5 AND id = $6) AND "comments"."deleted_at" IS NULL`)).
+			WithArgs("Edited", sqlmock.AnyArg(), sqlmock.AnyArg(), 2, 16, 4).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		now := time.Now()
+		err := repo.UpdateBody(ctx, &entities.Comment{Id: 4, TaskId: 16, Body: "Edited", EditedAt: &now, UpdatedAt: now})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteIsSoft", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "comments" SET "deleted_at"=$1 WHERE workspace_id = $2 AND task_id = $3 AND "comments"."id" = $4 AND "comments"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), 2, 15, 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Delete(ctx, 15, 4))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetDeleted", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "comments" WHERE workspace_id = $1 AND task_id = $2 AND "comments"."id" = $3 AND "comments"."deleted_at" IS NULL LIMIT 1`)).
+			WithArgs(2, 15, 4).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetByID(ctx, 15, 4)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// CreateComment comments on a task
+// @Summary Comment on a task
+// @Description Add a Markdown comment to a task the caller can read; the caller is its author
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param comment body models.CommentRequest true "Comment"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=entities.Comment} "Comment created"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/comments [post]
+func (h *Handler) CreateComment(c echo.Context) error {
+	taskID, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+	req := new(models.CommentRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	comment := &entities.Comment{TaskId: taskID, Body: req.Body}
+	if err := h.CommentUsecase.CreateComment(c.Request().Context(), interfaces.ActorFrom(c), comment); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Comment created", comment))
+}
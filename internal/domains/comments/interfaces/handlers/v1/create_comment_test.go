@@ -0,0 +1,79 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/comments/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/comments/usecases"
+)
+
+func TestCreateComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockCommentUsecase(ctrl)
+	handler := &handlers.Handler{CommentUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+id+"/comments", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/comments")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().CreateComment(gomock.Any(), anonymous, &entities.Comment{TaskId: 15, Body: "Looks **good**"}).DoAndReturn(
+			func(_ context.Context, actor entities.Actor, comment *entities.Comment) error {
+				comment.Id = 4
+				comment.Author = actor.Name
+				return nil
+			},
+		)
+
+		c, rec := newContext("15", `{"body": "Looks **good**"}`)
+		if assert.NoError(t, handler.CreateComment(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+
+			var response struct {
+				Data map[string]any `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "anonymous", response.Data["author"])
+			assert.Nil(t, response.Data["edited_at"])
+			assert.NotContains(t, response.Data, "deleted_at")
+		}
+	})
+
+	t.Run("BadRequest_EmptyBody", func(t *testing.T) {
+		c, rec := newContext("15", `{"body": ""}`)
+		if assert.Error(t, invoke(handler.CreateComment, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("TaskNotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().CreateComment(gomock.Any(), anonymous, gomock.Any()).Return(domainerrors.NotFound("task not found"))
+
+		c, rec := newContext("16", `{"body": "Looks good"}`)
+		if assert.Error(t, invoke(handler.CreateComment, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// DeleteComment deletes a comment
+// @Summary Delete a comment
+// @Description Soft delete a comment, which is no longer listed nor counted. Only its author or an admin can.
+// @Tags comments
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param comment_id path int true "Comment ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 204 "Comment deleted"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the author of the comment"
+// @Failure 404 {object} models.ProblemDetails "Task or comment not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/comments/{comment_id} [delete]
+func (h *Handler) DeleteComment(c echo.Context) error {
+	taskID, id, err := parseCommentPath(c)
+	if err != nil {
+		return err
+	}
+	if err := h.CommentUsecase.DeleteComment(c.Request().Context(), interfaces.ActorFrom(c), taskID, id); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,50 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/comments/interfaces/handlers/v1"
+	mocks "github.com/supachai1998/task_services/internal/mocks/comments/usecases"
+)
+
+func TestDeleteComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockCommentUsecase(ctrl)
+	handler := &handlers.Handler{CommentUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(commentID string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodDelete, "/v1/tasks/15/comments/"+commentID, nil), rec)
+		c.SetPath("/v1/tasks/:id/comments/:comment_id")
+		c.SetParamNames("id", "comment_id")
+		c.SetParamValues("15", commentID)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteComment(gomock.Any(), anonymous, uint(15), uint(4)).Return(nil)
+
+		c, rec := newContext("4")
+		if assert.NoError(t, handler.DeleteComment(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteComment(gomock.Any(), anonymous, uint(15), uint(9)).Return(domainerrors.NotFound("comment not found"))
+
+		c, rec := newContext("9")
+		if assert.Error(t, invoke(handler.DeleteComment, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+}
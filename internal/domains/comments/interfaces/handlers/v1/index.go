@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/comments/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+type Handler struct {
+	CommentUsecase usecases.CommentUsecase
+}
+
+func NewCommentHandler(e *echo.Echo, commentUsecase usecases.CommentUsecase) {
+	handler := &Handler{
+		CommentUsecase: commentUsecase,
+	}
+	// Comments are a subresource of tasks, so they take the task scopes of API keys; the usecases
+	// check that the task can be read and that only authors change their comments.
+	read := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleViewer),
+		interfaces.RequireScope(entities.ScopeTasksRead),
+	}
+	write := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleMember),
+		interfaces.RequireScope(entities.ScopeTasksWrite),
+	}
+	remove := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleMember),
+		interfaces.RequireScope(entities.ScopeTasksDelete),
+	}
+
+	e.POST("/v1/tasks/:id/comments", handler.CreateComment, write...)
+	e.GET("/v1/tasks/:id/comments", handler.ListComments, read...)
+	e.PATCH("/v1/tasks/:id/comments/:comment_id", handler.UpdateComment, write...)
+	e.DELETE("/v1/tasks/:id/comments/:comment_id", handler.DeleteComment, remove...)
+}
+
+// parseIDParam reads an ID path parameter named name.
+func parseIDParam(c echo.Context, name string) (uint, error) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 0)
+	if err != nil {
+		return 0, domainerrors.Validation("Invalid ID format")
+	}
+	return uint(id), nil
+}
+
+// parseCommentPath reads the task ID and the comment ID path parameters.
+func parseCommentPath(c echo.Context) (uint, uint, error) {
+	taskID, err := parseIDParam(c, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := parseIDParam(c, "comment_id")
+	if err != nil {
+		return 0, 0, err
+	}
+	return taskID, id, nil
+}
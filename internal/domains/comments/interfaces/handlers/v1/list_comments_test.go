@@ -0,0 +1,53 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/comments/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/comments/usecases"
+)
+
+func TestListComments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockCommentUsecase(ctrl)
+	handler := &handlers.Handler{CommentUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(target string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, target, nil), rec)
+		c.SetPath("/v1/tasks/:id/comments")
+		c.SetParamNames("id")
+		c.SetParamValues("15")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().ListComments(gomock.Any(), anonymous, uint(15), &models.ListCommentsQuery{Limit: 2}).
+			Return([]entities.Comment{{Id: 4}, {Id: 5}}, "next", nil)
+
+		c, rec := newContext("/v1/tasks/15/comments?limit=2")
+		if assert.NoError(t, handler.ListComments(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"next_cursor":"next"`)
+		}
+	})
+
+	t.Run("BadRequest_Limit", func(t *testing.T) {
+		c, rec := newContext("/v1/tasks/15/comments?limit=500")
+		if assert.Error(t, invoke(handler.ListComments, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// UpdateComment edits a comment
+// @Summary Edit a comment
+// @Description Replace the body of a comment and set its edited_at. Only its author or an admin can.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param comment_id path int true "Comment ID"
+// @Param comment body models.CommentRequest true "Comment"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=entities.Comment} "Comment updated"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the author of the comment"
+// @Failure 404 {object} models.ProblemDetails "Task or comment not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/comments/{comment_id} [patch]
+func (h *Handler) UpdateComment(c echo.Context) error {
+	taskID, id, err := parseCommentPath(c)
+	if err != nil {
+		return err
+	}
+	req := new(models.CommentRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	comment, err := h.CommentUsecase.UpdateComment(c.Request().Context(), interfaces.ActorFrom(c), taskID, id, req.Body)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Comment updated", comment))
+}
@@ -0,0 +1,65 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/comments/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/comments/usecases"
+)
+
+func TestUpdateComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockCommentUsecase(ctrl)
+	handler := &handlers.Handler{CommentUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(commentID, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPatch, "/v1/tasks/15/comments/"+commentID, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/comments/:comment_id")
+		c.SetParamNames("id", "comment_id")
+		c.SetParamValues("15", commentID)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateComment(gomock.Any(), anonymous, uint(15), uint(4), "Edited").
+			Return(&entities.Comment{Id: 4, TaskId: 15, Body: "Edited"}, nil)
+
+		c, rec := newContext("4", `{"body": "Edited"}`)
+		if assert.NoError(t, handler.UpdateComment(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("NotAuthor", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateComment(gomock.Any(), anonymous, uint(15), uint(5), "Edited").
+			Return(nil, domainerrors.Forbidden("only the author of the comment can change it"))
+
+		c, rec := newContext("5", `{"body": "Edited"}`)
+		if assert.Error(t, invoke(handler.UpdateComment, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidCommentID", func(t *testing.T) {
+		c, rec := newContext("abc", `{"body": "Edited"}`)
+		if assert.Error(t, invoke(handler.UpdateComment, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// CommentRepository stores the comments of the tasks of the workspace of ctx. Deleted comments
+// are not found.
+type CommentRepository interface {
+	Create(ctx context.Context, comment *entities.Comment) error
+	// GetByID reads a comment of the task taskID.
+	GetByID(ctx context.Context, taskID, id uint) (*entities.Comment, error)
+	// List returns one page of the comments of a task, oldest first, and the cursor of the next page.
+	List(ctx context.Context, taskID uint, query *models.ListCommentsQuery) ([]entities.Comment, string, error)
+	// UpdateBody saves the body of a comment, and its edited_at and updated_at.
+	UpdateBody(ctx context.Context, comment *entities.Comment) error
+	// Delete soft deletes a comment of the task taskID.
+	Delete(ctx context.Context, taskID, id uint) error
+}
+
+// TaskReader reads the task comments belong to, failing when the actor cannot read it.
+type TaskReader interface {
+	GetTaskByID(ctx context.Context, actor entities.Actor, id uint) (*entities.Task, error)
+}
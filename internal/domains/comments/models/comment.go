@@ -0,0 +1,16 @@
+package models
+
+// DefaultListLimit is the page size used when the list query has no limit.
+const DefaultListLimit = 20
+
+// CommentRequest is the body of the requests writing or editing a comment.
+type CommentRequest struct {
+	// Body is Markdown.
+	Body string `json:"body" validate:"required,min=1,max=10000" example:"Reproduced on **staging**, see the logs."`
+}
+
+// ListCommentsQuery is the pagination query of GET /v1/tasks/{id}/comments.
+type ListCommentsQuery struct {
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `query:"cursor" validate:"omitempty,max=512"`
+}
@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) CreateComment(ctx context.Context, actor entities.Actor, comment *entities.Comment) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "comment on tasks"); err != nil {
+		return err
+	}
+	if _, err := u.tasks.GetTaskByID(ctx, actor, comment.TaskId); err != nil {
+		return err
+	}
+	comment.Author = actor.Name
+	return u.commentRepo.Create(ctx, comment)
+}
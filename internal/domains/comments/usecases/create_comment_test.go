@@ -0,0 +1,56 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/comments/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/comments/interfaces"
+)
+
+var (
+	alice  = entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	bob    = entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleMember}
+	viewer = entities.Actor{Name: "victor", UserId: "victor", Role: entities.WorkspaceRoleViewer}
+)
+
+func newUsecase(t *testing.T) (usecases.CommentUsecase, *mocks.MockCommentRepository, *mocks.MockTaskReader) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	mockRepo := mocks.NewMockCommentRepository(ctrl)
+	mockTasks := mocks.NewMockTaskReader(ctrl)
+	return usecases.NewCommentUsecase(mockRepo, mockTasks), mockRepo, mockTasks
+}
+
+func TestCreateComment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		usecase, mockRepo, mockTasks := newUsecase(t)
+		mockTasks.EXPECT().GetTaskByID(ctx, alice, uint(15)).Return(&entities.Task{Id: 15}, nil)
+		mockRepo.EXPECT().Create(ctx, &entities.Comment{TaskId: 15, Author: "alice", Body: "Looks good"}).Return(nil)
+
+		assert.NoError(t, usecase.CreateComment(ctx, alice, &entities.Comment{TaskId: 15, Body: "Looks good"}))
+	})
+
+	t.Run("TaskNotFound", func(t *testing.T) {
+		usecase, _, mockTasks := newUsecase(t)
+		notFound := domainerrors.NotFound("task not found")
+		mockTasks.EXPECT().GetTaskByID(ctx, alice, uint(16)).Return(nil, notFound)
+
+		err := usecase.CreateComment(ctx, alice, &entities.Comment{TaskId: 16, Body: "Looks good"})
+		assert.True(t, errors.Is(err, notFound))
+	})
+
+	t.Run("Viewer", func(t *testing.T) {
+		usecase, _, _ := newUsecase(t)
+
+		err := usecase.CreateComment(ctx, viewer, &entities.Comment{TaskId: 15, Body: "Looks good"})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
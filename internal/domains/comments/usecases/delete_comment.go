@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// DeleteComment soft deletes a comment; it is no longer listed nor counted.
+func (u *usecase) DeleteComment(ctx context.Context, actor entities.Actor, taskID, id uint) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "comment on tasks"); err != nil {
+		return err
+	}
+	comment, err := u.getComment(ctx, actor, taskID, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeAuthor(actor, comment); err != nil {
+		return err
+	}
+	return u.commentRepo.Delete(ctx, taskID, id)
+}
@@ -0,0 +1,43 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestDeleteComment(t *testing.T) {
+	ctx := context.Background()
+	comment := &entities.Comment{Id: 4, TaskId: 15, Author: "alice"}
+
+	t.Run("Author", func(t *testing.T) {
+		usecase, mockRepo, mockTasks := newUsecase(t)
+		mockTasks.EXPECT().GetTaskByID(ctx, alice, uint(15)).Return(&entities.Task{Id: 15}, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(15), uint(4)).Return(comment, nil)
+		mockRepo.EXPECT().Delete(ctx, uint(15), uint(4)).Return(nil)
+
+		assert.NoError(t, usecase.DeleteComment(ctx, alice, 15, 4))
+	})
+
+	t.Run("OtherMember", func(t *testing.T) {
+		usecase, mockRepo, mockTasks := newUsecase(t)
+		mockTasks.EXPECT().GetTaskByID(ctx, bob, uint(15)).Return(&entities.Task{Id: 15}, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(15), uint(4)).Return(comment, nil)
+
+		err := usecase.DeleteComment(ctx, bob, 15, 4)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+
+	t.Run("AuthenticationDisabled", func(t *testing.T) {
+		usecase, mockRepo, mockTasks := newUsecase(t)
+		anonymous := entities.Actor{Name: "anonymous"}
+		mockTasks.EXPECT().GetTaskByID(ctx, anonymous, uint(15)).Return(&entities.Task{Id: 15}, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(15), uint(4)).Return(comment, nil)
+		mockRepo.EXPECT().Delete(ctx, uint(15), uint(4)).Return(nil)
+
+		assert.NoError(t, usecase.DeleteComment(ctx, anonymous, 15, 4))
+	})
+}
@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/comments/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type CommentUsecase interface {
+	// CreateComment adds a comment written by actor to the task comment.TaskId.
+	CreateComment(ctx context.Context, actor entities.Actor, comment *entities.Comment) error
+	// ListComments returns one page of the comments of a task, oldest first, and the cursor of the next page.
+	ListComments(ctx context.Context, actor entities.Actor, taskID uint, query *models.ListCommentsQuery) ([]entities.Comment, string, error)
+	// UpdateComment replaces the body of a comment.
+	UpdateComment(ctx context.Context, actor entities.Actor, taskID, id uint, body string) (*entities.Comment, error)
+	DeleteComment(ctx context.Context, actor entities.Actor, taskID, id uint) error
+}
+
+var errNotAuthor = domainerrors.Forbidden("only the author of the comment can change it")
+
+type usecase struct {
+	commentRepo interfaces.CommentRepository
+	tasks       interfaces.TaskReader
+}
+
+func NewCommentUsecase(commentRepo interfaces.CommentRepository, tasks interfaces.TaskReader) CommentUsecase {
+	return &usecase{commentRepo, tasks}
+}
+
+// Authorization policy: reading the comments of a task takes reading the task, and writing one
+// also takes the member role. Only the author of a comment edits or deletes it, unless the actor
+// is an admin or anonymous, which only happens when authentication is disabled.
+
+// authorizeRole fails when the workspace role of actor does not include required.
+func authorizeRole(actor entities.Actor, required entities.WorkspaceRole, action string) error {
+	if unrestricted(actor) || actor.Role.Includes(required) {
+		return nil
+	}
+	return domainerrors.Forbidden(fmt.Sprintf("the %s role is required to %s", required, action)).
+		WithDetail("role", actor.Role).
+		WithDetail("required_role", required)
+}
+
+// authorizeAuthor fails unless actor wrote comment.
+func authorizeAuthor(actor entities.Actor, comment *entities.Comment) error {
+	if unrestricted(actor) || comment.Author == actor.UserId {
+		return nil
+	}
+	return errNotAuthor
+}
+
+// unrestricted reports whether the policy does not apply to actor.
+func unrestricted(actor entities.Actor) bool {
+	return actor.Admin || actor.Role == entities.WorkspaceRoleAdmin || actor.UserId == ""
+}
+
+// getComment reads a comment of a task the actor can read.
+func (u *usecase) getComment(ctx context.Context, actor entities.Actor, taskID, id uint) (*entities.Comment, error) {
+	if _, err := u.tasks.GetTaskByID(ctx, actor, taskID); err != nil {
+		return nil, err
+	}
+	return u.commentRepo.GetByID(ctx, taskID, id)
+}
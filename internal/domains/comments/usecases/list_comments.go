@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListComments(ctx context.Context, actor entities.Actor, taskID uint, query *models.ListCommentsQuery) ([]entities.Comment, string, error) {
+	if _, err := u.tasks.GetTaskByID(ctx, actor, taskID); err != nil {
+		return nil, "", err
+	}
+	if query.Limit <= 0 {
+		query.Limit = models.DefaultListLimit
+	}
+	return u.commentRepo.List(ctx, taskID, query)
+}
@@ -0,0 +1,25 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/comments/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestListComments(t *testing.T) {
+	ctx := context.Background()
+	usecase, mockRepo, mockTasks := newUsecase(t)
+	// Viewers read the comments of the tasks they can read.
+	mockTasks.EXPECT().GetTaskByID(ctx, viewer, uint(15)).Return(&entities.Task{Id: 15}, nil)
+	mockRepo.EXPECT().List(ctx, uint(15), &models.ListCommentsQuery{Limit: models.DefaultListLimit}).
+		Return([]entities.Comment{{Id: 4, TaskId: 15}}, "", nil)
+
+	comments, _, err := usecase.ListComments(ctx, viewer, 15, &models.ListCommentsQuery{})
+
+	if assert.NoError(t, err) {
+		assert.Len(t, comments, 1)
+	}
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) UpdateComment(ctx context.Context, actor entities.Actor, taskID, id uint, body string) (*entities.Comment, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "comment on tasks"); err != nil {
+		return nil, err
+	}
+	comment, err := u.getComment(ctx, actor, taskID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeAuthor(actor, comment); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	comment.Body = body
+	comment.EditedAt = &now
+	comment.UpdatedAt = now
+	if err := u.commentRepo.UpdateBody(ctx, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
@@ -0,0 +1,49 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestUpdateComment(t *testing.T) {
+	ctx := context.Background()
+	admin := entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleAdmin}
+
+	cases := []struct {
+		name         string
+		actor        entities.Actor
+		expectedKind domainerrors.Kind
+	}{
+		{"Author", alice, ""},
+		{"WorkspaceAdmin", admin, ""},
+		{"OtherMember", bob, domainerrors.KindForbidden},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			usecase, mockRepo, mockTasks := newUsecase(t)
+			mockTasks.EXPECT().GetTaskByID(ctx, tc.actor, uint(15)).Return(&entities.Task{Id: 15}, nil)
+			mockRepo.EXPECT().GetByID(ctx, uint(15), uint(4)).Return(&entities.Comment{Id: 4, TaskId: 15, Author: "alice", Body: "Old"}, nil)
+			if tc.expectedKind == "" {
+				mockRepo.EXPECT().UpdateBody(ctx, gomock.Any()).Return(nil)
+			}
+
+			comment, err := usecase.UpdateComment(ctx, tc.actor, 15, 4, "New")
+
+			if tc.expectedKind == "" {
+				if assert.NoError(t, err) {
+					assert.Equal(t, "New", comment.Body)
+					assert.Equal(t, "alice", comment.Author)
+					assert.NotNil(t, comment.EditedAt)
+				}
+				return
+			}
+			assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/labels/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// sqlStateUniqueViolation is the SQLSTATE of a unique_violation.
+	sqlStateUniqueViolation = "23505"
+	// labelNameIndex keeps the names of the labels of a workspace unique, ignoring case.
+	labelNameIndex = "idx_labels_workspace_name"
+)
+
+var (
+	errLabelNotFound  = domainerrors.NotFound("label not found")
+	errLabelNameTaken = domainerrors.Conflict("a label with this name already exists")
+	errNoWorkspace    = domainerrors.Internal(errors.New("repository: the context has no workspace"))
+)
+
+// Options tunes the label repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type repository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewLabelRepository(db *gorm.DB, options Options) interfaces.LabelRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) Create(ctx context.Context, label *entities.Label) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	label.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(label).Error)
+}
+
+func (r *repository) GetByID(ctx context.Context, id uint) (*entities.Label, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var label entities.Label
+	if err := db.Take(&label, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &label, nil
+}
+
+func (r *repository) List(ctx context.Context) ([]entities.Label, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	labels := []entities.Label{}
+	if err := db.Order("name, id").Find(&labels).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return labels, nil
+}
+
+func (r *repository) Update(ctx context.Context, label *entities.Label) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	label.UpdatedAt = time.Now()
+	result := db.Model(&entities.Label{}).
+		Where("id = ?", label.Id).
+		Updates(map[string]any{"name": label.Name, "color": label.Color, "updated_at": label.UpdatedAt})
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errLabelNotFound
+	}
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Delete(&entities.Label{}, id)
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errLabelNotFound
+	}
+	return nil
+}
+
+// withContext binds the queries to ctx and to the workspace of ctx, bounded by the query timeout.
+func (r *repository) withContext(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	db := r.db.WithContext(ctx).Session(&gorm.Session{})
+	workspaceID, ok := helpers.WorkspaceIDFromContext(ctx)
+	if !ok {
+		_ = db.AddError(errNoWorkspace)
+		return db, cancel
+	}
+	return db.Where("workspace_id = ?", workspaceID).Session(&gorm.Session{}), cancel
+}
+
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errLabelNotFound
+	case isUniqueViolation(err, labelNameIndex):
+		return errLabelNameTaken
+	default:
+		return domainerrors.Internal(err)
+	}
+}
+
+// isUniqueViolation reports whether a row duplicated the unique key of constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == sqlStateUniqueViolation && pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgconn"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/labels/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/labels/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newRepository(t *testing.T) (interfaces.LabelRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewLabelRepository(db, repository.Options{}), mock
+}
+
+func TestLabelRepository(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 2)
+
+	t.Run("CreateUsesContextWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "labels" ("workspace_id","name","color","created_by","created_at","updated_at")`)).
+			WithArgs(2, "bug", "#d73a4a", "alice", sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+
+		label := &entities.Label{Name: "bug", Color: "#d73a4a", CreatedBy: lo.ToPtr("alice")}
+		if assert.NoError(t, repo.Create(ctx, label)) {
+			assert.Equal(t, uint(2), label.WorkspaceId)
+			assert.Equal(t, uint(7), label.Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateDuplicateName", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "labels"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_labels_workspace_name"})
+
+		err := repo.Create(ctx, &entities.Label{Name: "Bug", Color: "#d73a4a"})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "labels" WHERE workspace_id = $1 ORDER BY name, id`)).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(7, "bug"))
+
+		labels, err := repo.List(ctx)
+
+		if assert.NoError(t, err) && assert.Len(t, labels, 1) {
+			assert.Equal(t, "bug", labels[0].Name)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "labels" SET "color"=$1,"name"=$2,"updated_at"=$3 WHERE workspace_id = $4 AND id = $5`)).
+			WithArgs("#0075ca", "ui", sqlmock.AnyArg(), 2, 7).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Update(ctx, &entities.Label{Id: 7, Name: "ui", Color: "#0075ca"})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "labels" WHERE workspace_id = $1 AND "labels"."id" = $2`)).
+			WithArgs(2, 7).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(ctx, 7)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoWorkspace", func(t *testing.T) {
+		repo, _ := newRepository(t)
+
+		_, err := repo.List(context.Background())
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+	})
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/labels/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// CreateLabel creates a label
+// @Summary Create a label
+// @Description Create a label of the workspace; its name must be unique in the workspace, ignoring case
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param label body models.LabelRequest true "Label"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=entities.Label} "Label created"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 409 {object} models.ProblemDetails "A label with this name exists"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/labels [post]
+func (h *Handler) CreateLabel(c echo.Context) error {
+	req := new(models.LabelRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	label := &entities.Label{Name: req.Name, Color: req.Color}
+	if err := h.LabelUsecase.CreateLabel(c.Request().Context(), interfaces.ActorFrom(c), label); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Label created", label))
+}
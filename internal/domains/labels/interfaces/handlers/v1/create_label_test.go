@@ -0,0 +1,77 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/labels/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/labels/usecases"
+)
+
+func TestCreateLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockLabelUsecase(ctrl)
+	handler := &handlers.Handler{LabelUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/labels", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/labels")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().CreateLabel(gomock.Any(), anonymous, &entities.Label{Name: "bug", Color: "#d73a4a"}).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, label *entities.Label) error {
+				label.Id = 7
+				return nil
+			},
+		)
+
+		c, rec := newContext(`{"name": "bug", "color": "#d73a4a"}`)
+		if assert.NoError(t, handler.CreateLabel(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+
+			var response struct {
+				Data entities.Label `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, uint(7), response.Data.Id)
+		}
+	})
+
+	t.Run("BadRequest_Color", func(t *testing.T) {
+		for _, color := range []string{"red", "#fff", "#d73a4a00"} {
+			c, rec := newContext(`{"name": "bug", "color": "` + color + `"}`)
+			if assert.Error(t, invoke(handler.CreateLabel, c), color) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+			}
+		}
+	})
+
+	t.Run("NameTaken", func(t *testing.T) {
+		mockUsecase.EXPECT().CreateLabel(gomock.Any(), anonymous, gomock.Any()).
+			Return(domainerrors.Conflict("a label with this name already exists"))
+
+		c, rec := newContext(`{"name": "Bug", "color": "#d73a4a"}`)
+		if assert.Error(t, invoke(handler.CreateLabel, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// DeleteLabel deletes a label
+// @Summary Delete a label
+// @Description Delete a label and remove it from every task; only admins of the workspace can
+// @Tags labels
+// @Produce json
+// @Param id path int true "Label ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 204 "Label deleted"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Label not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/labels/{id} [delete]
+func (h *Handler) DeleteLabel(c echo.Context) error {
+	id, err := parseLabelID(c)
+	if err != nil {
+		return err
+	}
+	if err := h.LabelUsecase.DeleteLabel(c.Request().Context(), interfaces.ActorFrom(c), id); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
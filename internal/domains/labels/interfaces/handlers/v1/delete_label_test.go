@@ -0,0 +1,51 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/labels/interfaces/handlers/v1"
+	mocks "github.com/supachai1998/task_services/internal/mocks/labels/usecases"
+)
+
+func TestDeleteLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockLabelUsecase(ctrl)
+	handler := &handlers.Handler{LabelUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodDelete, "/v1/labels/"+id, nil), rec)
+		c.SetPath("/v1/labels/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteLabel(gomock.Any(), anonymous, uint(7)).Return(nil)
+
+		c, rec := newContext("7")
+		if assert.NoError(t, handler.DeleteLabel(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteLabel(gomock.Any(), anonymous, uint(8)).
+			Return(domainerrors.Forbidden("the admin role is required to delete labels"))
+
+		c, rec := newContext("8")
+		if assert.Error(t, invoke(handler.DeleteLabel, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	})
+}
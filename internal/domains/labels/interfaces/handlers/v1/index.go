@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/labels/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+type Handler struct {
+	LabelUsecase usecases.LabelUsecase
+}
+
+func NewLabelHandler(e *echo.Echo, labelUsecase usecases.LabelUsecase) {
+	handler := &Handler{
+		LabelUsecase: labelUsecase,
+	}
+	// Labels belong to the tasks of the workspace, so they take the task scopes of API keys.
+	read := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleViewer),
+		interfaces.RequireScope(entities.ScopeTasksRead),
+	}
+	write := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleMember),
+		interfaces.RequireScope(entities.ScopeTasksWrite),
+	}
+	remove := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleAdmin),
+		interfaces.RequireScope(entities.ScopeTasksDelete),
+	}
+
+	e.POST("/v1/labels", handler.CreateLabel, write...)
+	e.GET("/v1/labels", handler.ListLabels, read...)
+	e.PUT("/v1/labels/:id", handler.UpdateLabel, write...)
+	e.DELETE("/v1/labels/:id", handler.DeleteLabel, remove...)
+}
+
+// parseLabelID reads the label ID path parameter.
+func parseLabelID(c echo.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		return 0, domainerrors.Validation("Invalid ID format")
+	}
+	return uint(id), nil
+}
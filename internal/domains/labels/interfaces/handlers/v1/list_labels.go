@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// ListLabels lists the labels
+// @Summary List the labels
+// @Description List the labels of the workspace, by name
+// @Tags labels
+// @Produce json
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=[]entities.Label} "Labels listed"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/labels [get]
+func (h *Handler) ListLabels(c echo.Context) error {
+	labels, err := h.LabelUsecase.ListLabels(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Labels listed", labels))
+}
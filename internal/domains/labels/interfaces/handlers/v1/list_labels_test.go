@@ -0,0 +1,44 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/labels/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/labels/usecases"
+)
+
+func TestListLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockLabelUsecase(ctrl)
+	handler := &handlers.Handler{LabelUsecase: mockUsecase}
+	e := echo.New()
+
+	mockUsecase.EXPECT().ListLabels(gomock.Any()).Return([]entities.Label{
+		{Id: 7, Name: "bug", Color: "#d73a4a"},
+		{Id: 8, Name: "ui", Color: "#0075ca"},
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/labels", nil), rec)
+
+	if assert.NoError(t, handler.ListLabels(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Data []entities.Label `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		if assert.Len(t, response.Data, 2) {
+			assert.Equal(t, "#0075ca", response.Data[1].Color)
+		}
+	}
+}
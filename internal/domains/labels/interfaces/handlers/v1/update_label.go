@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/labels/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// UpdateLabel replaces a label
+// @Summary Update a label
+// @Description Rename or recolor a label; the tasks with the label show the change at once
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param id path int true "Label ID"
+// @Param label body models.LabelRequest true "Label"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=entities.Label} "Label updated"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Label not found"
+// @Failure 409 {object} models.ProblemDetails "A label with this name exists"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/labels/{id} [put]
+func (h *Handler) UpdateLabel(c echo.Context) error {
+	id, err := parseLabelID(c)
+	if err != nil {
+		return err
+	}
+	req := new(models.LabelRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	label := &entities.Label{Id: id, Name: req.Name, Color: req.Color}
+	if err := h.LabelUsecase.UpdateLabel(c.Request().Context(), interfaces.ActorFrom(c), label); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Label updated", label))
+}
@@ -0,0 +1,63 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/labels/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/labels/usecases"
+)
+
+func TestUpdateLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockLabelUsecase(ctrl)
+	handler := &handlers.Handler{LabelUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/v1/labels/"+id, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/labels/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateLabel(gomock.Any(), anonymous, &entities.Label{Id: 7, Name: "defect", Color: "#0075ca"}).Return(nil)
+
+		c, rec := newContext("7", `{"name": "defect", "color": "#0075ca"}`)
+		if assert.NoError(t, handler.UpdateLabel(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateLabel(gomock.Any(), anonymous, gomock.Any()).Return(domainerrors.NotFound("label not found"))
+
+		c, rec := newContext("9", `{"name": "defect", "color": "#0075ca"}`)
+		if assert.Error(t, invoke(handler.UpdateLabel, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidID", func(t *testing.T) {
+		c, rec := newContext("abc", `{"name": "defect", "color": "#0075ca"}`)
+		if assert.Error(t, invoke(handler.UpdateLabel, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
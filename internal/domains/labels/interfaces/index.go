@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// LabelRepository stores the labels of the workspace of ctx.
+type LabelRepository interface {
+	Create(ctx context.Context, label *entities.Label) error
+	GetByID(ctx context.Context, id uint) (*entities.Label, error)
+	// List returns every label, by name.
+	List(ctx context.Context) ([]entities.Label, error)
+	// Update saves the name and the color of a label.
+	Update(ctx context.Context, label *entities.Label) error
+	// Delete deletes a label and removes it from its tasks.
+	Delete(ctx context.Context, id uint) error
+}
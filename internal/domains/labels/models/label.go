@@ -0,0 +1,8 @@
+package models
+
+// LabelRequest is the body of the requests creating or replacing a label.
+type LabelRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=50" example:"bug"`
+	// Color is a hex color of six digits.
+	Color string `json:"color" validate:"required,len=7,hexcolor" example:"#d73a4a"`
+}
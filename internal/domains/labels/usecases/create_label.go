@@ -0,0 +1,21 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) CreateLabel(ctx context.Context, actor entities.Actor, label *entities.Label) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "create labels"); err != nil {
+		return err
+	}
+	if err := normalize(label); err != nil {
+		return err
+	}
+	if actor.UserId != "" {
+		label.CreatedBy = lo.ToPtr(actor.UserId)
+	}
+	return u.labelRepo.Create(ctx, label)
+}
@@ -0,0 +1,41 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/labels/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/labels/interfaces"
+)
+
+func TestCreateLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockLabelRepository(ctrl)
+	usecase := usecases.NewLabelUsecase(mockRepo)
+	member := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().Create(ctx, &entities.Label{Name: "bug", Color: "#d73a4a", CreatedBy: lo.ToPtr("alice")}).Return(nil)
+
+		assert.NoError(t, usecase.CreateLabel(ctx, member, &entities.Label{Name: " bug ", Color: "#D73A4A"}))
+	})
+
+	t.Run("BlankName", func(t *testing.T) {
+		err := usecase.CreateLabel(ctx, member, &entities.Label{Name: "  ", Color: "#d73a4a"})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+
+	t.Run("Viewer", func(t *testing.T) {
+		viewer := entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleViewer}
+		err := usecase.CreateLabel(ctx, viewer, &entities.Label{Name: "bug", Color: "#d73a4a"})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
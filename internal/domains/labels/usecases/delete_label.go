@@ -0,0 +1,15 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// DeleteLabel is reserved to admins, since it changes every task with the label.
+func (u *usecase) DeleteLabel(ctx context.Context, actor entities.Actor, id uint) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleAdmin, "delete labels"); err != nil {
+		return err
+	}
+	return u.labelRepo.Delete(ctx, id)
+}
@@ -0,0 +1,48 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/labels/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/labels/interfaces"
+)
+
+func TestDeleteLabel(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name         string
+		actor        entities.Actor
+		expectedKind domainerrors.Kind
+	}{
+		{"WorkspaceAdmin", entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleAdmin}, ""},
+		{"Anonymous", entities.Actor{Name: "anonymous"}, ""},
+		{"Member", entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}, domainerrors.KindForbidden},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockLabelRepository(ctrl)
+			usecase := usecases.NewLabelUsecase(mockRepo)
+			if tc.expectedKind == "" {
+				mockRepo.EXPECT().Delete(ctx, uint(7)).Return(nil)
+			}
+
+			err := usecase.DeleteLabel(ctx, tc.actor, 7)
+
+			if tc.expectedKind == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+		})
+	}
+}
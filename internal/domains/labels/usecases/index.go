@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/labels/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type LabelUsecase interface {
+	// CreateLabel creates a label in the workspace of ctx.
+	CreateLabel(ctx context.Context, actor entities.Actor, label *entities.Label) error
+	// ListLabels returns the labels of the workspace of ctx, by name.
+	ListLabels(ctx context.Context) ([]entities.Label, error)
+	// UpdateLabel renames or recolors a label.
+	UpdateLabel(ctx context.Context, actor entities.Actor, label *entities.Label) error
+	// DeleteLabel deletes a label and removes it from its tasks.
+	DeleteLabel(ctx context.Context, actor entities.Actor, id uint) error
+}
+
+type usecase struct {
+	labelRepo interfaces.LabelRepository
+}
+
+func NewLabelUsecase(labelRepo interfaces.LabelRepository) LabelUsecase {
+	return &usecase{labelRepo}
+}
+
+// authorizeRole fails when the workspace role of actor does not include required. Admins and
+// anonymous actors, which only exist when authentication is disabled, are not restricted.
+func authorizeRole(actor entities.Actor, required entities.WorkspaceRole, action string) error {
+	if actor.Admin || actor.UserId == "" || actor.Role.Includes(required) {
+		return nil
+	}
+	return domainerrors.Forbidden(fmt.Sprintf("the %s role is required to %s", required, action)).
+		WithDetail("role", actor.Role).
+		WithDetail("required_role", required)
+}
+
+// normalize trims the name of a label and lowercases its color, so equal colors compare equal.
+func normalize(label *entities.Label) error {
+	label.Name = strings.TrimSpace(label.Name)
+	label.Color = strings.ToLower(label.Color)
+	if label.Name == "" {
+		return domainerrors.Validation("name must not be blank")
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListLabels(ctx context.Context) ([]entities.Label, error) {
+	return u.labelRepo.List(ctx)
+}
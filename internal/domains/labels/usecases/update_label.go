@@ -0,0 +1,25 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) UpdateLabel(ctx context.Context, actor entities.Actor, label *entities.Label) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "change labels"); err != nil {
+		return err
+	}
+	if err := normalize(label); err != nil {
+		return err
+	}
+	if err := u.labelRepo.Update(ctx, label); err != nil {
+		return err
+	}
+	updated, err := u.labelRepo.GetByID(ctx, label.Id)
+	if err != nil {
+		return err
+	}
+	*label = *updated
+	return nil
+}
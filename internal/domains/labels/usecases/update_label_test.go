@@ -0,0 +1,43 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/labels/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/labels/interfaces"
+)
+
+func TestUpdateLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockLabelRepository(ctrl)
+	usecase := usecases.NewLabelUsecase(mockRepo)
+	member := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+
+	t.Run("Success", func(t *testing.T) {
+		stored := &entities.Label{Id: 7, WorkspaceId: 2, Name: "defect", Color: "#0075ca"}
+		mockRepo.EXPECT().Update(ctx, &entities.Label{Id: 7, Name: "defect", Color: "#0075ca"}).Return(nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(7)).Return(stored, nil)
+
+		label := &entities.Label{Id: 7, Name: "defect", Color: "#0075CA"}
+		if assert.NoError(t, usecase.UpdateLabel(ctx, member, label)) {
+			assert.Equal(t, *stored, *label)
+		}
+	})
+
+	t.Run("NameTaken", func(t *testing.T) {
+		conflict := domainerrors.Conflict("a label with this name already exists")
+		mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(conflict)
+
+		err := usecase.UpdateLabel(ctx, member, &entities.Label{Id: 7, Name: "ui", Color: "#0075ca"})
+		assert.True(t, errors.Is(err, conflict))
+	})
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/outbox/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+
+	"gorm.io/gorm"
+)
+
+// Options tunes the outbox repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type repository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewOutboxRepository(db *gorm.DB, options Options) interfaces.OutboxRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entities.OutboxMessage, error) {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	messages := []entities.OutboxMessage{}
+	err := db.Raw(`UPDATE outbox SET attempts = attempts + 1, next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM outbox WHERE published_at IS NULL AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, id LIMIT ? FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, limit).
+		Scan(&messages).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	// RETURNING does not keep the order of the subquery.
+	sort.Slice(messages, func(i, j int) bool { return messages[i].Id < messages[j].Id })
+	return messages, nil
+}
+
+func (r *repository) MarkPublished(ctx context.Context, id uint, publishedAt time.Time) error {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	return wrapError(db.Model(&entities.OutboxMessage{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"published_at": publishedAt, "last_error": ""}).Error)
+}
+
+func (r *repository) Reschedule(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string, publishedSinks entities.SinkNames) error {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	return wrapError(db.Model(&entities.OutboxMessage{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"next_attempt_at": nextAttemptAt, "last_error": lastError, "published_sinks": publishedSinks}).Error)
+}
+
+func (r *repository) DeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	result := db.Where("published_at < ?", before).Delete(&entities.OutboxMessage{})
+	return result.RowsAffected, wrapError(result.Error)
+}
+
+// withTimeout binds the queries to ctx, bounded by the query timeout.
+func (r *repository) withTimeout(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	return r.db.WithContext(ctx).Session(&gorm.Session{}), cancel
+}
+
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	default:
+		return domainerrors.Internal(err)
+	}
+}
@@ -0,0 +1,96 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/outbox/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/outbox/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newRepository(t *testing.T) (interfaces.OutboxRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewOutboxRepository(db, repository.Options{}), mock
+}
+
+func TestOutboxRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("ClaimDueOldestFirst", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(`UPDATE outbox SET attempts = attempts \+ 1, next_attempt_at = \$1\s+WHERE id IN \(\s+SELECT id FROM outbox WHERE published_at IS NULL AND next_attempt_at <= \$2\s+ORDER BY next_attempt_at, id LIMIT \$3 FOR UPDATE SKIP LOCKED`).
+			WithArgs(now.Add(time.Minute), now, 100).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "workspace_id", "type", "attempts"}).
+				AddRow(9, "b", 2, "TaskUpdated", 1).
+				AddRow(8, "a", 1, "TaskCreated", 3))
+
+		messages, err := repo.ClaimDue(ctx, now, time.Minute, 100)
+
+		if assert.NoError(t, err) && assert.Len(t, messages, 2) {
+			assert.Equal(t, uint(8), messages[0].Id)
+			assert.Equal(t, 3, messages[0].Attempts)
+			assert.Equal(t, uint(9), messages[1].Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("MarkPublished", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox" SET "last_error"=$1,"published_at"=$2 WHERE id = $3`)).
+			WithArgs("", now, 8).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.MarkPublished(ctx, 8, now))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reschedule", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox" SET "last_error"=$1,"next_attempt_at"=$2,"published_sinks"=$3 WHERE id = $4`)).
+			WithArgs("nats: connection refused", now, "in-process kafka", 8).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Reschedule(ctx, 8, now, "nats: connection refused", entities.SinkNames{"in-process", "kafka"}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeletePublished", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "outbox" WHERE published_at < $1`)).
+			WithArgs(now).
+			WillReturnResult(sqlmock.NewResult(0, 42))
+
+		deleted, err := repo.DeletePublished(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), deleted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(`UPDATE outbox`).WillReturnError(context.DeadlineExceeded)
+
+		_, err := repo.ClaimDue(ctx, now, time.Minute, 100)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindUnavailable))
+	})
+}
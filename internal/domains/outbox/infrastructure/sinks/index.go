@@ -0,0 +1,39 @@
+package sinks
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/configs"
+	"github.com/supachai1998/task_services/internal/domains/outbox/interfaces"
+)
+
+// NewSinks returns the sinks of the relay: the in-process subscribers, and the NATS and Kafka
+// publishers when their URL is configured.
+func NewSinks(config *configs.OutboxConfig, subscribers *Subscribers) ([]interfaces.Sink, error) {
+	sinks := []interfaces.Sink{subscribers}
+	timeout := time.Duration(config.PublishTimeout) * time.Second
+	if config.NatsURL != "" {
+		sink, err := NewNatsSink(NatsOptions{
+			URL:           config.NatsURL,
+			SubjectPrefix: config.NatsSubjectPrefix,
+			Timeout:       timeout,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if config.KafkaRestURL != "" {
+		sink, err := NewKafkaSink(KafkaOptions{
+			URL:    config.KafkaRestURL,
+			Topic:  config.KafkaTopic,
+			Client: &http.Client{Timeout: timeout},
+		})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
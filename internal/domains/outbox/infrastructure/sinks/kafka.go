@@ -0,0 +1,124 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domains/outbox/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+const (
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+	kafkaAccept      = "application/vnd.kafka.v2+json"
+)
+
+// KafkaOptions configures a sink producing to a Kafka topic through a REST proxy, such as the
+// Confluent REST Proxy or Redpanda's HTTP proxy.
+type KafkaOptions struct {
+	// URL is the base URL of the REST proxy.
+	URL   string
+	Topic string
+	// Client sends the requests; by default it times out after 10 seconds.
+	Client *http.Client
+}
+
+type kafkaSink struct {
+	endpoint string
+	options  KafkaOptions
+}
+
+// NewKafkaSink produces each event as a JSON record keyed by its task ID, so the events of a task
+// go to the same partition, in order; the ID of the event is in the record.
+func NewKafkaSink(options KafkaOptions) (interfaces.Sink, error) {
+	u, err := url.Parse(strings.TrimSuffix(options.URL, "/"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("sinks: invalid Kafka REST proxy URL %q", options.URL)
+	}
+	if options.Topic == "" {
+		return nil, errors.New("sinks: the Kafka topic is required")
+	}
+	if options.Client == nil {
+		options.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &kafkaSink{u.String() + "/topics/" + url.PathEscape(options.Topic), options}, nil
+}
+
+func (s *kafkaSink) Name() string {
+	return "kafka"
+}
+
+type kafkaRecord struct {
+	Key   string               `json:"key"`
+	Value entities.DomainEvent `json:"value"`
+}
+
+type kafkaOffset struct {
+	Partition int     `json:"partition"`
+	Offset    int64   `json:"offset"`
+	ErrorCode *int    `json:"error_code"`
+	Error     *string `json:"error"`
+}
+
+func (s *kafkaSink) Publish(ctx context.Context, event entities.DomainEvent) error {
+	body, err := json.Marshal(map[string][]kafkaRecord{
+		"records": {{Key: strconv.FormatUint(uint64(event.TaskId), 10), Value: event}},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", kafkaAccept)
+
+	resp, err := s.options.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("the REST proxy answered %s: %s", resp.Status, truncate(string(content), 200))
+	}
+	var result struct {
+		Offsets []kafkaOffset `json:"offsets"`
+	}
+	if err := json.Unmarshal(content, &result); err != nil {
+		return fmt.Errorf("cannot decode the answer of the REST proxy: %w", err)
+	}
+	for _, offset := range result.Offsets {
+		if offset.ErrorCode != nil || offset.Error != nil {
+			return fmt.Errorf("the REST proxy rejected the record: %s", kafkaError(offset))
+		}
+	}
+	return nil
+}
+
+func kafkaError(offset kafkaOffset) string {
+	if offset.Error != nil {
+		return *offset.Error
+	}
+	return "error code " + strconv.Itoa(*offset.ErrorCode)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
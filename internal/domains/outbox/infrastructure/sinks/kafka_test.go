@@ -0,0 +1,96 @@
+package sinks_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domains/outbox/infrastructure/sinks"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// fakeKafkaProxy is a stand-in of the produce endpoint of a Kafka REST proxy.
+type fakeKafkaProxy struct {
+	records []json.RawMessage
+	// answer is the body of the answer, the offset of the records by default.
+	answer string
+	status int
+}
+
+func (f *fakeKafkaProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/topics/task-events" ||
+		r.Header.Get("Content-Type") != "application/vnd.kafka.json.v2+json" {
+		http.Error(w, `{"error_code":40401,"message":"Not found"}`, http.StatusNotFound)
+		return
+	}
+	var body struct {
+		Records []json.RawMessage `json:"records"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, `{"error_code":422,"message":"Unprocessable"}`, http.StatusUnprocessableEntity)
+		return
+	}
+	f.records = append(f.records, body.Records...)
+	if f.status != 0 {
+		w.WriteHeader(f.status)
+	}
+	if f.answer != "" {
+		w.Write([]byte(f.answer))
+		return
+	}
+	w.Write([]byte(`{"key_schema_id":null,"value_schema_id":null,"offsets":[{"partition":0,"offset":12,"error_code":null,"error":null}]}`))
+}
+
+func TestKafkaSink(t *testing.T) {
+	ctx := context.Background()
+	event := entities.DomainEvent{Id: "0b9a1c9e-5d4f-4f0e-9a51-63d1f6b0c2aa", Type: entities.DomainEventTaskCreated, WorkspaceId: 2, TaskId: 7}
+
+	newSink := func(t *testing.T, proxy *fakeKafkaProxy) func() error {
+		server := httptest.NewServer(proxy)
+		t.Cleanup(server.Close)
+		sink, err := sinks.NewKafkaSink(sinks.KafkaOptions{URL: server.URL + "/", Topic: "task-events"})
+		require.NoError(t, err)
+		return func() error { return sink.Publish(ctx, event) }
+	}
+
+	t.Run("KeyedByTask", func(t *testing.T) {
+		proxy := &fakeKafkaProxy{}
+		publish := newSink(t, proxy)
+
+		require.NoError(t, publish())
+
+		if assert.Len(t, proxy.records, 1) {
+			var record struct {
+				Key   string               `json:"key"`
+				Value entities.DomainEvent `json:"value"`
+			}
+			require.NoError(t, json.Unmarshal(proxy.records[0], &record))
+			assert.Equal(t, "7", record.Key)
+			assert.Equal(t, event.Id, record.Value.Id)
+			assert.Equal(t, entities.DomainEventTaskCreated, record.Value.Type)
+		}
+	})
+
+	t.Run("RecordRejected", func(t *testing.T) {
+		publish := newSink(t, &fakeKafkaProxy{
+			answer: `{"offsets":[{"partition":null,"offset":null,"error_code":50003,"error":"Kafka error: topic is not available"}]}`,
+		})
+
+		assert.ErrorContains(t, publish(), "topic is not available")
+	})
+
+	t.Run("ProxyUnavailable", func(t *testing.T) {
+		publish := newSink(t, &fakeKafkaProxy{status: http.StatusServiceUnavailable, answer: `{"error_code":50301}`})
+
+		assert.ErrorContains(t, publish(), "503")
+	})
+
+	t.Run("TopicRequired", func(t *testing.T) {
+		_, err := sinks.NewKafkaSink(sinks.KafkaOptions{URL: "http://localhost:8082"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,169 @@
+package sinks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domains/outbox/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// NatsOptions configures a sink publishing to a NATS server.
+type NatsOptions struct {
+	// URL is the address of the server, as nats://[user:password@]host:port.
+	URL string
+	// SubjectPrefix prefixes the subjects of the events, which end with their type, such as
+	// tasks.TaskCreated for the prefix "tasks".
+	SubjectPrefix string
+	// Timeout bounds the connection and each publication, 10 seconds by default.
+	Timeout time.Duration
+}
+
+// natsSink speaks the client protocol of NATS over a single connection, opened on the first
+// publication and opened again after an error. Each publication waits for the PONG of a PING, so
+// it returns once the server has processed it.
+type natsSink struct {
+	address string
+	user    *url.Userinfo
+	options NatsOptions
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewNatsSink publishes the events with their ID in the Nats-Msg-Id header, which JetStream
+// streams use to drop the duplicates.
+func NewNatsSink(options NatsOptions) (interfaces.Sink, error) {
+	u, err := url.Parse(options.URL)
+	if err != nil || u.Scheme != "nats" || u.Host == "" {
+		return nil, fmt.Errorf("sinks: invalid NATS URL %q, expected nats://host:port", options.URL)
+	}
+	if options.SubjectPrefix == "" {
+		return nil, errors.New("sinks: the NATS subject prefix is required")
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 10 * time.Second
+	}
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), "4222")
+	}
+	return &natsSink{address: address, user: u.User, options: options}, nil
+}
+
+func (s *natsSink) Name() string {
+	return "nats"
+}
+
+func (s *natsSink) Publish(ctx context.Context, event entities.DomainEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.publish(ctx, s.options.SubjectPrefix+"."+string(event.Type), event.Id, payload); err != nil {
+		s.close()
+		return err
+	}
+	return nil
+}
+
+func (s *natsSink) publish(ctx context.Context, subject, id string, payload []byte) error {
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return err
+		}
+	}
+	deadline := time.Now().Add(s.options.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := s.conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	headers := "NATS/1.0\r\nNats-Msg-Id: " + id + "\r\n\r\n"
+	msg := fmt.Sprintf("HPUB %s %d %d\r\n%s%s\r\nPING\r\n", subject, len(headers), len(headers)+len(payload), headers, payload)
+	if _, err := s.conn.Write([]byte(msg)); err != nil {
+		return err
+	}
+	return s.awaitPong()
+}
+
+// connect opens the connection and sends the CONNECT of the client, with the credentials of the URL.
+func (s *natsSink) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: s.options.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+	s.conn, s.reader = conn, bufio.NewReader(conn)
+	if err := conn.SetDeadline(time.Now().Add(s.options.Timeout)); err != nil {
+		return err
+	}
+	// The server greets with its INFO.
+	line, err := s.readLine()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("unexpected greeting %q", line)
+	}
+	options := map[string]any{"verbose": false, "pedantic": false, "headers": true, "name": "task-services", "lang": "go"}
+	if s.user != nil {
+		options["user"] = s.user.Username()
+		if password, ok := s.user.Password(); ok {
+			options["pass"] = password
+		}
+	}
+	connect, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+	_, err = s.conn.Write([]byte("CONNECT " + string(connect) + "\r\n"))
+	return err
+}
+
+// awaitPong reads until the PONG of the last PING, answering the PINGs of the server.
+func (s *natsSink) awaitPong() error {
+	for {
+		line, err := s.readLine()
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := s.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("the server answered %s", line)
+		}
+	}
+}
+
+func (s *natsSink) readLine() (string, error) {
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (s *natsSink) close() {
+	if s.conn != nil {
+		s.conn.Close()
+		s.conn, s.reader = nil, nil
+	}
+}
@@ -0,0 +1,153 @@
+package sinks_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domains/outbox/infrastructure/sinks"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// natsMessage is a message published to fakeNats.
+type natsMessage struct {
+	subject string
+	headers string
+	payload string
+}
+
+// fakeNats is a stand-in of a NATS server speaking enough of the client protocol for HPUB.
+type fakeNats struct {
+	listener net.Listener
+	mu       sync.Mutex
+	connects []string
+	messages []natsMessage
+	// reject answers the next publications with -ERR.
+	reject bool
+}
+
+func newFakeNats(t *testing.T) *fakeNats {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	f := &fakeNats{listener: listener}
+	t.Cleanup(func() { listener.Close() })
+	go f.serve()
+	return f
+}
+
+func (f *fakeNats) URL(userinfo string) string {
+	return "nats://" + userinfo + f.listener.Addr().String()
+}
+
+func (f *fakeNats) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeNats) handle(conn net.Conn) {
+	defer conn.Close()
+	fmt.Fprint(conn, "INFO {\"server_id\":\"fake\",\"headers\":true}\r\n")
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "CONNECT":
+			f.mu.Lock()
+			f.connects = append(f.connects, strings.TrimSpace(strings.TrimPrefix(line, "CONNECT")))
+			f.mu.Unlock()
+		case "HPUB":
+			headerLength, _ := strconv.Atoi(fields[2])
+			totalLength, _ := strconv.Atoi(fields[3])
+			body := make([]byte, totalLength+2)
+			if _, err := io.ReadFull(reader, body); err != nil {
+				return
+			}
+			f.mu.Lock()
+			if f.reject {
+				f.mu.Unlock()
+				fmt.Fprint(conn, "-ERR 'Permissions Violation for Publish'\r\n")
+				continue
+			}
+			f.messages = append(f.messages, natsMessage{fields[1], string(body[:headerLength]), string(body[headerLength:totalLength])})
+			f.mu.Unlock()
+		case "PING":
+			fmt.Fprint(conn, "PONG\r\n")
+		}
+	}
+}
+
+func TestNatsSink(t *testing.T) {
+	ctx := context.Background()
+	event := entities.DomainEvent{Id: "0b9a1c9e-5d4f-4f0e-9a51-63d1f6b0c2aa", Type: entities.DomainEventTaskStatusChanged, WorkspaceId: 2, TaskId: 7}
+
+	t.Run("Publish", func(t *testing.T) {
+		server := newFakeNats(t)
+		sink, err := sinks.NewNatsSink(sinks.NatsOptions{URL: server.URL("app:s3cret@"), SubjectPrefix: "tasks", Timeout: time.Second})
+		require.NoError(t, err)
+
+		require.NoError(t, sink.Publish(ctx, event))
+		require.NoError(t, sink.Publish(ctx, event))
+
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		// Both publications share the connection.
+		if assert.Len(t, server.connects, 1) {
+			assert.Contains(t, server.connects[0], `"user":"app"`)
+			assert.Contains(t, server.connects[0], `"pass":"s3cret"`)
+			assert.Contains(t, server.connects[0], `"headers":true`)
+		}
+		if assert.Len(t, server.messages, 2) {
+			assert.Equal(t, "tasks.TaskStatusChanged", server.messages[0].subject)
+			assert.Equal(t, "NATS/1.0\r\nNats-Msg-Id: 0b9a1c9e-5d4f-4f0e-9a51-63d1f6b0c2aa\r\n\r\n", server.messages[0].headers)
+			assert.Contains(t, server.messages[0].payload, `"type":"TaskStatusChanged"`)
+		}
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		server := newFakeNats(t)
+		server.reject = true
+		sink, err := sinks.NewNatsSink(sinks.NatsOptions{URL: server.URL(""), SubjectPrefix: "tasks", Timeout: time.Second})
+		require.NoError(t, err)
+
+		err = sink.Publish(ctx, event)
+
+		assert.ErrorContains(t, err, "Permissions Violation")
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		address := listener.Addr().String()
+		listener.Close()
+		sink, err := sinks.NewNatsSink(sinks.NatsOptions{URL: "nats://" + address, SubjectPrefix: "tasks", Timeout: time.Second})
+		require.NoError(t, err)
+
+		assert.Error(t, sink.Publish(ctx, event))
+	})
+
+	t.Run("InvalidURL", func(t *testing.T) {
+		_, err := sinks.NewNatsSink(sinks.NatsOptions{URL: "http://localhost:4222", SubjectPrefix: "tasks"})
+		assert.Error(t, err)
+	})
+}
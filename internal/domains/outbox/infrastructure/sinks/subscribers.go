@@ -0,0 +1,68 @@
+package sinks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// Handler handles a domain event in process. An error makes the relay publish the event again,
+// so handlers must tolerate seeing an event more than once.
+type Handler func(ctx context.Context, event entities.DomainEvent) error
+
+type subscription struct {
+	handler Handler
+	types   []entities.DomainEventType
+}
+
+// Subscribers is the sink of the in-process subscribers of the domain events.
+type Subscribers struct {
+	mu            sync.RWMutex
+	subscriptions []subscription
+}
+
+func NewSubscribers() *Subscribers {
+	return &Subscribers{}
+}
+
+// Subscribe calls handler with the events of types, or with every event when types is empty.
+func (s *Subscribers) Subscribe(handler Handler, types ...entities.DomainEventType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions = append(s.subscriptions, subscription{handler, types})
+}
+
+func (s *Subscribers) Name() string {
+	return "in-process"
+}
+
+// Publish calls every handler subscribed to the event, in the order they subscribed, and returns
+// the first error.
+func (s *Subscribers) Publish(ctx context.Context, event entities.DomainEvent) error {
+	s.mu.RLock()
+	subscriptions := s.subscriptions
+	s.mu.RUnlock()
+	var first error
+	for _, subscription := range subscriptions {
+		if !subscription.matches(event.Type) {
+			continue
+		}
+		if err := subscription.handler(ctx, event); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (s subscription) matches(eventType entities.DomainEventType) bool {
+	if len(s.types) == 0 {
+		return true
+	}
+	for _, t := range s.types {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package sinks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/outbox/infrastructure/sinks"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestSubscribers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ByType", func(t *testing.T) {
+		subscribers := sinks.NewSubscribers()
+		var all, deleted []string
+		subscribers.Subscribe(func(_ context.Context, event entities.DomainEvent) error {
+			all = append(all, event.Id)
+			return nil
+		})
+		subscribers.Subscribe(func(_ context.Context, event entities.DomainEvent) error {
+			deleted = append(deleted, event.Id)
+			return nil
+		}, entities.DomainEventTaskDeleted)
+
+		assert.NoError(t, subscribers.Publish(ctx, entities.DomainEvent{Id: "1", Type: entities.DomainEventTaskCreated}))
+		assert.NoError(t, subscribers.Publish(ctx, entities.DomainEvent{Id: "2", Type: entities.DomainEventTaskDeleted}))
+
+		assert.Equal(t, []string{"1", "2"}, all)
+		assert.Equal(t, []string{"2"}, deleted)
+	})
+
+	t.Run("FailureDoesNotSkipTheOthers", func(t *testing.T) {
+		subscribers := sinks.NewSubscribers()
+		called := false
+		subscribers.Subscribe(func(context.Context, entities.DomainEvent) error {
+			return errors.New("webhooks: database error")
+		})
+		subscribers.Subscribe(func(context.Context, entities.DomainEvent) error {
+			called = true
+			return nil
+		})
+
+		err := subscribers.Publish(ctx, entities.DomainEvent{Id: "3", Type: entities.DomainEventTaskUpdated})
+
+		assert.EqualError(t, err, "webhooks: database error")
+		assert.True(t, called)
+	})
+}
@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// OutboxRepository reads the outbox of every workspace for the relay. The messages are written
+// by the repositories of the changes they describe, in the same transactions.
+type OutboxRepository interface {
+	// ClaimDue counts an attempt on up to limit unpublished messages due at now, oldest first, and
+	// postpones them until now+lease, so other relays skip them while they are published and retry
+	// them if the relay stops before finishing them.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entities.OutboxMessage, error)
+	MarkPublished(ctx context.Context, id uint, publishedAt time.Time) error
+	// Reschedule records the failure of an attempt, the sinks that accepted the event so far and
+	// when to make the next attempt.
+	Reschedule(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string, publishedSinks entities.SinkNames) error
+	// DeletePublished deletes the messages published before a time and returns how many it deleted.
+	DeletePublished(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Sink publishes domain events to an integration.
+type Sink interface {
+	// Name identifies the sink in the errors of the outbox.
+	Name() string
+	// Publish returns once the integration has accepted event; an error makes the relay publish it
+	// to this sink again later. A sink may still see an event more than once, when a relay stops
+	// before recording that the sink accepted it.
+	Publish(ctx context.Context, event entities.DomainEvent) error
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domains/outbox/interfaces"
+)
+
+// Relay publishes the domain events of the outbox to the sinks, at least once each.
+type Relay interface {
+	// RelayDue publishes the due messages of every workspace and returns how many it claimed.
+	RelayDue(ctx context.Context) (int, error)
+	// Run relays the due messages every poll interval, and deletes the messages published longer
+	// than the retention ago, until ctx is done.
+	Run(ctx context.Context)
+}
+
+// Options tunes the relay.
+type Options struct {
+	// PollInterval is how often the relay looks for due messages, 1 second by default.
+	PollInterval time.Duration
+	// BatchSize is the number of messages the relay claims at once, 100 by default.
+	BatchSize int
+	// Lease is how long a claimed message is hidden from other relays, 1 minute by default; it
+	// must exceed the time the sinks take to publish a batch.
+	Lease time.Duration
+	// RetryDelay is the delay before the second attempt of a message, doubled after each further
+	// attempt up to MaxRetryDelay; 5 seconds and 5 minutes by default. Messages are retried until
+	// every sink accepts them.
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+	// Retention is how long published messages are kept, 7 days by default.
+	Retention time.Duration
+	// Now is the clock of the relay, time.Now by default.
+	Now func() time.Time
+}
+
+type relay struct {
+	outboxRepo interfaces.OutboxRepository
+	sinks      []interfaces.Sink
+	options    Options
+}
+
+func NewRelay(outboxRepo interfaces.OutboxRepository, sinks []interfaces.Sink, options Options) Relay {
+	if options.PollInterval <= 0 {
+		options.PollInterval = time.Second
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = 100
+	}
+	if options.Lease <= 0 {
+		options.Lease = time.Minute
+	}
+	if options.RetryDelay <= 0 {
+		options.RetryDelay = 5 * time.Second
+	}
+	if options.MaxRetryDelay <= 0 {
+		options.MaxRetryDelay = 5 * time.Minute
+	}
+	if options.Retention <= 0 {
+		options.Retention = 7 * 24 * time.Hour
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return &relay{outboxRepo, sinks, options}
+}
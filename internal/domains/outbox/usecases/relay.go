@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// maxErrorLength bounds the error recorded on a failed attempt.
+const maxErrorLength = 1000
+
+func (r *relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.options.PollInterval)
+	defer ticker.Stop()
+	for {
+		// Keep relaying while full batches are due, so a backlog drains without waiting for the ticker.
+		for {
+			claimed, err := r.RelayDue(ctx)
+			if err != nil {
+				log.Printf("outbox: cannot relay the due messages: %v", err)
+			}
+			if err != nil || claimed < r.options.BatchSize {
+				break
+			}
+		}
+		if _, err := r.outboxRepo.DeletePublished(ctx, r.options.Now().Add(-r.options.Retention)); err != nil {
+			log.Printf("outbox: cannot delete the published messages: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayDue publishes the messages one at a time, in the order they were written, so the sinks
+// see the events of a task in order unless one of them is retried.
+func (r *relay) RelayDue(ctx context.Context) (int, error) {
+	messages, err := r.outboxRepo.ClaimDue(ctx, r.options.Now(), r.options.Lease, r.options.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for i := range messages {
+		message := &messages[i]
+		if err := r.publish(ctx, message); err != nil {
+			next := r.options.Now().Add(r.retryDelay(message.Attempts))
+			if err := r.outboxRepo.Reschedule(ctx, message.Id, next, truncate(err.Error(), maxErrorLength), message.PublishedSinks); err != nil {
+				return i, err
+			}
+			continue
+		}
+		if err := r.outboxRepo.MarkPublished(ctx, message.Id, r.options.Now()); err != nil {
+			return i, err
+		}
+	}
+	return len(messages), nil
+}
+
+// publish publishes the event of a message to every sink that has not accepted it yet, even when
+// one of them fails, and adds the sinks that accept it to the published sinks of the message, so
+// a retry only publishes it to the sinks that failed.
+func (r *relay) publish(ctx context.Context, message *entities.OutboxMessage) error {
+	var event entities.DomainEvent
+	if err := json.Unmarshal(message.Payload, &event); err != nil {
+		return fmt.Errorf("cannot decode event %s: %w", message.EventId, err)
+	}
+	var failures []string
+	for _, sink := range r.sinks {
+		if message.PublishedSinks.Contains(sink.Name()) {
+			continue
+		}
+		if err := sink.Publish(ctx, event); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", sink.Name(), err))
+			continue
+		}
+		message.PublishedSinks = append(message.PublishedSinks, sink.Name())
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// retryDelay returns the delay after the attempt-th attempt of a message.
+func (r *relay) retryDelay(attempt int) time.Duration {
+	delay := r.options.RetryDelay
+	for i := 1; i < attempt && delay < r.options.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > r.options.MaxRetryDelay {
+		delay = r.options.MaxRetryDelay
+	}
+	return delay
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
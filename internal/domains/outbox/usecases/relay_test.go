@@ -0,0 +1,138 @@
+package usecases_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domains/outbox/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/outbox/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/outbox/interfaces"
+)
+
+// newMessage returns a claimed message of the domain event of a history entry.
+func newMessage(t *testing.T, id uint, attempts int, action entities.TaskEventAction) entities.OutboxMessage {
+	message, err := entities.NewOutboxMessage(&entities.TaskEvent{Id: id + 100, TaskId: 7, WorkspaceId: 2, Action: action, Changes: entities.JSON(`{}`)})
+	require.NoError(t, err)
+	message.Id = id
+	message.Attempts = attempts
+	return *message
+}
+
+func TestRelayDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockRepo := mocks.NewMockOutboxRepository(ctrl)
+	nats := mocks.NewMockSink(ctrl)
+	kafka := mocks.NewMockSink(ctrl)
+	nats.EXPECT().Name().Return("nats").AnyTimes()
+	kafka.EXPECT().Name().Return("kafka").AnyTimes()
+	relay := usecases.NewRelay(mockRepo, []interfaces.Sink{nats, kafka}, usecases.Options{
+		BatchSize:     10,
+		RetryDelay:    time.Second,
+		MaxRetryDelay: 3 * time.Second,
+		Now:           func() time.Time { return now },
+	})
+
+	t.Run("PublishedToEverySinkInOrder", func(t *testing.T) {
+		created := newMessage(t, 1, 1, entities.TaskEventCreated)
+		moved := newMessage(t, 2, 1, entities.TaskEventStatusChanged)
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 10).Return([]entities.OutboxMessage{created, moved}, nil)
+		gomock.InOrder(
+			nats.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, event entities.DomainEvent) error {
+				assert.Equal(t, created.EventId, event.Id)
+				assert.Equal(t, entities.DomainEventTaskCreated, event.Type)
+				assert.Equal(t, uint(101), event.TaskEvent.Id)
+				return nil
+			}),
+			kafka.EXPECT().Publish(ctx, gomock.Any()).Return(nil),
+			mockRepo.EXPECT().MarkPublished(ctx, uint(1), now).Return(nil),
+			nats.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, event entities.DomainEvent) error {
+				assert.Equal(t, entities.DomainEventTaskStatusChanged, event.Type)
+				return nil
+			}),
+			kafka.EXPECT().Publish(ctx, gomock.Any()).Return(nil),
+			mockRepo.EXPECT().MarkPublished(ctx, uint(2), now).Return(nil),
+		)
+
+		claimed, err := relay.RelayDue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, claimed)
+	})
+
+	t.Run("FailedSinkRetriedWithBackoff", func(t *testing.T) {
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 10).Return([]entities.OutboxMessage{newMessage(t, 3, 2, entities.TaskEventDeleted)}, nil)
+		nats.EXPECT().Publish(ctx, gomock.Any()).Return(errors.New("connection refused"))
+		// The other sinks still get the event, and are recorded so the retry skips them.
+		kafka.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().Reschedule(ctx, uint(3), now.Add(2*time.Second), "nats: connection refused", entities.SinkNames{"kafka"}).Return(nil)
+
+		_, err := relay.RelayDue(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RetryOnlyPublishesToTheFailedSinks", func(t *testing.T) {
+		message := newMessage(t, 3, 3, entities.TaskEventDeleted)
+		message.PublishedSinks = entities.SinkNames{"kafka"}
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 10).Return([]entities.OutboxMessage{message}, nil)
+		nats.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().MarkPublished(ctx, uint(3), now).Return(nil)
+
+		_, err := relay.RelayDue(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("BackoffIsCapped", func(t *testing.T) {
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 10).Return([]entities.OutboxMessage{newMessage(t, 4, 9, entities.TaskEventUpdated)}, nil)
+		nats.EXPECT().Publish(ctx, gomock.Any()).Return(errors.New("timeout"))
+		kafka.EXPECT().Publish(ctx, gomock.Any()).Return(errors.New("the REST proxy answered 503 Service Unavailable"))
+		mockRepo.EXPECT().Reschedule(ctx, uint(4), now.Add(3*time.Second),
+			"nats: timeout; kafka: the REST proxy answered 503 Service Unavailable", entities.SinkNames(nil)).Return(nil)
+
+		_, err := relay.RelayDue(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("UndecodablePayload", func(t *testing.T) {
+		message := newMessage(t, 5, 1, entities.TaskEventUpdated)
+		message.Payload = entities.JSON(`{"id":`)
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 10).Return([]entities.OutboxMessage{message}, nil)
+		mockRepo.EXPECT().Reschedule(ctx, uint(5), now.Add(time.Second), gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := relay.RelayDue(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ClaimFailure", func(t *testing.T) {
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 10).Return(nil, errors.New("database error"))
+
+		_, err := relay.RelayDue(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewOutboxMessage(t *testing.T) {
+	occurredAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	message, err := entities.NewOutboxMessage(&entities.TaskEvent{
+		Id: 40, TaskId: 7, WorkspaceId: 2, Action: entities.TaskEventRestored, Changes: entities.JSON(`{}`), CreatedAt: occurredAt,
+	})
+	require.NoError(t, err)
+
+	var event entities.DomainEvent
+	require.NoError(t, json.Unmarshal(message.Payload, &event))
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, message.EventId)
+	assert.Equal(t, message.EventId, event.Id)
+	assert.Equal(t, entities.DomainEventTaskCreated, event.Type)
+	assert.Equal(t, uint(2), event.WorkspaceId)
+	assert.Equal(t, occurredAt, event.OccurredAt)
+	assert.Equal(t, occurredAt, message.NextAttemptAt)
+}
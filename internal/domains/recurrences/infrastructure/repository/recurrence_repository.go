@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+
+	"gorm.io/gorm"
+)
+
+var (
+	errRecurrenceNotFound = domainerrors.NotFound("recurrence not found")
+	errNoWorkspace        = domainerrors.Internal(errors.New("repository: the context has no workspace"))
+)
+
+// Options tunes the recurrence repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type repository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewRecurrenceRepository(db *gorm.DB, options Options) interfaces.RecurrenceRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) Create(ctx context.Context, recurrence *entities.Recurrence) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	recurrence.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(recurrence).Error)
+}
+
+func (r *repository) GetByID(ctx context.Context, id uint) (*entities.Recurrence, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var recurrence entities.Recurrence
+	if err := db.Take(&recurrence, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &recurrence, nil
+}
+
+func (r *repository) List(ctx context.Context) ([]entities.Recurrence, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	recurrences := []entities.Recurrence{}
+	if err := db.Order("id").Find(&recurrences).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return recurrences, nil
+}
+
+func (r *repository) Update(ctx context.Context, recurrence *entities.Recurrence) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	recurrence.UpdatedAt = time.Now().UTC()
+	result := db.Model(&entities.Recurrence{}).
+		Where("id = ?", recurrence.Id).
+		Updates(map[string]any{
+			"title":              recurrence.Title,
+			"description":        recurrence.Description,
+			"priority":           recurrence.Priority,
+			"estimate_minutes":   recurrence.EstimateMinutes,
+			"assignee_id":        recurrence.AssigneeId,
+			"due_after_minutes":  recurrence.DueAfterMinutes,
+			"schedule":           recurrence.Schedule,
+			"timezone":           recurrence.Timezone,
+			"starts_at":          recurrence.StartsAt,
+			"ends_at":            recurrence.EndsAt,
+			"paused":             recurrence.Paused,
+			"next_occurrence_at": recurrence.NextOccurrenceAt,
+			"locked_until":       nil,
+			"updated_at":         recurrence.UpdatedAt,
+		})
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errRecurrenceNotFound
+	}
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Delete(&entities.Recurrence{}, id)
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errRecurrenceNotFound
+	}
+	return nil
+}
+
+func (r *repository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entities.Recurrence, error) {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	recurrences := []entities.Recurrence{}
+	err := db.Raw(`UPDATE recurrences SET locked_until = ?
+		WHERE id IN (
+			SELECT id FROM recurrences
+			WHERE NOT paused AND next_occurrence_at <= ? AND (locked_until IS NULL OR locked_until <= ?)
+			ORDER BY next_occurrence_at, id LIMIT ? FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, now, limit).
+		Scan(&recurrences).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return recurrences, nil
+}
+
+func (r *repository) Advance(ctx context.Context, recurrence *entities.Recurrence, claimed time.Time) error {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	return wrapError(db.Model(&entities.Recurrence{}).
+		Where("id = ? AND next_occurrence_at = ?", recurrence.Id, claimed).
+		Updates(map[string]any{
+			"last_occurrence_at": recurrence.LastOccurrenceAt,
+			"next_occurrence_at": recurrence.NextOccurrenceAt,
+			"locked_until":       nil,
+		}).Error)
+}
+
+// withTimeout binds the queries to ctx, bounded by the query timeout.
+func (r *repository) withTimeout(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	return r.db.WithContext(ctx).Session(&gorm.Session{}), cancel
+}
+
+// withContext binds the queries to ctx and to the workspace of ctx, bounded by the query timeout.
+func (r *repository) withContext(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	db, cancel := r.withTimeout(ctx)
+	workspaceID, ok := helpers.WorkspaceIDFromContext(ctx)
+	if !ok {
+		_ = db.AddError(errNoWorkspace)
+		return db, cancel
+	}
+	return db.Where("workspace_id = ?", workspaceID).Session(&gorm.Session{}), cancel
+}
+
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errRecurrenceNotFound
+	default:
+		return domainerrors.Internal(err)
+	}
+}
@@ -0,0 +1,121 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newRepository(t *testing.T) (interfaces.RecurrenceRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewRecurrenceRepository(db, repository.Options{}), mock
+}
+
+func TestRecurrenceRepository(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 2)
+	now := time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC)
+
+	t.Run("CreateInTheWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "recurrences" ("workspace_id","title"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+
+		recurrence := &entities.Recurrence{WorkspaceId: 5, Title: "Weekly report", Schedule: "@weekly", Timezone: "UTC", StartsAt: now}
+		if assert.NoError(t, repo.Create(ctx, recurrence)) {
+			assert.Equal(t, uint(2), recurrence.WorkspaceId)
+			assert.Equal(t, uint(3), recurrence.Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetByIDOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "recurrences" WHERE workspace_id = $1 AND "recurrences"."id" = $2 LIMIT 1`)).
+			WithArgs(2, 3).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetByID(ctx, 3)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateReleasesTheLease", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`"locked_until"=$`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(ctx, &entities.Recurrence{Id: 3, Title: "Weekly report", NextOccurrenceAt: &now})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "recurrences" WHERE workspace_id = $1 AND "recurrences"."id" = $2`)).
+			WithArgs(2, 3).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(ctx, 3)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ClaimDueOfEveryWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(`UPDATE recurrences SET locked_until = \$1\s+WHERE id IN \(\s+SELECT id FROM recurrences\s+WHERE NOT paused AND next_occurrence_at <= \$2 AND \(locked_until IS NULL OR locked_until <= \$3\)\s+ORDER BY next_occurrence_at, id LIMIT \$4 FOR UPDATE SKIP LOCKED`).
+			WithArgs(now.Add(time.Minute), now, now, 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workspace_id", "next_occurrence_at"}).AddRow(3, 5, now))
+
+		recurrences, err := repo.ClaimDue(context.Background(), now, time.Minute, 20)
+
+		if assert.NoError(t, err) && assert.Len(t, recurrences, 1) {
+			assert.Equal(t, uint(5), recurrences[0].WorkspaceId)
+			assert.Equal(t, now, *recurrences[0].NextOccurrenceAt)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AdvanceUnlessRescheduled", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		next := now.Add(7 * 24 * time.Hour)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "recurrences" SET "last_occurrence_at"=$1,"locked_until"=$2,"next_occurrence_at"=$3,"updated_at"=$4 WHERE id = $5 AND next_occurrence_at = $6`)).
+			WithArgs(now, nil, next, sqlmock.AnyArg(), 3, now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Advance(context.Background(), &entities.Recurrence{Id: 3, LastOccurrenceAt: &now, NextOccurrenceAt: &next}, now)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoWorkspace", func(t *testing.T) {
+		repo, _ := newRepository(t)
+
+		_, err := repo.List(context.Background())
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+	})
+}
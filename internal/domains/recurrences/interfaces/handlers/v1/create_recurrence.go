@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// CreateRecurrence creates a recurrence
+// @Summary Create a recurrence
+// @Description Create a task template that the scheduler turns into a task at each occurrence of a cron expression or an RRULE,
+// @Description evaluated in the time zone of the recurrence. Occurrences missed while the scheduler was down are skipped but
+// @Description the latest one. Only members of the workspace can
+// @Tags recurrences
+// @Accept json
+// @Produce json
+// @Param recurrence body models.RecurrenceRequest true "Recurrence"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=entities.Recurrence} "Recurrence created"
+// @Failure 400 {object} models.ProblemDetails "Invalid input, schedule or time zone"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/recurrences [post]
+func (h *Handler) CreateRecurrence(c echo.Context) error {
+	req := new(models.RecurrenceRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	recurrence := req.Recurrence()
+	if err := h.RecurrenceUsecase.CreateRecurrence(c.Request().Context(), interfaces.ActorFrom(c), recurrence); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Recurrence created", recurrence))
+}
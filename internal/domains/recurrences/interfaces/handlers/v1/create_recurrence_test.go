@@ -0,0 +1,97 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/recurrences/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/recurrences/usecases"
+)
+
+func TestCreateRecurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockRecurrenceUsecase(ctrl)
+	handler := &handlers.Handler{RecurrenceUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/recurrences", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/recurrences")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		me := "me"
+		startsAt := time.Date(2026, 11, 2, 0, 0, 0, 0, time.FixedZone("", 7*60*60))
+		expected := &entities.Recurrence{
+			Title:       "Weekly report",
+			Description: "Send the weekly report to the team.",
+			Priority:    entities.TaskPriorityHigh,
+			AssigneeId:  &me,
+			Schedule:    "FREQ=WEEKLY;BYDAY=MO",
+			Timezone:    "Asia/Bangkok",
+		}
+		mockUsecase.EXPECT().CreateRecurrence(gomock.Any(), anonymous, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, recurrence *entities.Recurrence) error {
+				assert.True(t, startsAt.Equal(recurrence.StartsAt))
+				recurrence.StartsAt = time.Time{}
+				assert.Equal(t, expected, recurrence)
+				recurrence.Id = 3
+				return nil
+			},
+		)
+
+		c, rec := newContext(`{
+			"title": "Weekly report",
+			"description": "Send the weekly report to the team.",
+			"priority": "HIGH",
+			"assignee_id": "me",
+			"schedule": "FREQ=WEEKLY;BYDAY=MO",
+			"timezone": "Asia/Bangkok",
+			"starts_at": "2026-11-02T00:00:00+07:00"
+		}`)
+		if assert.NoError(t, handler.CreateRecurrence(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+
+			var response struct {
+				Data entities.Recurrence `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, uint(3), response.Data.Id)
+		}
+	})
+
+	t.Run("BadRequest_MissingSchedule", func(t *testing.T) {
+		c, rec := newContext(`{"title": "Weekly report", "description": "Send the report.", "timezone": "UTC"}`)
+		if assert.Error(t, invoke(handler.CreateRecurrence, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidSchedule", func(t *testing.T) {
+		mockUsecase.EXPECT().CreateRecurrence(gomock.Any(), anonymous, gomock.Any()).
+			Return(domainerrors.Validation("invalid schedule: cron: expected 5 fields"))
+
+		c, rec := newContext(`{"title": "Weekly report", "description": "Send the report.", "schedule": "weekly", "timezone": "UTC"}`)
+		if assert.Error(t, invoke(handler.CreateRecurrence, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
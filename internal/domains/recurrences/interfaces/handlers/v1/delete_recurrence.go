@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// DeleteRecurrence deletes a recurrence
+// @Summary Delete a recurrence
+// @Description Delete a recurrence; the tasks it created are kept. Only its creator and admins of the workspace can
+// @Tags recurrences
+// @Produce json
+// @Param id path int true "Recurrence ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 204 "Recurrence deleted"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role, API key scope or creator not allowed"
+// @Failure 404 {object} models.ProblemDetails "Recurrence not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/recurrences/{id} [delete]
+func (h *Handler) DeleteRecurrence(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+	if err := h.RecurrenceUsecase.DeleteRecurrence(c.Request().Context(), interfaces.ActorFrom(c), id); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,50 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/recurrences/interfaces/handlers/v1"
+	mocks "github.com/supachai1998/task_services/internal/mocks/recurrences/usecases"
+)
+
+func TestDeleteRecurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockRecurrenceUsecase(ctrl)
+	handler := &handlers.Handler{RecurrenceUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodDelete, "/v1/recurrences/"+id, nil), rec)
+		c.SetPath("/v1/recurrences/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteRecurrence(gomock.Any(), anonymous, uint(4)).Return(nil)
+
+		c, rec := newContext("4")
+		if assert.NoError(t, handler.DeleteRecurrence(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteRecurrence(gomock.Any(), anonymous, uint(9)).Return(domainerrors.NotFound("recurrence not found"))
+
+		c, rec := newContext("9")
+		if assert.Error(t, invoke(handler.DeleteRecurrence, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/recurrences/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/recurrences/usecases"
+)
+
+func TestGetRecurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockRecurrenceUsecase(ctrl)
+	handler := &handlers.Handler{RecurrenceUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/recurrences/"+id, nil), rec)
+		c.SetPath("/v1/recurrences/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().GetRecurrence(gomock.Any(), uint(4)).Return(&entities.Recurrence{Id: 4}, nil)
+
+		c, rec := newContext("4")
+		if assert.NoError(t, handler.GetRecurrence(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().GetRecurrence(gomock.Any(), uint(9)).Return(nil, domainerrors.NotFound("recurrence not found"))
+
+		c, rec := newContext("9")
+		if assert.Error(t, invoke(handler.GetRecurrence, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidID", func(t *testing.T) {
+		c, rec := newContext("abc")
+		if assert.Error(t, invoke(handler.GetRecurrence, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
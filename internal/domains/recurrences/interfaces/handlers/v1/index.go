@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+type Handler struct {
+	RecurrenceUsecase usecases.RecurrenceUsecase
+}
+
+func NewRecurrenceHandler(e *echo.Echo, recurrenceUsecase usecases.RecurrenceUsecase) {
+	handler := &Handler{
+		RecurrenceUsecase: recurrenceUsecase,
+	}
+	// Recurrences create tasks, so they take the roles and the scopes of the tasks.
+	read := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleViewer),
+		interfaces.RequireScope(entities.ScopeTasksRead),
+	}
+	write := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleMember),
+		interfaces.RequireScope(entities.ScopeTasksWrite),
+	}
+	remove := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleMember),
+		interfaces.RequireScope(entities.ScopeTasksDelete),
+	}
+
+	e.POST("/v1/recurrences", handler.CreateRecurrence, write...)
+	e.GET("/v1/recurrences", handler.ListRecurrences, read...)
+	e.POST("/v1/recurrences\\:preview", handler.PreviewOccurrences, read...)
+	e.GET("/v1/recurrences/:id", handler.GetRecurrence, read...)
+	e.PUT("/v1/recurrences/:id", handler.UpdateRecurrence, write...)
+	e.DELETE("/v1/recurrences/:id", handler.DeleteRecurrence, remove...)
+	e.GET("/v1/recurrences/:id/occurrences", handler.ListOccurrences, read...)
+}
+
+// parseIDParam reads an ID path parameter.
+func parseIDParam(c echo.Context, name string) (uint, error) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 0)
+	if err != nil {
+		return 0, domainerrors.Validation("Invalid ID format")
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// ListOccurrences lists the next occurrences of a recurrence
+// @Summary List the next occurrences of a recurrence
+// @Description List the next occurrences of a recurrence after now, in its time zone, up to its end. A paused recurrence
+// @Description skips its occurrences until it resumes
+// @Tags recurrences
+// @Produce json
+// @Param id path int true "Recurrence ID"
+// @Param count query int false "Number of occurrences" minimum(1) maximum(100) default(10)
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=models.Occurrences} "Occurrences listed"
+// @Failure 400 {object} models.ProblemDetails "Invalid query"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Recurrence not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/recurrences/{id}/occurrences [get]
+func (h *Handler) ListOccurrences(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+	query := new(models.OccurrencesQuery)
+	if err := c.Bind(query); err != nil {
+		return err
+	}
+	if err := c.Validate(query); err != nil {
+		return err
+	}
+
+	occurrences, err := h.RecurrenceUsecase.ListOccurrences(c.Request().Context(), id, query.Count)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Occurrences listed", occurrences))
+}
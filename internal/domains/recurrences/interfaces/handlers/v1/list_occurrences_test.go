@@ -0,0 +1,50 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/recurrences/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/recurrences/usecases"
+)
+
+func TestListOccurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockRecurrenceUsecase(ctrl)
+	handler := &handlers.Handler{RecurrenceUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, query string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/recurrences/"+id+"/occurrences?"+query, nil), rec)
+		c.SetPath("/v1/recurrences/:id/occurrences")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().ListOccurrences(gomock.Any(), uint(3), 5).Return(&models.Occurrences{Timezone: "UTC"}, nil)
+
+		c, rec := newContext("3", "count=5")
+		if assert.NoError(t, handler.ListOccurrences(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_CountTooLarge", func(t *testing.T) {
+		c, rec := newContext("3", "count=1000")
+		if assert.Error(t, invoke(handler.ListOccurrences, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// ListRecurrences lists the recurrences
+// @Summary List the recurrences
+// @Description List the recurrences of the workspace, oldest first
+// @Tags recurrences
+// @Produce json
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=[]entities.Recurrence} "Recurrences listed"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/recurrences [get]
+func (h *Handler) ListRecurrences(c echo.Context) error {
+	recurrences, err := h.RecurrenceUsecase.ListRecurrences(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Recurrences listed", recurrences))
+}
@@ -0,0 +1,31 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/recurrences/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/recurrences/usecases"
+)
+
+func TestListRecurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockRecurrenceUsecase(ctrl)
+	handler := &handlers.Handler{RecurrenceUsecase: mockUsecase}
+	e := echo.New()
+
+	mockUsecase.EXPECT().ListRecurrences(gomock.Any()).Return([]entities.Recurrence{{Id: 1}, {Id: 2}}, nil)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/recurrences", nil), rec)
+	if assert.NoError(t, handler.ListRecurrences(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// PreviewOccurrences lists the next occurrences of a schedule
+// @Summary Preview a schedule
+// @Description List the next occurrences of a cron expression or an RRULE after now, in the time zone, without creating a
+// @Description recurrence
+// @Tags recurrences
+// @Accept json
+// @Produce json
+// @Param schedule body models.PreviewRequest true "Schedule"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=models.Occurrences} "Occurrences listed"
+// @Failure 400 {object} models.ProblemDetails "Invalid input, schedule or time zone"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/recurrences:preview [post]
+func (h *Handler) PreviewOccurrences(c echo.Context) error {
+	req := new(models.PreviewRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	occurrences, err := h.RecurrenceUsecase.PreviewOccurrences(c.Request().Context(), &req.ScheduleRequest, req.Count)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Occurrences listed", occurrences))
+}
@@ -0,0 +1,67 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/recurrences/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/recurrences/usecases"
+)
+
+func TestPreviewOccurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockRecurrenceUsecase(ctrl)
+	handler := &handlers.Handler{RecurrenceUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/recurrences:preview", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/recurrences\\:preview")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		bangkok := time.FixedZone("+07", 7*60*60)
+		mockUsecase.EXPECT().PreviewOccurrences(gomock.Any(), &models.ScheduleRequest{Schedule: "0 9 * * 1-5", Timezone: "Asia/Bangkok"}, 2).
+			Return(&models.Occurrences{Timezone: "Asia/Bangkok", Occurrences: []time.Time{
+				time.Date(2026, 11, 2, 9, 0, 0, 0, bangkok),
+				time.Date(2026, 11, 3, 9, 0, 0, 0, bangkok),
+			}}, nil)
+
+		c, rec := newContext(`{"schedule": "0 9 * * 1-5", "timezone": "Asia/Bangkok", "count": 2}`)
+		if assert.NoError(t, handler.PreviewOccurrences(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response struct {
+				Data struct {
+					Occurrences []string `json:"occurrences"`
+				} `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			// The occurrences keep the offset of the time zone.
+			assert.Equal(t, []string{"2026-11-02T09:00:00+07:00", "2026-11-03T09:00:00+07:00"}, response.Data.Occurrences)
+		}
+	})
+
+	t.Run("BadRequest_MissingTimezone", func(t *testing.T) {
+		c, rec := newContext(`{"schedule": "@daily"}`)
+		if assert.Error(t, invoke(handler.PreviewOccurrences, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// UpdateRecurrence replaces a recurrence
+// @Summary Update a recurrence
+// @Description Replace the template and the schedule of a recurrence; its next occurrence is the first one after now, and the
+// @Description tasks it already created are kept. Without starts_at, the recurrence keeps its start. Only its creator and
+// @Description admins of the workspace can
+// @Tags recurrences
+// @Accept json
+// @Produce json
+// @Param id path int true "Recurrence ID"
+// @Param recurrence body models.RecurrenceRequest true "Recurrence"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=entities.Recurrence} "Recurrence updated"
+// @Failure 400 {object} models.ProblemDetails "Invalid input, schedule or time zone"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role, API key scope or creator not allowed"
+// @Failure 404 {object} models.ProblemDetails "Recurrence not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/recurrences/{id} [put]
+func (h *Handler) UpdateRecurrence(c echo.Context) error {
+	id, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+	req := new(models.RecurrenceRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	recurrence := req.Recurrence()
+	recurrence.Id = id
+	if err := h.RecurrenceUsecase.UpdateRecurrence(c.Request().Context(), interfaces.ActorFrom(c), recurrence); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Recurrence updated", recurrence))
+}
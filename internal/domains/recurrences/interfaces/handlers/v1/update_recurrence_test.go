@@ -0,0 +1,73 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/recurrences/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/recurrences/usecases"
+)
+
+func TestUpdateRecurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockRecurrenceUsecase(ctrl)
+	handler := &handlers.Handler{RecurrenceUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/v1/recurrences/"+id, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/recurrences/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+	body := `{"title": "Daily standup", "description": "Write the notes.", "schedule": "@daily", "timezone": "UTC", "paused": true}`
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateRecurrence(gomock.Any(), anonymous, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, recurrence *entities.Recurrence) error {
+				assert.Equal(t, uint(3), recurrence.Id)
+				assert.True(t, recurrence.Paused)
+				assert.True(t, recurrence.StartsAt.IsZero())
+				return nil
+			},
+		)
+
+		c, rec := newContext("3", body)
+		if assert.NoError(t, handler.UpdateRecurrence(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("Forbidden_NotCreator", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateRecurrence(gomock.Any(), anonymous, gomock.Any()).
+			Return(domainerrors.Forbidden("only the creator of the recurrence can change it"))
+
+		c, rec := newContext("3", body)
+		if assert.Error(t, invoke(handler.UpdateRecurrence, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidPriority", func(t *testing.T) {
+		c, rec := newContext("3", `{"title": "Daily standup", "description": "Write the notes.", "schedule": "@daily", "timezone": "UTC", "priority": "SOON"}`)
+		if assert.Error(t, invoke(handler.UpdateRecurrence, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
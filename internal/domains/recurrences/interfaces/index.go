@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// RecurrenceRepository stores the recurrences of the workspace of ctx. The methods of the
+// scheduler work on the recurrences of every workspace.
+type RecurrenceRepository interface {
+	Create(ctx context.Context, recurrence *entities.Recurrence) error
+	GetByID(ctx context.Context, id uint) (*entities.Recurrence, error)
+	// List returns every recurrence, by ID.
+	List(ctx context.Context) ([]entities.Recurrence, error)
+	// Update saves the template, the schedule and the next occurrence of a recurrence, and releases
+	// the lease of a scheduler, whose occurrence is then no longer due.
+	Update(ctx context.Context, recurrence *entities.Recurrence) error
+	// Delete deletes a recurrence; its tasks are kept.
+	Delete(ctx context.Context, id uint) error
+	// ClaimDue leases up to limit recurrences of every workspace that are not paused and whose next
+	// occurrence is due at now until now+lease, so other schedulers skip them while their tasks are
+	// created and retry them if the scheduler stops before finishing them.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entities.Recurrence, error)
+	// Advance saves the last and next occurrences of a claimed recurrence of any workspace and
+	// releases its lease, unless its next occurrence changed from claimed since the claim.
+	Advance(ctx context.Context, recurrence *entities.Recurrence, claimed time.Time) error
+}
+
+// TaskCreator creates the tasks of the recurrences in the workspace of ctx.
+type TaskCreator interface {
+	CreateTask(ctx context.Context, actor entities.Actor, task *entities.Task) error
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+const (
+	// Me stands for the authenticated user in the assignee of a recurrence.
+	Me = "me"
+
+	DefaultOccurrenceCount = 10
+	MaxOccurrenceCount     = 100
+)
+
+// ScheduleRequest is the schedule of a recurrence.
+type ScheduleRequest struct {
+	// Schedule is a 5-field cron expression, with the @daily, @weekly, @monthly, @yearly and @hourly
+	// macros, or an RRULE with a FREQ of DAILY, WEEKLY, MONTHLY or YEARLY.
+	Schedule string `json:"schedule" validate:"required,max=255" example:"0 9 * * MON-FRI"`
+	// Timezone is the IANA time zone of the wall clock times of the schedule.
+	Timezone string `json:"timezone" validate:"required,max=64" example:"Asia/Bangkok"`
+	// StartsAt is the first possible occurrence and the DTSTART of an RRULE; now when omitted.
+	StartsAt *time.Time `json:"starts_at" example:"2026-11-02T00:00:00+07:00"`
+	// EndsAt, when set, is the last possible occurrence.
+	EndsAt *time.Time `json:"ends_at"`
+}
+
+// RecurrenceRequest is the body of the requests creating or replacing a recurrence. Replacing a
+// recurrence without starts_at keeps its start.
+type RecurrenceRequest struct {
+	Title       string `json:"title" validate:"required,min=3,max=100" example:"Weekly report"`
+	Description string `json:"description" validate:"required,min=3,max=25500" example:"Send the weekly report to the team."`
+	// Priority is MEDIUM when omitted.
+	Priority        entities.TaskPriority `json:"priority" validate:"omitempty,oneof=LOW MEDIUM HIGH URGENT" example:"HIGH"`
+	EstimateMinutes *int                  `json:"estimate_minutes" validate:"omitempty,min=0,max=525600" example:"30"`
+	// AssigneeId is the user the tasks are assigned to, or "me".
+	AssigneeId *string `json:"assignee_id" validate:"omitempty,min=1,max=255" example:"me"`
+	// DueAfterMinutes makes the tasks due that long after their occurrence.
+	DueAfterMinutes *int `json:"due_after_minutes" validate:"omitempty,min=0,max=525600" example:"480"`
+	ScheduleRequest
+	Paused bool `json:"paused" example:"false"`
+}
+
+// Recurrence returns the recurrence of the request.
+func (r RecurrenceRequest) Recurrence() *entities.Recurrence {
+	recurrence := &entities.Recurrence{
+		Title:           r.Title,
+		Description:     r.Description,
+		Priority:        r.Priority,
+		EstimateMinutes: r.EstimateMinutes,
+		AssigneeId:      r.AssigneeId,
+		DueAfterMinutes: r.DueAfterMinutes,
+		Schedule:        r.Schedule,
+		Timezone:        r.Timezone,
+		EndsAt:          r.EndsAt,
+		Paused:          r.Paused,
+	}
+	if r.StartsAt != nil {
+		recurrence.StartsAt = *r.StartsAt
+	}
+	return recurrence
+}
+
+// PreviewRequest is the body of POST /v1/recurrences:preview.
+type PreviewRequest struct {
+	ScheduleRequest
+	// Count is the number of occurrences, 10 by default.
+	Count int `json:"count" validate:"omitempty,min=1,max=100" example:"5"`
+}
+
+// OccurrencesQuery is the query of GET /v1/recurrences/{id}/occurrences.
+type OccurrencesQuery struct {
+	Count int `query:"count" validate:"omitempty,min=1,max=100"`
+}
+
+// Occurrences are the next occurrences of a schedule, in its time zone.
+type Occurrences struct {
+	Timezone    string      `json:"timezone" example:"Asia/Bangkok"`
+	Occurrences []time.Time `json:"occurrences" example:"2026-11-02T09:00:00+07:00,2026-11-03T09:00:00+07:00"`
+}
@@ -0,0 +1,197 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears bounds the search of the next occurrence of a cron expression that matches no
+// date, such as February 30.
+const cronSearchYears = 5
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	monthNames   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	weekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// cronField is the range of a field of a cron expression, with the names of its values from min.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	minuteField   = cronField{name: "minute", min: 0, max: 59}
+	hourField     = cronField{name: "hour", min: 0, max: 23}
+	dayField      = cronField{name: "day of month", min: 1, max: 31}
+	monthField    = cronField{name: "month", min: 1, max: 12, names: monthNames}
+	weekdayField  = cronField{name: "day of week", min: 0, max: 7, names: weekdayNames}
+	cronFieldList = []cronField{minuteField, hourField, dayField, monthField, weekdayField}
+)
+
+// cron is a standard 5-field cron expression: minute, hour, day of month, month and day of week.
+type cron struct {
+	minutes, hours, days, months, weekdays uint64
+	// As in Vixie cron, a date matches either of the day of month and the day of week when both
+	// are restricted.
+	anyDay, anyWeekday bool
+	start              time.Time
+}
+
+func parseCron(expression string, start time.Time) (*cron, error) {
+	if macro, ok := cronMacros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFieldList) {
+		return nil, fmt.Errorf("a cron expression has %d fields, got %d", len(cronFieldList), len(fields))
+	}
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		var err error
+		if bits[i], err = cronFieldList[i].parse(field); err != nil {
+			return nil, err
+		}
+	}
+	// Sunday is both 0 and 7.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return &cron{
+		minutes:    bits[0],
+		hours:      bits[1],
+		days:       bits[2],
+		months:     bits[3],
+		weekdays:   bits[4],
+		anyDay:     fields[2] == "*" || fields[2] == "?",
+		anyWeekday: fields[4] == "*" || fields[4] == "?",
+		start:      start,
+	}, nil
+}
+
+// parse parses a comma-separated list of values, ranges and steps of f into a set of bits.
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		low, high, step := f.min, f.max, 1
+		spec := item
+		if i := strings.Index(item, "/"); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step %q of the %s", item[i+1:], f.name)
+			}
+			spec, step = item[:i], n
+		}
+		switch {
+		case spec == "*" || spec == "?":
+		case strings.Contains(spec, "-"):
+			bounds := strings.SplitN(spec, "-", 2)
+			var err error
+			if low, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			if high, err = f.value(bounds[1]); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q of the %s", spec, f.name)
+			}
+		default:
+			var err error
+			if low, err = f.value(spec); err != nil {
+				return 0, err
+			}
+			// A value with a step runs to the end of the range, as in 5/15.
+			if step == 1 {
+				high = low
+			}
+		}
+		for v := low; v <= high; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// value parses a number or a name of f.
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q, expected %d to %d", f.name, s, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next searches minute by minute, skipping the months, days and hours that do not match. The
+// wall clock times skipped by a daylight saving change never occur.
+func (c *cron) Next(after time.Time) (time.Time, bool) {
+	if after.Before(c.start) {
+		after = c.start.Add(-time.Nanosecond)
+	}
+	loc := c.start.Location()
+	after = after.In(loc)
+	t := date(after.Year(), after.Month(), after.Day(), after.Hour(), after.Minute(), 0, loc).Add(time.Minute)
+	limit := t.Year() + cronSearchYears
+
+wrap:
+	if t.Year() > limit {
+		return time.Time{}, false
+	}
+	for c.months&(1<<uint(t.Month())) == 0 {
+		t = date(t.Year(), t.Month()+1, 1, 0, 0, 0, loc)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+	for !c.matchesDay(t) {
+		t = date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, loc)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+	for c.hours&(1<<uint(t.Hour())) == 0 {
+		t = date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, loc)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+	for c.minutes&(1<<uint(t.Minute())) == 0 {
+		t = date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, loc)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+	// The repeated hour of a daylight saving change can lead back in time.
+	if !t.After(after) {
+		t = t.Add(time.Hour)
+		goto wrap
+	}
+	return t, true
+}
+
+func (c *cron) matchesDay(t time.Time) bool {
+	day := c.days&(1<<uint(t.Day())) != 0
+	weekday := c.weekdays&(1<<uint(t.Weekday())) != 0
+	if c.anyDay || c.anyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
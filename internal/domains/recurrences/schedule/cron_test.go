@@ -0,0 +1,129 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/schedule"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+// occurrences returns the first n occurrences of rule from start, formatted in loc.
+func occurrences(t *testing.T, rule string, start time.Time, loc *time.Location, n int) []string {
+	s, err := schedule.Parse(rule, start, loc)
+	require.NoError(t, err)
+	formatted := []string{}
+	for _, occurrence := range schedule.Occurrences(s, start.Add(-time.Nanosecond), n) {
+		formatted = append(formatted, occurrence.In(loc).Format("2006-01-02 15:04 Mon -0700"))
+	}
+	return formatted
+}
+
+func TestCron(t *testing.T) {
+	bangkok := mustLoad(t, "Asia/Bangkok")
+	start := time.Date(2026, 3, 2, 8, 30, 0, 0, bangkok)
+
+	tests := []struct {
+		name     string
+		rule     string
+		n        int
+		expected []string
+	}{
+		{
+			name: "WeekdaysAtNine",
+			rule: "0 9 * * MON-FRI",
+			n:    6,
+			expected: []string{
+				"2026-03-02 09:00 Mon +0700", "2026-03-03 09:00 Tue +0700", "2026-03-04 09:00 Wed +0700",
+				"2026-03-05 09:00 Thu +0700", "2026-03-06 09:00 Fri +0700", "2026-03-09 09:00 Mon +0700",
+			},
+		},
+		{
+			name:     "IncludesTheStart",
+			rule:     "30 8 * * *",
+			n:        2,
+			expected: []string{"2026-03-02 08:30 Mon +0700", "2026-03-03 08:30 Tue +0700"},
+		},
+		{
+			name:     "StepsAndLists",
+			rule:     "*/20 9,17 2 3 *",
+			n:        4,
+			expected: []string{"2026-03-02 09:00 Mon +0700", "2026-03-02 09:20 Mon +0700", "2026-03-02 09:40 Mon +0700", "2026-03-02 17:00 Mon +0700"},
+		},
+		{
+			name:     "DayOfMonthOrDayOfWeek",
+			rule:     "0 0 13 * 5",
+			n:        3,
+			expected: []string{"2026-03-06 00:00 Fri +0700", "2026-03-13 00:00 Fri +0700", "2026-03-20 00:00 Fri +0700"},
+		},
+		{
+			name:     "SundayAsSeven",
+			rule:     "0 12 * * 7",
+			n:        1,
+			expected: []string{"2026-03-08 12:00 Sun +0700"},
+		},
+		{
+			name:     "Macro",
+			rule:     "@monthly",
+			n:        2,
+			expected: []string{"2026-04-01 00:00 Wed +0700", "2026-05-01 00:00 Fri +0700"},
+		},
+		{
+			name:     "LeapDay",
+			rule:     "0 0 29 FEB *",
+			n:        2,
+			expected: []string{"2028-02-29 00:00 Tue +0700", "2032-02-29 00:00 Sun +0700"},
+		},
+		{
+			name:     "NeverMatches",
+			rule:     "0 0 30 2 *",
+			n:        1,
+			expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, occurrences(t, tt.rule, start, bangkok, tt.n))
+		})
+	}
+}
+
+func TestCronDaylightSaving(t *testing.T) {
+	newYork := mustLoad(t, "America/New_York")
+
+	t.Run("KeepsTheWallClock", func(t *testing.T) {
+		start := time.Date(2026, 3, 7, 0, 0, 0, 0, newYork)
+		assert.Equal(t, []string{
+			"2026-03-07 09:00 Sat -0500", "2026-03-08 09:00 Sun -0400", "2026-03-09 09:00 Mon -0400",
+		}, occurrences(t, "0 9 * * *", start, newYork, 3))
+	})
+
+	t.Run("SkipsTheMissingHour", func(t *testing.T) {
+		start := time.Date(2026, 3, 7, 0, 0, 0, 0, newYork)
+		assert.Equal(t, []string{
+			"2026-03-07 02:30 Sat -0500", "2026-03-09 02:30 Mon -0400",
+		}, occurrences(t, "30 2 * * *", start, newYork, 2))
+	})
+
+	t.Run("RepeatedHourOccursOnce", func(t *testing.T) {
+		start := time.Date(2026, 10, 31, 0, 0, 0, 0, newYork)
+		assert.Equal(t, []string{
+			"2026-10-31 01:30 Sat -0400", "2026-11-01 01:30 Sun -0400", "2026-11-02 01:30 Mon -0500",
+		}, occurrences(t, "30 1 * * *", start, newYork, 3))
+	})
+}
+
+func TestParseCron(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	for _, rule := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "* * * FOO *", "@every 5m"} {
+		_, err := schedule.Parse(rule, start, time.UTC)
+		assert.Error(t, err, rule)
+	}
+}
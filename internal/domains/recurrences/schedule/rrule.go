@@ -0,0 +1,390 @@
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rruleSearchPeriods bounds the search of the next occurrence of an RRULE whose periods have no
+// occurrence, such as every February 30.
+const rruleSearchPeriods = 1000
+
+type frequency int
+
+const (
+	daily frequency = iota
+	weekly
+	monthly
+	yearly
+)
+
+var frequencies = map[string]frequency{
+	"DAILY":   daily,
+	"WEEKLY":  weekly,
+	"MONTHLY": monthly,
+	"YEARLY":  yearly,
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// weekdayNum is a day of BYDAY, the nth such weekday of the month when n is not zero, counting
+// from the end when it is negative.
+type weekdayNum struct {
+	n       int
+	weekday time.Weekday
+}
+
+// rrule is the subset of the RRULEs of RFC 5545 with a FREQ of DAILY, WEEKLY, MONTHLY or YEARLY,
+// and the INTERVAL, COUNT, UNTIL, WKST, BYMONTH, BYMONTHDAY, BYDAY, BYHOUR and BYMINUTE parts.
+// Its DTSTART is the start of the schedule.
+type rrule struct {
+	freq       frequency
+	interval   int
+	count      int
+	until      *time.Time
+	weekStart  time.Weekday
+	byMonth    []int
+	byMonthDay []int
+	byDay      []weekdayNum
+	byHour     []int
+	byMinute   []int
+	start      time.Time
+}
+
+func parseRRule(rule string, start time.Time) (*rrule, error) {
+	if len(rule) >= 6 && strings.EqualFold(rule[:6], "RRULE:") {
+		rule = rule[6:]
+	}
+	r := &rrule{freq: -1, interval: 1, weekStart: time.Monday, start: start}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(strings.ToUpper(rule), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid RRULE part %q", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate RRULE part %s", name)
+		}
+		seen[name] = true
+		var err error
+		switch name {
+		case "FREQ":
+			freq, ok := frequencies[value]
+			if !ok {
+				return nil, fmt.Errorf("unsupported FREQ %s, expected DAILY, WEEKLY, MONTHLY or YEARLY", value)
+			}
+			r.freq = freq
+		case "INTERVAL":
+			r.interval, err = parseInt(name, value, 1, 1000)
+		case "COUNT":
+			r.count, err = parseInt(name, value, 1, 100000)
+		case "UNTIL":
+			var until time.Time
+			until, err = parseUntil(value, start.Location())
+			r.until = &until
+		case "WKST":
+			weekday, ok := weekdayCodes[value]
+			if !ok {
+				return nil, fmt.Errorf("invalid WKST %s", value)
+			}
+			r.weekStart = weekday
+		case "BYMONTH":
+			r.byMonth, err = parseInts(name, value, 1, 12, false)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseInts(name, value, 1, 31, true)
+		case "BYDAY":
+			r.byDay, err = parseByDay(value)
+		case "BYHOUR":
+			r.byHour, err = parseInts(name, value, 0, 23, false)
+		case "BYMINUTE":
+			r.byMinute, err = parseInts(name, value, 0, 59, false)
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case r.freq < 0:
+		return nil, fmt.Errorf("an RRULE needs a FREQ")
+	case r.count > 0 && r.until != nil:
+		return nil, fmt.Errorf("an RRULE has either a COUNT or an UNTIL")
+	}
+	for _, day := range r.byDay {
+		if day.n != 0 && r.freq != monthly && r.freq != yearly {
+			return nil, fmt.Errorf("the BYDAY ordinals need a MONTHLY or YEARLY FREQ")
+		}
+		if day.n != 0 && r.freq == yearly && len(r.byMonth) == 0 {
+			return nil, fmt.Errorf("the BYDAY ordinals of a YEARLY FREQ need a BYMONTH")
+		}
+	}
+	if r.freq == weekly && len(r.byMonthDay) > 0 {
+		return nil, fmt.Errorf("BYMONTHDAY does not apply to a WEEKLY FREQ")
+	}
+	return r, nil
+}
+
+func parseInt(name, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("invalid %s %s, expected %d to %d", name, value, min, max)
+	}
+	return n, nil
+}
+
+// parseInts parses a comma-separated list of numbers from min to max, or down to -max when
+// negative is true.
+func parseInts(name, value string, min, max int, negative bool) ([]int, error) {
+	values := []int{}
+	for _, s := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(s)
+		if err != nil || n > max || (n < min && !(negative && n <= -min && n >= -max)) {
+			return nil, fmt.Errorf("invalid %s %s", name, s)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+func parseByDay(value string) ([]weekdayNum, error) {
+	days := []weekdayNum{}
+	for _, s := range strings.Split(value, ",") {
+		if len(s) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY %s", s)
+		}
+		weekday, ok := weekdayCodes[s[len(s)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY %s", s)
+		}
+		day := weekdayNum{weekday: weekday}
+		if ordinal := s[:len(s)-2]; ordinal != "" {
+			n, err := strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid BYDAY %s", s)
+			}
+			day.n = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// parseUntil parses a UTC date-time, a local date-time of loc or a date, which includes its day.
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %s, expected a date or a date-time", value)
+}
+
+// Next expands the periods of the rule, the days, weeks, months or years every INTERVAL from
+// DTSTART, in order. A rule with a COUNT is expanded from DTSTART to count its occurrences; any
+// other starts from the period before after.
+func (r *rrule) Next(after time.Time) (time.Time, bool) {
+	first := 0
+	if r.count == 0 {
+		first = r.periodsBetween(after) - 1
+		if first < 0 {
+			first = 0
+		}
+	}
+	count := 0
+	for period := first; period < first+rruleSearchPeriods; period++ {
+		for _, occurrence := range r.expand(period) {
+			if occurrence.Before(r.start) {
+				continue
+			}
+			if r.until != nil && occurrence.After(*r.until) {
+				return time.Time{}, false
+			}
+			count++
+			if r.count > 0 && count > r.count {
+				return time.Time{}, false
+			}
+			if occurrence.After(after) {
+				return occurrence, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// periodsBetween returns the number of periods from DTSTART to t.
+func (r *rrule) periodsBetween(t time.Time) int {
+	if !t.After(r.start) {
+		return 0
+	}
+	t = t.In(r.start.Location())
+	var n int
+	switch r.freq {
+	case daily:
+		n = daysBetween(r.start, t)
+	case weekly:
+		n = daysBetween(r.weekOf(r.start), t) / 7
+	case monthly:
+		n = (t.Year()-r.start.Year())*12 + int(t.Month()-r.start.Month())
+	case yearly:
+		n = t.Year() - r.start.Year()
+	}
+	return n / r.interval
+}
+
+// daysBetween returns the number of calendar days from the day of from to the day of to.
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// weekOf returns the first day of the week of t, which starts on WKST.
+func (r *rrule) weekOf(t time.Time) time.Time {
+	offset := (int(t.Weekday()) - int(r.weekStart) + 7) % 7
+	return date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, t.Location())
+}
+
+// expand returns the occurrences of the nth period, in order.
+func (r *rrule) expand(n int) []time.Time {
+	start, loc := r.start, r.start.Location()
+	step := n * r.interval
+	var days []time.Time
+	switch r.freq {
+	case daily:
+		day := date(start.Year(), start.Month(), start.Day()+step, 0, 0, 0, loc)
+		if r.matchesMonth(day) && r.matchesMonthDay(day) && r.matchesWeekday(day) {
+			days = append(days, day)
+		}
+	case weekly:
+		week := r.weekOf(start)
+		for i := 0; i < 7; i++ {
+			day := date(week.Year(), week.Month(), week.Day()+step*7+i, 0, 0, 0, loc)
+			if !r.matchesMonth(day) {
+				continue
+			}
+			if len(r.byDay) == 0 && day.Weekday() == start.Weekday() || len(r.byDay) > 0 && r.matchesWeekday(day) {
+				days = append(days, day)
+			}
+		}
+	case monthly:
+		month := date(start.Year(), start.Month()+time.Month(step), 1, 0, 0, 0, loc)
+		if r.matchesMonth(month) {
+			days = r.daysOf(month)
+		}
+	case yearly:
+		months := r.byMonth
+		if len(months) == 0 {
+			months = []int{int(start.Month())}
+		}
+		sorted := append([]int(nil), months...)
+		sort.Ints(sorted)
+		for _, m := range sorted {
+			days = append(days, r.daysOf(date(start.Year()+step, time.Month(m), 1, 0, 0, 0, loc))...)
+		}
+	}
+
+	hours, minutes := r.byHour, r.byMinute
+	if len(hours) == 0 {
+		hours = []int{start.Hour()}
+	}
+	if len(minutes) == 0 {
+		minutes = []int{start.Minute()}
+	}
+	occurrences := []time.Time{}
+	for _, day := range days {
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				occurrences = append(occurrences, date(day.Year(), day.Month(), day.Day(), hour, minute, start.Second(), loc))
+			}
+		}
+	}
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].Before(occurrences[j]) })
+	return occurrences
+}
+
+// daysOf returns the days of the month of first matching BYMONTHDAY and BYDAY, or the day of
+// DTSTART when neither is set.
+func (r *rrule) daysOf(first time.Time) []time.Time {
+	days := []time.Time{}
+	last := daysIn(first)
+	for d := 1; d <= last; d++ {
+		day := date(first.Year(), first.Month(), d, 0, 0, 0, first.Location())
+		if len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+			if d == r.start.Day() {
+				days = append(days, day)
+			}
+			continue
+		}
+		if r.matchesMonthDay(day) && r.matchesWeekday(day) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+func (r *rrule) matchesMonth(day time.Time) bool {
+	if len(r.byMonth) == 0 {
+		return true
+	}
+	for _, m := range r.byMonth {
+		if time.Month(m) == day.Month() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMonthDay matches BYMONTHDAY, whose negative days count from the end of the month.
+func (r *rrule) matchesMonthDay(day time.Time) bool {
+	if len(r.byMonthDay) == 0 {
+		return true
+	}
+	last := daysIn(day)
+	for _, d := range r.byMonthDay {
+		if d == day.Day() || d < 0 && last+d+1 == day.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekday matches BYDAY, whose ordinals count the weekdays of the month.
+func (r *rrule) matchesWeekday(day time.Time) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	last := daysIn(day)
+	for _, d := range r.byDay {
+		if d.weekday != day.Weekday() {
+			continue
+		}
+		switch {
+		case d.n == 0,
+			d.n > 0 && (day.Day()-1)/7+1 == d.n,
+			d.n < 0 && (last-day.Day())/7+1 == -d.n:
+			return true
+		}
+	}
+	return false
+}
+
+// daysIn returns the number of days of the month of t.
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,148 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/schedule"
+)
+
+func TestRRule(t *testing.T) {
+	bangkok := mustLoad(t, "Asia/Bangkok")
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, bangkok)
+
+	tests := []struct {
+		name     string
+		rule     string
+		n        int
+		expected []string
+	}{
+		{
+			name:     "Daily",
+			rule:     "FREQ=DAILY",
+			n:        3,
+			expected: []string{"2026-01-05 09:00 Mon +0700", "2026-01-06 09:00 Tue +0700", "2026-01-07 09:00 Wed +0700"},
+		},
+		{
+			name: "EveryOtherWeekOnTwoDays",
+			rule: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			n:    5,
+			expected: []string{
+				"2026-01-05 09:00 Mon +0700", "2026-01-08 09:00 Thu +0700", "2026-01-19 09:00 Mon +0700",
+				"2026-01-22 09:00 Thu +0700", "2026-02-02 09:00 Mon +0700",
+			},
+		},
+		{
+			name:     "WeeklyOnTheDayOfTheStart",
+			rule:     "FREQ=WEEKLY;BYHOUR=8,17;BYMINUTE=15",
+			n:        3,
+			expected: []string{"2026-01-05 17:15 Mon +0700", "2026-01-12 08:15 Mon +0700", "2026-01-12 17:15 Mon +0700"},
+		},
+		{
+			name:     "LastFridayOfTheMonth",
+			rule:     "FREQ=MONTHLY;BYDAY=-1FR",
+			n:        3,
+			expected: []string{"2026-01-30 09:00 Fri +0700", "2026-02-27 09:00 Fri +0700", "2026-03-27 09:00 Fri +0700"},
+		},
+		{
+			name:     "LastDayOfTheMonth",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			n:        3,
+			expected: []string{"2026-01-31 09:00 Sat +0700", "2026-02-28 09:00 Sat +0700", "2026-03-31 09:00 Tue +0700"},
+		},
+		{
+			name:     "MonthlyOnTheDayOfTheStart",
+			rule:     "FREQ=MONTHLY;INTERVAL=3",
+			n:        3,
+			expected: []string{"2026-01-05 09:00 Mon +0700", "2026-04-05 09:00 Sun +0700", "2026-07-05 09:00 Sun +0700"},
+		},
+		{
+			name:     "YearlyOnTheSecondTuesdayOfMarch",
+			rule:     "FREQ=YEARLY;BYMONTH=3;BYDAY=2TU",
+			n:        2,
+			expected: []string{"2026-03-10 09:00 Tue +0700", "2027-03-09 09:00 Tue +0700"},
+		},
+		{
+			name:     "Count",
+			rule:     "FREQ=DAILY;INTERVAL=10;COUNT=2",
+			n:        5,
+			expected: []string{"2026-01-05 09:00 Mon +0700", "2026-01-15 09:00 Thu +0700"},
+		},
+		{
+			name:     "UntilIncludesItsDay",
+			rule:     "FREQ=WEEKLY;BYDAY=MO;UNTIL=20260119",
+			n:        5,
+			expected: []string{"2026-01-05 09:00 Mon +0700", "2026-01-12 09:00 Mon +0700", "2026-01-19 09:00 Mon +0700"},
+		},
+		{
+			name:     "UntilInUTC",
+			rule:     "FREQ=DAILY;UNTIL=20260106T015959Z",
+			n:        5,
+			expected: []string{"2026-01-05 09:00 Mon +0700"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, occurrences(t, tt.rule, start, bangkok, tt.n))
+		})
+	}
+}
+
+func TestRRuleNext(t *testing.T) {
+	newYork := mustLoad(t, "America/New_York")
+	start := time.Date(2020, 1, 1, 9, 0, 0, 0, newYork)
+
+	t.Run("FarFromTheStart", func(t *testing.T) {
+		s, err := schedule.Parse("FREQ=WEEKLY;INTERVAL=2;BYDAY=WE", start, newYork)
+		assert.NoError(t, err)
+
+		next, ok := s.Next(time.Date(2026, 3, 10, 0, 0, 0, 0, newYork))
+
+		assert.True(t, ok)
+		assert.Equal(t, "2026-03-18 09:00 Wed -0400", next.In(newYork).Format("2006-01-02 15:04 Mon -0700"))
+	})
+
+	t.Run("CountEnded", func(t *testing.T) {
+		s, err := schedule.Parse("FREQ=DAILY;COUNT=3", start, newYork)
+		assert.NoError(t, err)
+
+		_, ok := s.Next(time.Date(2020, 1, 3, 9, 0, 0, 0, newYork))
+
+		assert.False(t, ok)
+	})
+
+	t.Run("MovesTheMissingHourForward", func(t *testing.T) {
+		s, err := schedule.Parse("FREQ=DAILY;BYHOUR=2;BYMINUTE=30", start, newYork)
+		assert.NoError(t, err)
+
+		next, ok := s.Next(time.Date(2026, 3, 7, 12, 0, 0, 0, newYork))
+
+		assert.True(t, ok)
+		assert.Equal(t, "2026-03-08 03:30 Sun -0400", next.In(newYork).Format("2006-01-02 15:04 Mon -0700"))
+	})
+}
+
+func TestParseRRule(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	for _, rule := range []string{
+		"FREQ=HOURLY",
+		"INTERVAL=2",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20260201",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=YEARLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=MONTHLY;BYSETPOS=1",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=DAILY;BYHOUR=24",
+	} {
+		_, err := schedule.Parse(rule, start, time.UTC)
+		assert.Error(t, err, rule)
+	}
+	assert.True(t, schedule.IsRRule("rrule:freq=daily"))
+	assert.False(t, schedule.IsRRule("0 9 * * *"))
+}
@@ -0,0 +1,58 @@
+// Package schedule computes the occurrences of the schedules of recurring tasks: cron expressions
+// and a subset of iCalendar RRULEs, evaluated in a time zone.
+package schedule
+
+import (
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed schedule.
+type Schedule interface {
+	// Next returns the first occurrence strictly after after, and false when there is none.
+	Next(after time.Time) (time.Time, bool)
+}
+
+// Parse parses rule, an RRULE when it has a FREQ part, with or without the "RRULE:" prefix, and
+// a cron expression otherwise. The occurrences are wall clock times of loc that are not before
+// start, which is also the DTSTART of an RRULE.
+func Parse(rule string, start time.Time, loc *time.Location) (Schedule, error) {
+	rule = strings.TrimSpace(rule)
+	if IsRRule(rule) {
+		return parseRRule(rule, start.In(loc))
+	}
+	return parseCron(rule, start.In(loc))
+}
+
+// IsRRule reports whether rule is an RRULE rather than a cron expression.
+func IsRRule(rule string) bool {
+	rule = strings.ToUpper(strings.TrimSpace(rule))
+	return strings.HasPrefix(rule, "RRULE:") || strings.HasPrefix(rule, "FREQ=") || strings.Contains(rule, ";FREQ=")
+}
+
+// Occurrences returns the first n occurrences of s after after, fewer when s ends first.
+func Occurrences(s Schedule, after time.Time, n int) []time.Time {
+	occurrences := []time.Time{}
+	for len(occurrences) < n {
+		next, ok := s.Next(after)
+		if !ok {
+			break
+		}
+		occurrences = append(occurrences, next)
+		after = next
+	}
+	return occurrences
+}
+
+// date is time.Date, except that a wall clock time skipped by a daylight saving change moves
+// forward by the length of the change, where time.Date moves it back.
+func date(year int, month time.Month, day, hour, min, sec int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, hour, min, sec, 0, loc)
+	wall := time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+	if time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC).Equal(wall) {
+		return t
+	}
+	// The offset before the change puts the wall clock time after it.
+	_, offset := t.Zone()
+	return wall.Add(-time.Duration(offset) * time.Second).In(loc)
+}
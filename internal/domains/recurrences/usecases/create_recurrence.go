@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+var errAuthenticationRequired = domainerrors.Unauthorized("authentication required")
+
+func (u *usecase) CreateRecurrence(ctx context.Context, actor entities.Actor, recurrence *entities.Recurrence) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "create recurrences"); err != nil {
+		return err
+	}
+	if recurrence.StartsAt.IsZero() {
+		recurrence.StartsAt = u.options.Now()
+	}
+	if err := u.prepare(actor, recurrence); err != nil {
+		return err
+	}
+	if actor.UserId != "" {
+		createdBy := actor.UserId
+		recurrence.CreatedBy = &createdBy
+	}
+	return u.recurrenceRepo.Create(ctx, recurrence)
+}
+
+// prepare resolves the assignee of a recurrence, checks its schedule and computes its next
+// occurrence after now; the missed occurrences are skipped.
+func (u *usecase) prepare(actor entities.Actor, recurrence *entities.Recurrence) error {
+	if recurrence.AssigneeId != nil && *recurrence.AssigneeId == models.Me {
+		if actor.UserId == "" {
+			return errAuthenticationRequired
+		}
+		assigneeID := actor.UserId
+		recurrence.AssigneeId = &assigneeID
+	}
+	if recurrence.Priority == "" {
+		recurrence.Priority = entities.TaskPriorityMedium
+	}
+	if err := checkEnd(recurrence.StartsAt, recurrence.EndsAt); err != nil {
+		return err
+	}
+	s, _, err := parseSchedule(recurrence.Schedule, recurrence.Timezone, recurrence.StartsAt)
+	if err != nil {
+		return err
+	}
+	// Timestamp columns have no time zone; the time zone of the schedule is stored apart.
+	recurrence.StartsAt = recurrence.StartsAt.UTC()
+	if recurrence.EndsAt != nil {
+		endsAt := recurrence.EndsAt.UTC()
+		recurrence.EndsAt = &endsAt
+	}
+	recurrence.NextOccurrenceAt = nextOccurrence(s, u.options.Now(), recurrence.EndsAt)
+	return nil
+}
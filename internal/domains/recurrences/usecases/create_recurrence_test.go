@@ -0,0 +1,89 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/recurrences/interfaces"
+)
+
+// now is a Monday, 09:30 in Bangkok.
+var now = time.Date(2026, 3, 2, 2, 30, 0, 0, time.UTC)
+
+func newUsecase(ctrl *gomock.Controller) (usecases.RecurrenceUsecase, *mocks.MockRecurrenceRepository, *mocks.MockTaskCreator) {
+	mockRepo := mocks.NewMockRecurrenceRepository(ctrl)
+	mockTasks := mocks.NewMockTaskCreator(ctrl)
+	usecase := usecases.NewRecurrenceUsecase(mockRepo, mockTasks, usecases.Options{
+		Now: func() time.Time { return now },
+	})
+	return usecase, mockRepo, mockTasks
+}
+
+func TestCreateRecurrence(t *testing.T) {
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	newRecurrence := func(schedule, timezone string) *entities.Recurrence {
+		me := "me"
+		return &entities.Recurrence{Title: "Weekly report", Schedule: schedule, Timezone: timezone, AssigneeId: &me}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, _ := newUsecase(ctrl)
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		recurrence := newRecurrence("0 9 * * MON-FRI", "Asia/Bangkok")
+		assert.NoError(t, usecase.CreateRecurrence(ctx, alice, recurrence))
+
+		assert.Equal(t, now, recurrence.StartsAt)
+		// 09:00 has passed on Monday in Bangkok.
+		assert.Equal(t, time.Date(2026, 3, 3, 2, 0, 0, 0, time.UTC), *recurrence.NextOccurrenceAt)
+		assert.Equal(t, "alice", *recurrence.CreatedBy)
+		assert.Equal(t, "alice", *recurrence.AssigneeId)
+		assert.Equal(t, entities.TaskPriorityMedium, recurrence.Priority)
+	})
+
+	t.Run("OverBeforeItStarts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, _ := newUsecase(ctrl)
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		recurrence := newRecurrence("FREQ=DAILY;COUNT=2", "UTC")
+		recurrence.StartsAt = now.AddDate(0, 0, -5)
+		assert.NoError(t, usecase.CreateRecurrence(ctx, alice, recurrence))
+
+		assert.Nil(t, recurrence.NextOccurrenceAt)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, _, _ := newUsecase(ctrl)
+		endsAt := now.Add(-time.Hour)
+
+		for name, recurrence := range map[string]*entities.Recurrence{
+			"Schedule":    newRecurrence("0 25 * * *", "UTC"),
+			"RRule":       newRecurrence("FREQ=HOURLY", "UTC"),
+			"Timezone":    newRecurrence("@daily", "Mars/Olympus_Mons"),
+			"LocalTime":   newRecurrence("@daily", "Local"),
+			"EndsAtFirst": {Title: "Weekly report", Schedule: "@daily", Timezone: "UTC", StartsAt: now, EndsAt: &endsAt},
+		} {
+			err := usecase.CreateRecurrence(ctx, alice, recurrence)
+			assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation), name)
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, _, _ := newUsecase(ctrl)
+		viewer := entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleViewer}
+
+		err := usecase.CreateRecurrence(ctx, viewer, newRecurrence("@daily", "UTC"))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
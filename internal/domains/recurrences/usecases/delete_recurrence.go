@@ -0,0 +1,21 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) DeleteRecurrence(ctx context.Context, actor entities.Actor, id uint) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "delete recurrences"); err != nil {
+		return err
+	}
+	recurrence, err := u.recurrenceRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeCreator(actor, recurrence); err != nil {
+		return err
+	}
+	return u.recurrenceRepo.Delete(ctx, id)
+}
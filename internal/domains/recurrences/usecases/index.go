@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type RecurrenceUsecase interface {
+	// CreateRecurrence creates a recurrence in the workspace of ctx, owned by actor.
+	CreateRecurrence(ctx context.Context, actor entities.Actor, recurrence *entities.Recurrence) error
+	// ListRecurrences returns the recurrences of the workspace of ctx, by ID.
+	ListRecurrences(ctx context.Context) ([]entities.Recurrence, error)
+	GetRecurrence(ctx context.Context, id uint) (*entities.Recurrence, error)
+	// UpdateRecurrence replaces the template and the schedule of a recurrence, whose next occurrence
+	// is the first one after now.
+	UpdateRecurrence(ctx context.Context, actor entities.Actor, recurrence *entities.Recurrence) error
+	// DeleteRecurrence deletes a recurrence; the tasks it created are kept.
+	DeleteRecurrence(ctx context.Context, actor entities.Actor, id uint) error
+	// ListOccurrences returns the next count occurrences of a recurrence after now.
+	ListOccurrences(ctx context.Context, id uint, count int) (*models.Occurrences, error)
+	// PreviewOccurrences returns the first count occurrences of a schedule after now.
+	PreviewOccurrences(ctx context.Context, schedule *models.ScheduleRequest, count int) (*models.Occurrences, error)
+	// MaterializeDue creates the tasks of the due recurrences of every workspace and returns how
+	// many recurrences it handled.
+	MaterializeDue(ctx context.Context) (int, error)
+	// RunScheduler calls MaterializeDue every poll interval until ctx is done.
+	RunScheduler(ctx context.Context)
+}
+
+// Options tunes the scheduler of the recurrences.
+type Options struct {
+	// PollInterval is how often the scheduler looks for due recurrences, 30 seconds by default.
+	PollInterval time.Duration
+	// BatchSize is the number of recurrences the scheduler claims at once, 20 by default.
+	BatchSize int
+	// Lease is how long a claimed recurrence is skipped by the other schedulers, 1 minute by default.
+	Lease time.Duration
+	// Now is the clock of the scheduler and of the next occurrences, time.Now by default.
+	Now func() time.Time
+}
+
+type usecase struct {
+	recurrenceRepo interfaces.RecurrenceRepository
+	tasks          interfaces.TaskCreator
+	options        Options
+}
+
+func NewRecurrenceUsecase(recurrenceRepo interfaces.RecurrenceRepository, tasks interfaces.TaskCreator, options Options) RecurrenceUsecase {
+	if options.PollInterval <= 0 {
+		options.PollInterval = 30 * time.Second
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = 20
+	}
+	if options.Lease <= 0 {
+		options.Lease = time.Minute
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return &usecase{recurrenceRepo, tasks, options}
+}
+
+// Authorization policy: reading the recurrences takes the viewer role and writing one the member
+// role. Only the creator of a recurrence, who owns its tasks, changes or deletes it, unless the
+// actor is an admin or anonymous, which only happens when authentication is disabled.
+
+var errNotCreator = domainerrors.Forbidden("only the creator of the recurrence can change it")
+
+// authorizeRole fails when the workspace role of actor does not include required.
+func authorizeRole(actor entities.Actor, required entities.WorkspaceRole, action string) error {
+	if unrestricted(actor) || actor.Role.Includes(required) {
+		return nil
+	}
+	return domainerrors.Forbidden(fmt.Sprintf("the %s role is required to %s", required, action)).
+		WithDetail("role", actor.Role).
+		WithDetail("required_role", required)
+}
+
+// authorizeCreator fails unless actor created recurrence.
+func authorizeCreator(actor entities.Actor, recurrence *entities.Recurrence) error {
+	if unrestricted(actor) || (recurrence.CreatedBy != nil && *recurrence.CreatedBy == actor.UserId) {
+		return nil
+	}
+	return errNotCreator
+}
+
+// unrestricted reports whether the policy does not apply to actor.
+func unrestricted(actor entities.Actor) bool {
+	return actor.Admin || actor.Role == entities.WorkspaceRoleAdmin || actor.UserId == ""
+}
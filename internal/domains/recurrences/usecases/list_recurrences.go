@@ -0,0 +1,15 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListRecurrences(ctx context.Context) ([]entities.Recurrence, error) {
+	return u.recurrenceRepo.List(ctx)
+}
+
+func (u *usecase) GetRecurrence(ctx context.Context, id uint) (*entities.Recurrence, error) {
+	return u.recurrenceRepo.GetByID(ctx, id)
+}
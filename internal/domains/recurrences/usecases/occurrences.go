@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+)
+
+// ListOccurrences also lists the occurrences of a paused recurrence, which it skips until it resumes.
+func (u *usecase) ListOccurrences(ctx context.Context, id uint, count int) (*models.Occurrences, error) {
+	recurrence, err := u.recurrenceRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s, loc, err := parseSchedule(recurrence.Schedule, recurrence.Timezone, recurrence.StartsAt)
+	if err != nil {
+		return nil, err
+	}
+	return occurrences(s, loc, u.options.Now(), recurrence.EndsAt, count), nil
+}
+
+func (u *usecase) PreviewOccurrences(ctx context.Context, schedule *models.ScheduleRequest, count int) (*models.Occurrences, error) {
+	now := u.options.Now()
+	startsAt := now
+	if schedule.StartsAt != nil {
+		startsAt = *schedule.StartsAt
+	}
+	if err := checkEnd(startsAt, schedule.EndsAt); err != nil {
+		return nil, err
+	}
+	s, loc, err := parseSchedule(schedule.Schedule, schedule.Timezone, startsAt)
+	if err != nil {
+		return nil, err
+	}
+	return occurrences(s, loc, now, schedule.EndsAt, count), nil
+}
@@ -0,0 +1,62 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestOccurrences(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ListInTheTimeZone", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, _ := newUsecase(ctrl)
+		endsAt := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+		mockRepo.EXPECT().GetByID(ctx, uint(3)).Return(&entities.Recurrence{
+			Id: 3, Schedule: "30 8 * * *", Timezone: "Asia/Bangkok", StartsAt: now.AddDate(0, -1, 0), EndsAt: &endsAt,
+		}, nil)
+
+		occurrences, err := usecase.ListOccurrences(ctx, 3, 5)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Asia/Bangkok", occurrences.Timezone)
+			formatted := []string{}
+			for _, occurrence := range occurrences.Occurrences {
+				formatted = append(formatted, occurrence.Format(time.RFC3339))
+			}
+			// The occurrences up to the end.
+			assert.Equal(t, []string{"2026-03-03T08:30:00+07:00"}, formatted)
+		}
+	})
+
+	t.Run("Preview", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, _, _ := newUsecase(ctrl)
+
+		occurrences, err := usecase.PreviewOccurrences(ctx, &models.ScheduleRequest{
+			Schedule: "RRULE:FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=17;BYMINUTE=0",
+			Timezone: "Europe/London",
+		}, 0)
+
+		if assert.NoError(t, err) && assert.Len(t, occurrences.Occurrences, models.DefaultOccurrenceCount) {
+			assert.Equal(t, "2026-03-27T17:00:00Z", occurrences.Occurrences[0].Format(time.RFC3339))
+			assert.Equal(t, "2026-04-24T17:00:00+01:00", occurrences.Occurrences[1].Format(time.RFC3339))
+		}
+	})
+
+	t.Run("PreviewInvalidSchedule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, _, _ := newUsecase(ctrl)
+
+		_, err := usecase.PreviewOccurrences(ctx, &models.ScheduleRequest{Schedule: "every day", Timezone: "UTC"}, 5)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+}
@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// SchedulerActor is the actor of the history of the tasks created by the recurrences.
+const SchedulerActor = "scheduler"
+
+func (u *usecase) RunScheduler(ctx context.Context) {
+	ticker := time.NewTicker(u.options.PollInterval)
+	defer ticker.Stop()
+	for {
+		// Keep going while full batches are due, so a backlog drains without waiting for the ticker.
+		for {
+			handled, err := u.MaterializeDue(ctx)
+			if err != nil {
+				log.Printf("recurrences: cannot create the tasks of the due recurrences: %v", err)
+			}
+			if err != nil || handled < u.options.BatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// MaterializeDue creates each occurrence once, even with several schedulers: a claimed recurrence
+// is skipped by the others until its lease ends, and the tasks are unique by recurrence and
+// occurrence, should a scheduler outlive its lease.
+func (u *usecase) MaterializeDue(ctx context.Context) (int, error) {
+	recurrences, err := u.recurrenceRepo.ClaimDue(ctx, u.options.Now(), u.options.Lease, u.options.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for i := range recurrences {
+		if err := u.materialize(ctx, &recurrences[i]); err != nil {
+			// The recurrence is retried once its lease ends.
+			log.Printf("recurrences: cannot create the task of recurrence %d: %v", recurrences[i].Id, err)
+		}
+	}
+	return len(recurrences), nil
+}
+
+// materialize creates the task of the latest due occurrence of a claimed recurrence, skipping
+// the ones missed while no scheduler ran, and advances it to its next occurrence.
+func (u *usecase) materialize(ctx context.Context, recurrence *entities.Recurrence) error {
+	s, _, err := parseSchedule(recurrence.Schedule, recurrence.Timezone, recurrence.StartsAt)
+	if err != nil {
+		return err
+	}
+	now := u.options.Now()
+	claimed := *recurrence.NextOccurrenceAt
+	occurrence := claimed
+	for {
+		next := nextOccurrence(s, occurrence, recurrence.EndsAt)
+		if next == nil || next.After(now) {
+			break
+		}
+		occurrence = *next
+	}
+
+	actor := entities.Actor{Name: SchedulerActor, Role: entities.WorkspaceRoleMember}
+	if recurrence.CreatedBy != nil {
+		// The creator of the recurrence owns its tasks.
+		actor.UserId = *recurrence.CreatedBy
+	}
+	task := newTask(recurrence, occurrence)
+	err = u.tasks.CreateTask(helpers.ContextWithWorkspaceID(ctx, recurrence.WorkspaceId), actor, task)
+	// Another scheduler may already have created the task of the occurrence; any other error,
+	// conflicts included, keeps the recurrence until its lease ends.
+	if err != nil && !errors.Is(err, entities.ErrOccurrenceExists) {
+		return err
+	}
+	recurrence.LastOccurrenceAt = &occurrence
+	recurrence.NextOccurrenceAt = nextOccurrence(s, now, recurrence.EndsAt)
+	return u.recurrenceRepo.Advance(ctx, recurrence, claimed)
+}
+
+// newTask returns the task of recurrence at occurrence.
+func newTask(recurrence *entities.Recurrence, occurrence time.Time) *entities.Task {
+	task := &entities.Task{
+		Title:        recurrence.Title,
+		Description:  recurrence.Description,
+		AssigneeId:   recurrence.AssigneeId,
+		RecurrenceId: &recurrence.Id,
+		OccurrenceAt: &occurrence,
+		TaskSchedule: entities.TaskSchedule{
+			Priority:        recurrence.Priority,
+			EstimateMinutes: recurrence.EstimateMinutes,
+		},
+	}
+	if recurrence.DueAfterMinutes != nil {
+		dueAt := occurrence.Add(time.Duration(*recurrence.DueAfterMinutes) * time.Minute)
+		task.DueAt = &dueAt
+	}
+	return task
+}
@@ -0,0 +1,118 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+func TestMaterializeDue(t *testing.T) {
+	ctx := context.Background()
+	creator := "alice"
+	dueAfter := 60
+	// Daily at 09:00 in Bangkok, due since Saturday: the scheduler did not run for the weekend.
+	saturday := time.Date(2026, 2, 28, 2, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC)
+	tuesday := time.Date(2026, 3, 3, 2, 0, 0, 0, time.UTC)
+	claimed := func() entities.Recurrence {
+		return entities.Recurrence{
+			Id: 3, WorkspaceId: 5, Title: "Standup notes", Description: "Write the notes", Priority: entities.TaskPriorityHigh,
+			DueAfterMinutes: &dueAfter, Schedule: "0 9 * * *", Timezone: "Asia/Bangkok",
+			StartsAt: saturday.AddDate(0, 0, -7), NextOccurrenceAt: &saturday, CreatedBy: &creator,
+		}
+	}
+
+	t.Run("CreatesTheLatestOccurrence", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, mockTasks := newUsecase(ctrl)
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 20).Return([]entities.Recurrence{claimed()}, nil)
+		mockTasks.EXPECT().CreateTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, actor entities.Actor, task *entities.Task) error {
+				workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+				assert.Equal(t, uint(5), workspaceID)
+				assert.Equal(t, entities.Actor{Name: usecases.SchedulerActor, UserId: "alice", Role: entities.WorkspaceRoleMember}, actor)
+				assert.Equal(t, "Standup notes", task.Title)
+				assert.Equal(t, entities.TaskPriorityHigh, task.Priority)
+				assert.Equal(t, uint(3), *task.RecurrenceId)
+				assert.Equal(t, monday, *task.OccurrenceAt)
+				assert.Equal(t, monday.Add(time.Hour), *task.DueAt)
+				return nil
+			})
+		mockRepo.EXPECT().Advance(ctx, gomock.Any(), saturday).DoAndReturn(
+			func(_ context.Context, recurrence *entities.Recurrence, _ time.Time) error {
+				assert.Equal(t, monday, *recurrence.LastOccurrenceAt)
+				assert.Equal(t, tuesday, *recurrence.NextOccurrenceAt)
+				return nil
+			})
+
+		handled, err := usecase.MaterializeDue(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, handled)
+	})
+
+	t.Run("AlreadyCreated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, mockTasks := newUsecase(ctrl)
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 20).Return([]entities.Recurrence{claimed()}, nil)
+		mockTasks.EXPECT().CreateTask(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(entities.ErrOccurrenceExists)
+		mockRepo.EXPECT().Advance(ctx, gomock.Any(), saturday).Return(nil)
+
+		_, err := usecase.MaterializeDue(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("OtherConflictKeepsTheLease", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, mockTasks := newUsecase(ctrl)
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 20).Return([]entities.Recurrence{claimed()}, nil)
+		// Only the conflict of the occurrence index means the task exists; other conflicts do not advance
+		mockTasks.EXPECT().CreateTask(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(domainerrors.Conflict("the task is a duplicate"))
+
+		handled, err := usecase.MaterializeDue(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, handled)
+	})
+
+	t.Run("FailureKeepsTheLease", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, mockTasks := newUsecase(ctrl)
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 20).Return([]entities.Recurrence{claimed()}, nil)
+		mockTasks.EXPECT().CreateTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(domainerrors.Internal(errors.New("boom")))
+
+		handled, err := usecase.MaterializeDue(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, handled)
+	})
+
+	t.Run("LastOccurrence", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, mockTasks := newUsecase(ctrl)
+		recurrence := claimed()
+		recurrence.EndsAt = &monday
+		mockRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 20).Return([]entities.Recurrence{recurrence}, nil)
+		mockTasks.EXPECT().CreateTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockRepo.EXPECT().Advance(ctx, gomock.Any(), saturday).DoAndReturn(
+			func(_ context.Context, recurrence *entities.Recurrence, _ time.Time) error {
+				assert.Nil(t, recurrence.NextOccurrenceAt)
+				return nil
+			})
+
+		_, err := usecase.MaterializeDue(ctx)
+
+		assert.NoError(t, err)
+	})
+}
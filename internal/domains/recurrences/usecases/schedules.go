@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	"github.com/supachai1998/task_services/internal/domains/recurrences/schedule"
+)
+
+// parseSchedule parses a schedule starting at startsAt in the IANA time zone timezone.
+func parseSchedule(rule, timezone string, startsAt time.Time) (schedule.Schedule, *time.Location, error) {
+	// LoadLocation also accepts "" and "Local", which depend on the server.
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" || timezone == "Local" {
+		return nil, nil, domainerrors.Validation("timezone must be an IANA time zone").WithDetail("timezone", timezone)
+	}
+	s, err := schedule.Parse(rule, startsAt, loc)
+	if err != nil {
+		return nil, nil, domainerrors.Validation("invalid schedule: "+err.Error()).WithDetail("schedule", rule)
+	}
+	return s, loc, nil
+}
+
+// checkEnd fails when a schedule ends before it starts.
+func checkEnd(startsAt time.Time, endsAt *time.Time) error {
+	if endsAt != nil && endsAt.Before(startsAt) {
+		return domainerrors.Validation("ends_at must not be before starts_at")
+	}
+	return nil
+}
+
+// nextOccurrence returns the first occurrence of s after after, in UTC, or nil when there is none
+// up to endsAt.
+func nextOccurrence(s schedule.Schedule, after time.Time, endsAt *time.Time) *time.Time {
+	next, ok := s.Next(after)
+	if !ok || (endsAt != nil && next.After(*endsAt)) {
+		return nil
+	}
+	next = next.UTC()
+	return &next
+}
+
+// occurrences returns the next count occurrences of s after after up to endsAt, in loc.
+func occurrences(s schedule.Schedule, loc *time.Location, after time.Time, endsAt *time.Time, count int) *models.Occurrences {
+	if count <= 0 {
+		count = models.DefaultOccurrenceCount
+	}
+	result := &models.Occurrences{Timezone: loc.String(), Occurrences: []time.Time{}}
+	for len(result.Occurrences) < count {
+		next := nextOccurrence(s, after, endsAt)
+		if next == nil {
+			break
+		}
+		result.Occurrences = append(result.Occurrences, next.In(loc))
+		after = *next
+	}
+	return result
+}
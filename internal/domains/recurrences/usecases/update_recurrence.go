@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// UpdateRecurrence keeps the start of the recurrence when the update has none.
+func (u *usecase) UpdateRecurrence(ctx context.Context, actor entities.Actor, recurrence *entities.Recurrence) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "change recurrences"); err != nil {
+		return err
+	}
+	current, err := u.recurrenceRepo.GetByID(ctx, recurrence.Id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeCreator(actor, current); err != nil {
+		return err
+	}
+	if recurrence.StartsAt.IsZero() {
+		recurrence.StartsAt = current.StartsAt
+	}
+	if err := u.prepare(actor, recurrence); err != nil {
+		return err
+	}
+	if err := u.recurrenceRepo.Update(ctx, recurrence); err != nil {
+		return err
+	}
+	updated, err := u.recurrenceRepo.GetByID(ctx, recurrence.Id)
+	if err != nil {
+		return err
+	}
+	*recurrence = *updated
+	return nil
+}
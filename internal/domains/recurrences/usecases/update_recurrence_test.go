@@ -0,0 +1,58 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestUpdateRecurrence(t *testing.T) {
+	ctx := context.Background()
+	creator := "alice"
+	startsAt := time.Date(2026, 1, 5, 2, 0, 0, 0, time.UTC)
+	current := &entities.Recurrence{Id: 3, Title: "Weekly report", Schedule: "@weekly", Timezone: "UTC", StartsAt: startsAt, CreatedBy: &creator}
+
+	t.Run("KeepsTheStart", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, _ := newUsecase(ctrl)
+		alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+		mockRepo.EXPECT().GetByID(ctx, uint(3)).Return(current, nil)
+		mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, recurrence *entities.Recurrence) error {
+			assert.Equal(t, startsAt, recurrence.StartsAt)
+			// Every other Monday from January 5th, at 09:00 in Bangkok.
+			assert.Equal(t, time.Date(2026, 3, 16, 2, 0, 0, 0, time.UTC), *recurrence.NextOccurrenceAt)
+			return nil
+		})
+		mockRepo.EXPECT().GetByID(ctx, uint(3)).Return(current, nil)
+
+		err := usecase.UpdateRecurrence(ctx, alice, &entities.Recurrence{
+			Id: 3, Title: "Report", Schedule: "FREQ=WEEKLY;INTERVAL=2", Timezone: "Asia/Bangkok",
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotCreator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, _ := newUsecase(ctrl)
+		bob := entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleMember}
+		mockRepo.EXPECT().GetByID(ctx, uint(3)).Return(current, nil)
+
+		err := usecase.UpdateRecurrence(ctx, bob, &entities.Recurrence{Id: 3, Title: "Report", Schedule: "@daily", Timezone: "UTC"})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+
+	t.Run("AdminDeletes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		usecase, mockRepo, _ := newUsecase(ctrl)
+		admin := entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleAdmin}
+		mockRepo.EXPECT().GetByID(ctx, uint(3)).Return(current, nil)
+		mockRepo.EXPECT().Delete(ctx, uint(3)).Return(nil)
+
+		assert.NoError(t, usecase.DeleteRecurrence(ctx, admin, 3))
+	})
+}
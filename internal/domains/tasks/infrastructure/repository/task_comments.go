@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// commentCountRow is the number of comments of a task.
+type commentCountRow struct {
+	TaskId uint
+	Count  int
+}
+
+// CountComments counts the comments of each task of ids with a single query, leaving out deleted comments.
+func (r *repository) CountComments(ctx context.Context, ids []uint) (map[uint]int, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	counts := map[uint]int{}
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	var rows []commentCountRow
+	err := db.Model(&entities.Comment{}).
+		Select("task_id, COUNT(*) AS count").
+		Where("task_id IN ?", ids).
+		Group("task_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	for _, row := range rows {
+		counts[row.TaskId] = row.Count
+	}
+	return counts, nil
+}
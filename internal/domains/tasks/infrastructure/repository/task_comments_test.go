@@ -0,0 +1,23 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepository_CountComments(t *testing.T) {
+	repo, mock := newRepository(t)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT task_id, COUNT(*) AS count FROM "comments" WHERE task_id IN ($1,$2) AND workspace_id = $3 AND "comments"."deleted_at" IS NULL GROUP BY "task_id"`)).
+		WithArgs(15, 20, workspaceA).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "count"}).AddRow(15, 3))
+
+	counts, err := repo.CountComments(inWorkspace(workspaceA), []uint{15, 20})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[uint]int{15: 3}, counts)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
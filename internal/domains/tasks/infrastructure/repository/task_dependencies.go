@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// dependencyPrimaryKey is the primary key constraint of task_dependencies.
+const dependencyPrimaryKey = "task_dependencies_pkey"
+
+// upstreamBlockersSQL walks up the blockers of a task in its workspace; UNION stops at a row already
+// seen, so the walk ends even if the rows form a cycle.
+const upstreamBlockersSQL = `WITH RECURSIVE upstream AS (
+	SELECT blocker_id FROM task_dependencies WHERE blocked_id = @id AND workspace_id = @workspace
+	UNION
+	SELECT task_dependencies.blocker_id FROM task_dependencies JOIN upstream ON task_dependencies.blocked_id = upstream.blocker_id
+	WHERE task_dependencies.workspace_id = @workspace
+) SELECT blocker_id FROM upstream`
+
+var (
+	errDependencyNotFound = domainerrors.NotFound("dependency not found")
+	errDependencyExists   = domainerrors.Conflict("the dependency already exists")
+)
+
+func (r *repository) CreateDependency(ctx context.Context, dependency *entities.TaskDependency) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	dependency.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(dependency).Error)
+}
+
+func (r *repository) DeleteDependency(ctx context.Context, blockerID, blockedID uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Delete(&entities.TaskDependency{})
+	if result.Error == nil && result.RowsAffected == 0 {
+		return errDependencyNotFound
+	}
+	return wrapError(result.Error)
+}
+
+// ListDependencies returns the dependencies a task is the blocker or the blocked task of.
+func (r *repository) ListDependencies(ctx context.Context, taskID uint) ([]entities.TaskDependency, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var dependencies []entities.TaskDependency
+	err := db.Where("(blocked_id = ? OR blocker_id = ?)", taskID, taskID).
+		Order("blocker_id, blocked_id").
+		Find(&dependencies).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return dependencies, nil
+}
+
+// LockDependencies takes the advisory lock of the dependencies of the workspace of ctx,
+// released when the transaction of the repository ends.
+func (r *repository) LockDependencies(ctx context.Context) error {
+	return r.advisoryLock(ctx, "task_dependencies")
+}
+
+// ListUpstreamBlockerIDs returns the blockers of a task and, recursively, their blockers.
+// The query is raw SQL, so it names the workspace itself instead of relying on the workspace scope.
+func (r *repository) ListUpstreamBlockerIDs(ctx context.Context, id uint) ([]uint, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+	var ids []uint
+	err := db.Raw(upstreamBlockersSQL, map[string]interface{}{"id": id, "workspace": workspaceID}).Scan(&ids).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return ids, nil
+}
+
+// ListOpenBlockers returns the blockers of the tasks of ids that are neither done nor soft-deleted.
+func (r *repository) ListOpenBlockers(ctx context.Context, ids []uint) (map[uint][]uint, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	blockers := map[uint][]uint{}
+	if len(ids) == 0 {
+		return blockers, nil
+	}
+	openTasks := db.Model(&entities.Task{}).Select("id").Where("status_category <> ?", entities.StatusCategoryDone)
+	var dependencies []entities.TaskDependency
+	err := db.Select("blocker_id, blocked_id").
+		Where("blocked_id IN ?", ids).
+		Where("blocker_id IN (?)", openTasks).
+		Order("blocked_id, blocker_id").
+		Find(&dependencies).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	for _, dependency := range dependencies {
+		blockers[dependency.BlockedId] = append(blockers[dependency.BlockedId], dependency.BlockerId)
+	}
+	return blockers, nil
+}
@@ -0,0 +1,66 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestRepository_Dependencies(t *testing.T) {
+	t.Run("ListUpstreamBlockerIDsIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE upstream AS (
+	SELECT blocker_id FROM task_dependencies WHERE blocked_id = $1 AND workspace_id = $2`)).
+			WithArgs(12, workspaceA, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"blocker_id"}).AddRow(3).AddRow(1))
+
+		ids, err := repo.ListUpstreamBlockerIDs(inWorkspace(workspaceA), 12)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, []uint{3, 1}, ids)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListOpenBlockers", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT blocker_id, blocked_id FROM "task_dependencies" WHERE blocked_id IN ($1,$2) AND blocker_id IN (SELECT "id" FROM "tasks" WHERE status_category <> $3 AND workspace_id = $4 AND "tasks"."deleted_at" IS NULL) AND workspace_id = $5 ORDER BY blocked_id, blocker_id`)).
+			WithArgs(15, 20, entities.StatusCategoryDone, workspaceA, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"blocker_id", "blocked_id"}).AddRow(3, 15).AddRow(12, 15))
+
+		blockers, err := repo.ListOpenBlockers(inWorkspace(workspaceA), []uint{15, 20})
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[uint][]uint{15: {3, 12}}, blockers)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "task_dependencies"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "task_dependencies_pkey"})
+
+		err := repo.CreateDependency(inWorkspace(workspaceA), &entities.TaskDependency{BlockerId: 12, BlockedId: 15})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteMissing", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "task_dependencies" WHERE (blocker_id = $1 AND blocked_id = $2) AND workspace_id = $3`)).
+			WithArgs(12, 15, workspaceB).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.DeleteDependency(inWorkspace(workspaceB), 12, 15)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
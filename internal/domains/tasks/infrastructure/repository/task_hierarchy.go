@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// ancestorsSQL walks up the parents of a task in its workspace; UNION stops at a row already seen,
+// so the walk ends even if the rows form a cycle.
+const ancestorsSQL = `WITH RECURSIVE ancestors AS (
+	SELECT id, parent_id FROM tasks WHERE id = @id AND workspace_id = @workspace
+	UNION
+	SELECT tasks.id, tasks.parent_id FROM tasks JOIN ancestors ON tasks.id = ancestors.parent_id
+	WHERE tasks.workspace_id = @workspace
+) SELECT id FROM ancestors`
+
+// progressRow is the count of the subtasks of a parent and of those that are done.
+type progressRow struct {
+	ParentId uint
+	Total    int
+	Done     int
+}
+
+// UpdateParent makes the task a subtask of parentID, or a top-level task when it is nil, like Update.
+func (r *repository) UpdateParent(ctx context.Context, task *entities.TaskUpdate, parentID *uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	return update(db, task, db.NowFunc(), map[string]interface{}{"parent_id": parentID})
+}
+
+// LockHierarchy takes the advisory lock of the task hierarchy of the workspace of ctx,
+// released when the transaction of the repository ends.
+func (r *repository) LockHierarchy(ctx context.Context) error {
+	return r.advisoryLock(ctx, "tasks.parent_id")
+}
+
+// ListAncestorIDs returns id followed by the IDs of its ancestors, soft-deleted or not.
+// The query is raw SQL, so it names the workspace itself instead of relying on the workspace scope.
+func (r *repository) ListAncestorIDs(ctx context.Context, id uint) ([]uint, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+	var ids []uint
+	err := db.Raw(ancestorsSQL, map[string]interface{}{"id": id, "workspace": workspaceID}).Scan(&ids).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return ids, nil
+}
+
+// ListDescendants reads the subtasks of a task one level per query, down to depth levels,
+// and stops once it has read more than limit subtasks.
+func (r *repository) ListDescendants(ctx context.Context, id uint, depth int, limit int) ([]entities.Task, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var descendants []entities.Task
+	parents := []uint{id}
+	for level := 0; level < depth && len(parents) > 0 && len(descendants) <= limit; level++ {
+		var children []entities.Task
+		err := db.Where("parent_id IN ?", parents).
+			Order("id").
+			Limit(limit + 1 - len(descendants)).
+			Find(&children).Error
+		if err != nil {
+			return nil, wrapError(err)
+		}
+		descendants = append(descendants, children...)
+		parents = lo.Map(children, func(task entities.Task, _ int) uint {
+			return task.Id
+		})
+	}
+	return descendants, nil
+}
+
+// advisoryLock takes the transaction-level advisory lock named name of the workspace of ctx.
+func (r *repository) advisoryLock(ctx context.Context, name string) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Exec(`SELECT pg_advisory_xact_lock(hashtext(?), ?)`, name, workspaceID).Error)
+}
+
+// ChildProgress counts the subtasks of each task of ids, leaving out soft-deleted subtasks.
+func (r *repository) ChildProgress(ctx context.Context, ids []uint) (map[uint]entities.TaskProgress, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	progress := map[uint]entities.TaskProgress{}
+	if len(ids) == 0 {
+		return progress, nil
+	}
+	var rows []progressRow
+	err := db.Model(&entities.Task{}).
+		Select("parent_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE status_category = ?) AS done", entities.StatusCategoryDone).
+		Where("parent_id IN ?", ids).
+		Group("parent_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	for _, row := range rows {
+		progress[row.ParentId] = entities.NewTaskProgress(row.Total, row.Done)
+	}
+	return progress, nil
+}
@@ -0,0 +1,81 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestRepository_Hierarchy(t *testing.T) {
+	t.Run("ListAncestorIDsIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`WITH RECURSIVE ancestors AS (
+	SELECT id, parent_id FROM tasks WHERE id = $1 AND workspace_id = $2`)).
+			WithArgs(5, workspaceA, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5).AddRow(3).AddRow(1))
+
+		ids, err := repo.ListAncestorIDs(inWorkspace(workspaceA), 5)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, []uint{5, 3, 1}, ids)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("LockHierarchyOfWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock(hashtext($1), $2)`)).
+			WithArgs("tasks.parent_id", workspaceB).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.LockHierarchy(inWorkspace(workspaceB)))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListDescendantsLevelByLevel", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE parent_id IN ($1) AND workspace_id = $2 AND "tasks"."deleted_at" IS NULL ORDER BY id LIMIT 11`)).
+			WithArgs(1, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "parent_id"}).AddRow(2, 1).AddRow(3, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE parent_id IN ($1,$2) AND workspace_id = $3 AND "tasks"."deleted_at" IS NULL ORDER BY id LIMIT 9`)).
+			WithArgs(2, 3, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "parent_id"}).AddRow(4, 2))
+
+		// The third level is not read with a depth of 2.
+		tasks, err := repo.ListDescendants(inWorkspace(workspaceA), 1, 2, 10)
+
+		if assert.NoError(t, err) && assert.Len(t, tasks, 3) {
+			assert.Equal(t, uint(2), *tasks[2].ParentId)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListDescendantsStopsAfterLimit", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`WHERE parent_id IN ($1) AND workspace_id = $2 AND "tasks"."deleted_at" IS NULL ORDER BY id LIMIT 3`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "parent_id"}).AddRow(2, 1).AddRow(3, 1).AddRow(4, 1))
+
+		tasks, err := repo.ListDescendants(inWorkspace(workspaceA), 1, 5, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, tasks, 3)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ChildProgress", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT parent_id, COUNT(*) AS total, COUNT(*) FILTER (WHERE status_category = $1) AS done FROM "tasks" WHERE parent_id IN ($2,$3) AND workspace_id = $4 AND "tasks"."deleted_at" IS NULL GROUP BY "parent_id"`)).
+			WithArgs(entities.StatusCategoryDone, 1, 2, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"parent_id", "total", "done"}).AddRow(1, 3, 1))
+
+		progress, err := repo.ChildProgress(inWorkspace(workspaceA), []uint{1, 2})
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[uint]entities.TaskProgress{1: {Total: 3, Done: 1, Percent: 33}}, progress)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgconn"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+const (
+	// taskLabelPrimaryKey is the primary key constraint of task_labels.
+	taskLabelPrimaryKey = "task_labels_pkey"
+	// taskLabelForeignKey references the label of a task_labels row in the workspace of the row.
+	taskLabelForeignKey = "fk_task_labels_label"
+)
+
+// taskLabelsSQL reads the labels of the tasks of ids, by name. The query is raw SQL, so it names
+// the workspace itself instead of relying on the workspace scope.
+const taskLabelsSQL = `SELECT task_labels.task_id, labels.* FROM task_labels
+	JOIN labels ON labels.workspace_id = task_labels.workspace_id AND labels.id = task_labels.label_id
+	WHERE task_labels.workspace_id = @workspace AND task_labels.task_id IN @ids
+	ORDER BY labels.name, labels.id`
+
+var (
+	errTaskLabelNotFound = domainerrors.NotFound("the task does not have this label")
+	errTaskLabelExists   = domainerrors.Conflict("the task already has this label")
+	errLabelNotFound     = domainerrors.Validation("label not found")
+)
+
+// taskLabelRow is a label of the task TaskId.
+type taskLabelRow struct {
+	TaskId uint
+	entities.Label
+}
+
+// CreateTaskLabel puts a label on a task; the label must be a label of the workspace of ctx.
+func (r *repository) CreateTaskLabel(ctx context.Context, taskLabel *entities.TaskLabel) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	taskLabel.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	err := db.Create(taskLabel).Error
+	if isForeignKeyViolation(err, taskLabelForeignKey) {
+		return errLabelNotFound
+	}
+	return wrapError(err)
+}
+
+func (r *repository) DeleteTaskLabel(ctx context.Context, taskID, labelID uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Where("task_id = ? AND label_id = ?", taskID, labelID).Delete(&entities.TaskLabel{})
+	if result.Error == nil && result.RowsAffected == 0 {
+		return errTaskLabelNotFound
+	}
+	return wrapError(result.Error)
+}
+
+// ListLabels reads the labels of every task of ids with a single query.
+func (r *repository) ListLabels(ctx context.Context, ids []uint) (map[uint][]entities.Label, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	labels := map[uint][]entities.Label{}
+	if len(ids) == 0 {
+		return labels, nil
+	}
+	workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+	var rows []taskLabelRow
+	err := db.Raw(taskLabelsSQL, map[string]interface{}{"ids": ids, "workspace": workspaceID}).Scan(&rows).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	for _, row := range rows {
+		labels[row.TaskId] = append(labels[row.TaskId], row.Label)
+	}
+	return labels, nil
+}
+
+// isForeignKeyViolation reports whether a row referenced a missing row through constraint.
+func isForeignKeyViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == sqlStateForeignKeyViolation && pgErr.ConstraintName == constraint
+}
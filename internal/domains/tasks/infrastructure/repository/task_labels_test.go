@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func TestRepository_Labels(t *testing.T) {
+	t.Run("CreateUsesContextWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "task_labels" ("workspace_id","task_id","label_id","created_at") VALUES ($1,$2,$3,$4)`)).
+			WithArgs(workspaceB, 15, 7, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		taskLabel := &entities.TaskLabel{TaskId: 15, LabelId: 7}
+		if assert.NoError(t, repo.CreateTaskLabel(inWorkspace(workspaceB), taskLabel)) {
+			assert.Equal(t, uint(workspaceB), taskLabel.WorkspaceId)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateWithLabelOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "task_labels"`)).
+			WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_task_labels_label"})
+
+		err := repo.CreateTaskLabel(inWorkspace(workspaceA), &entities.TaskLabel{TaskId: 15, LabelId: 7})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "task_labels"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "task_labels_pkey"})
+
+		err := repo.CreateTaskLabel(inWorkspace(workspaceA), &entities.TaskLabel{TaskId: 15, LabelId: 7})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteMissing", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "task_labels" WHERE (task_id = $1 AND label_id = $2) AND workspace_id = $3`)).
+			WithArgs(15, 7, workspaceB).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.DeleteTaskLabel(inWorkspace(workspaceB), 15, 7)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListLabelsInOneQuery", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT task_labels.task_id, labels.* FROM task_labels
+	JOIN labels ON labels.workspace_id = task_labels.workspace_id AND labels.id = task_labels.label_id
+	WHERE task_labels.workspace_id = $1 AND task_labels.task_id IN ($2,$3)`)).
+			WithArgs(workspaceA, 15, 20).
+			WillReturnRows(sqlmock.NewRows([]string{"task_id", "id", "workspace_id", "name", "color"}).
+				AddRow(15, 7, workspaceA, "bug", "#d73a4a").
+				AddRow(15, 8, workspaceA, "ui", "#0075ca").
+				AddRow(20, 7, workspaceA, "bug", "#d73a4a"))
+
+		labels, err := repo.ListLabels(inWorkspace(workspaceA), []uint{15, 20})
+
+		if assert.NoError(t, err) && assert.Len(t, labels[15], 2) && assert.Len(t, labels[20], 1) {
+			assert.Equal(t, "ui", labels[15][1].Name)
+			assert.Equal(t, uint(7), labels[20][0].Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListAnyLabel", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE id IN (SELECT "task_id" FROM "task_labels" WHERE label_id IN (SELECT "id" FROM "labels" WHERE LOWER(name) IN ($1,$2) AND workspace_id = $3) AND workspace_id = $4) AND workspace_id = $5 AND "tasks"."deleted_at" IS NULL ORDER BY "id" LIMIT 21`)).
+			WithArgs("bug", "ui", workspaceA, workspaceA, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(15))
+
+		_, _, err := repo.List(inWorkspace(workspaceA), &models.ListTasksQuery{Label: []string{"bug", "ui"}, Sort: "id", Limit: 20})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListAllLabels", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE id IN (SELECT "task_id" FROM "task_labels" WHERE label_id IN (SELECT "id" FROM "labels" WHERE LOWER(name) IN ($1,$2) AND workspace_id = $3) AND workspace_id = $4 GROUP BY "task_id" HAVING COUNT(*) = $5) AND workspace_id = $6`)).
+			WithArgs("bug", "ui", workspaceA, workspaceA, 2, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, _, err := repo.List(inWorkspace(workspaceA), &models.ListTasksQuery{
+			Label: []string{"bug", "ui"}, LabelMatch: models.LabelMatchAll, Sort: "id", Limit: 20,
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,41 +1,512 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
 	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
 
+	"github.com/jackc/pgconn"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+// sortColumns maps the sort fields of the list query to their columns.
+var sortColumns = map[string]clause.Column{
+	"id":    {Name: "id"},
+	"title": {Name: "title"},
+	// Statuses are workflow-defined names and sort alphabetically.
+	"status":     {Name: "status"},
+	"priority":   {Name: "priority"},
+	"created_at": {Name: "created_at"},
+	"updated_at": {Name: "updated_at"},
+	// Tasks without a due date sort after the others; the expression matches idx_tasks_due_at.
+	"due_at": {Name: "COALESCE(due_at, 'infinity')", Raw: true},
+}
+
+// noDueDate is the cursor value of a task without a due date, sorted as due at infinity.
+const noDueDate = "infinity"
+
+const (
+	// sqlStateQueryCanceled is the SQLSTATE of a query canceled by a cancel request or statement_timeout.
+	sqlStateQueryCanceled = "57014"
+	// sqlStateForeignKeyViolation is the SQLSTATE of a row referencing a missing row.
+	sqlStateForeignKeyViolation = "23503"
+	// sqlStateUniqueViolation is the SQLSTATE of a row duplicating a unique key.
+	sqlStateUniqueViolation = "23505"
+	// occurrenceIndex keeps a recurrence from creating two tasks for an occurrence.
+	occurrenceIndex = "idx_tasks_recurrence_occurrence"
+)
+
+var (
+	errTaskNotFound      = domainerrors.NotFound("task not found")
+	errWorkspaceNotFound = domainerrors.NotFound("workspace not found")
+	errVersionMismatch   = domainerrors.PreconditionFailed("task has been modified since it was read")
+	errNoWorkspace       = domainerrors.Internal(errors.New("repository: the context has no workspace"))
+)
+
+// workspaceForeignKeys are the constraints of the workspace_id columns.
+var workspaceForeignKeys = map[string]bool{
+	"fk_tasks_workspace":             true,
+	"fk_task_events_workspace":       true,
+	"fk_task_dependencies_workspace": true,
+}
+
+// Options tunes the task repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
 type repository struct {
-	db *gorm.DB
+	db      *gorm.DB
+	options Options
+}
+
+func NewTaskRepository(db *gorm.DB, options Options) interfaces.TaskRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) Create(ctx context.Context, task *entities.Task) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	task.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(task).Error)
 }
 
-func NewTaskRepository(db *gorm.DB) interfaces.TaskRepository {
-	return &repository{db}
+func (r *repository) CreateBatch(ctx context.Context, tasks []*entities.Task) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	if len(tasks) == 0 {
+		return nil
+	}
+	workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+	for _, task := range tasks {
+		task.WorkspaceId = workspaceID
+	}
+	return wrapError(db.Create(&tasks).Error)
 }
 
-func (r *repository) Create(task *entities.Task) error {
-	return r.db.Create(task).Error
+// Update applies the non-nil fields of task, bumps the task version and sets updated_at,
+// and completed_at when the task moves to a done status.
+// When ExpectedVersions is set the row is only updated if its version is one of them.
+func (r *repository) Update(ctx context.Context, task *entities.TaskUpdate) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	now := db.NowFunc()
+	values := map[string]interface{}{}
+	if task.Title != nil {
+		values["title"] = *task.Title
+	}
+	if task.Description != nil {
+		values["description"] = *task.Description
+	}
+	if task.Status != nil {
+		values["status"] = *task.Status
+		values["status_category"] = task.StatusCategory
+		if task.StatusCategory == entities.StatusCategoryDone {
+			values["completed_at"] = now
+		} else {
+			values["completed_at"] = nil
+		}
+	}
+	if task.TaskSchedule != nil {
+		var dueAt *time.Time
+		if task.DueAt != nil {
+			dueAt = lo.ToPtr(task.DueAt.UTC())
+		}
+		values["due_at"] = dueAt
+		values["priority"] = task.Priority
+		values["estimate_minutes"] = task.EstimateMinutes
+	}
+	return update(db, task, now, values)
 }
 
-func (r *repository) Update(task *entities.TaskUpdate) error {
-	return r.db.Clauses(clause.Returning{}).Where("id = ?", task.Id).Updates(task).Error
+// UpdateAssignee assigns the task to assigneeID, or to nobody when it is nil, like Update.
+func (r *repository) UpdateAssignee(ctx context.Context, task *entities.TaskUpdate, assigneeID *string) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	return update(db, task, db.NowFunc(), map[string]interface{}{"assignee_id": assigneeID})
 }
 
-func (r *repository) GetByID(id uint) (*entities.Task, error) {
+func (r *repository) GetByID(ctx context.Context, id uint) (*entities.Task, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
 	var task entities.Task
-	err := r.db.First(&task, id).Error
-	return &task, err
+	if err := db.First(&task, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &task, nil
 }
 
-func (r *repository) DeleteByID(id uint) error {
-	return r.db.Delete(&entities.Task{}, id).Error
+// GetByIDUnscoped reads a task, soft-deleted or not.
+func (r *repository) GetByIDUnscoped(ctx context.Context, id uint) (*entities.Task, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var task entities.Task
+	if err := db.Unscoped().First(&task, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &task, nil
 }
 
-func (r *repository) List() ([]entities.Task, error) {
+// GetByIDForUpdate reads a task and locks its row until the end of the transaction.
+func (r *repository) GetByIDForUpdate(ctx context.Context, id uint) (*entities.Task, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var task entities.Task
+	if err := db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&task, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &task, nil
+}
+
+// GetByIDUnscopedForUpdate reads a task, soft-deleted or not, and locks its row until the end of the transaction.
+func (r *repository) GetByIDUnscopedForUpdate(ctx context.Context, id uint) (*entities.Task, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var task entities.Task
+	if err := db.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).First(&task, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &task, nil
+}
+
+func (r *repository) DeleteByID(ctx context.Context, id uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Delete(&entities.Task{}, id)
+	if result.Error == nil && result.RowsAffected == 0 {
+		return errTaskNotFound
+	}
+	return wrapError(result.Error)
+}
+
+// Restore clears the deleted_at of a soft-deleted task and bumps its version.
+func (r *repository) Restore(ctx context.Context, task *entities.Task) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Unscoped().Model(task).Clauses(clause.Returning{}).
+		Where("deleted_at IS NOT NULL").
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+			"updated_at": db.NowFunc(),
+		})
+	if result.Error == nil && result.RowsAffected == 0 {
+		return errTaskNotFound
+	}
+	return wrapError(result.Error)
+}
+
+// List returns one page of tasks matching the query and the cursor of the next page,
+// which is empty when there are no more rows.
+func (r *repository) List(ctx context.Context, query *models.ListTasksQuery) ([]entities.Task, string, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	column, ok := sortColumns[query.Sort]
+	if !ok {
+		return nil, "", domainerrors.Validation(fmt.Sprintf("unknown sort field %q", query.Sort))
+	}
+	desc := query.Order == models.SortOrderDesc
+
+	tx := db.Model(&entities.Task{})
+	if query.IncludeDeleted {
+		tx = tx.Unscoped()
+	}
+	if len(query.Status) > 0 {
+		tx = tx.Where("status IN ?", query.Status)
+	}
+	if query.Search != "" {
+		pattern := "%" + escapeLike(query.Search) + "%"
+		tx = tx.Where("(title ILIKE ? OR description ILIKE ?)", pattern, pattern)
+	}
+	if query.Owner != "" {
+		tx = tx.Where("created_by = ?", query.Owner)
+	}
+	if query.Assignee != "" {
+		tx = tx.Where("assignee_id = ?", query.Assignee)
+	}
+	if query.VisibleTo != "" {
+		tx = tx.Where("(created_by = ? OR assignee_id = ?)", query.VisibleTo, query.VisibleTo)
+	}
+	if query.ParentId != nil {
+		tx = tx.Where("parent_id = ?", *query.ParentId)
+	}
+	if query.CreatedAfter != nil {
+		tx = tx.Where("created_at > ?", query.CreatedAfter.UTC())
+	}
+	if query.UpdatedBefore != nil {
+		tx = tx.Where("updated_at < ?", query.UpdatedBefore.UTC())
+	}
+	if query.DueBefore != nil {
+		tx = tx.Where("due_at < ? AND status_category <> ?", query.DueBefore.UTC(), entities.StatusCategoryDone)
+	}
+	if len(query.Label) > 0 {
+		tx = tx.Where("id IN (?)", labeled(db, query.Label, query.LabelMatch == models.LabelMatchAll))
+	}
+	if query.Cursor != "" {
+		value, id, err := helpers.DecodeCursor(query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		op := ">"
+		if desc {
+			op = "<"
+		}
+		if column.Name == "id" {
+			tx = tx.Where("id "+op+" ?", id)
+		} else {
+			tx = tx.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column.Name, op), value, id)
+		}
+	}
+	tx = tx.Order(clause.OrderByColumn{Column: column, Desc: desc})
+	if column.Name != "id" {
+		tx = tx.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: desc})
+	}
+
+	// Fetch one extra row to know whether there is a next page.
 	var tasks []entities.Task
-	err := r.db.Find(&tasks).Error
-	return tasks, err
+	if err := tx.Limit(query.Limit + 1).Find(&tasks).Error; err != nil {
+		return nil, "", wrapError(err)
+	}
+	if len(tasks) <= query.Limit {
+		return tasks, "", nil
+	}
+	tasks = tasks[:query.Limit]
+	last := tasks[len(tasks)-1]
+	return tasks, helpers.EncodeCursor(sortValue(&last, query.Sort), last.Id), nil
+}
+
+// labeled selects the IDs of the tasks with any of the labels named names, or with all of them when all is true.
+// names must be lowercase and distinct.
+func labeled(db *gorm.DB, names []string, all bool) *gorm.DB {
+	labelIDs := db.Model(&entities.Label{}).Select("id").Where("LOWER(name) IN ?", names)
+	taskIDs := db.Model(&entities.TaskLabel{}).Select("task_id").Where("label_id IN (?)", labelIDs)
+	if all {
+		taskIDs = taskIDs.Group("task_id").Having("COUNT(*) = ?", len(names))
+	}
+	return taskIDs
+}
+
+func (r *repository) CreateEvent(ctx context.Context, event *entities.TaskEvent) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	event.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	if err := db.Create(event).Error; err != nil {
+		return wrapError(err)
+	}
+	return createOutboxMessages(db, []*entities.TaskEvent{event})
+}
+
+func (r *repository) CreateEvents(ctx context.Context, events []*entities.TaskEvent) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	if len(events) == 0 {
+		return nil
+	}
+	workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+	for _, event := range events {
+		event.WorkspaceId = workspaceID
+	}
+	if err := db.Create(&events).Error; err != nil {
+		return wrapError(err)
+	}
+	return createOutboxMessages(db, events)
+}
+
+// createOutboxMessages writes the domain events of recorded events to the outbox, so they are
+// published exactly when the transaction of db commits.
+func createOutboxMessages(db *gorm.DB, events []*entities.TaskEvent) error {
+	messages := make([]*entities.OutboxMessage, len(events))
+	for i, event := range events {
+		message, err := entities.NewOutboxMessage(event)
+		if err != nil {
+			return domainerrors.Internal(err)
+		}
+		messages[i] = message
+	}
+	return wrapError(db.Create(&messages).Error)
+}
+
+// ListEvents returns one page of the events of a task in chronological order and the cursor of the next page.
+func (r *repository) ListEvents(ctx context.Context, taskID uint, query *models.ListTaskHistoryQuery) ([]entities.TaskEvent, string, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	tx := db.Where("task_id = ?", taskID)
+	if query.Cursor != "" {
+		_, id, err := helpers.DecodeCursor(query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		tx = tx.Where("id > ?", id)
+	}
+
+	// Fetch one extra row to know whether there is a next page.
+	var events []entities.TaskEvent
+	if err := tx.Order("id").Limit(query.Limit + 1).Find(&events).Error; err != nil {
+		return nil, "", wrapError(err)
+	}
+	if len(events) <= query.Limit {
+		return events, "", nil
+	}
+	events = events[:query.Limit]
+	return events, helpers.EncodeCursor("", events[len(events)-1].Id), nil
+}
+
+func (r *repository) GetEvent(ctx context.Context, id uint) (*entities.TaskEvent, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var event entities.TaskEvent
+	if err := db.First(&event, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &event, nil
+}
+
+// ListEventsAfter returns the events of the workspace in ID order, which is the order they were
+// recorded in but not always the order their transactions committed in.
+func (r *repository) ListEventsAfter(ctx context.Context, afterID uint, limit int) ([]entities.TaskEvent, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var events []entities.TaskEvent
+	if err := db.Where("id > ?", afterID).Order("id").Limit(limit).Find(&events).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return events, nil
+}
+
+// Transaction runs fn in a transaction bound to ctx; each query of fn still gets its own timeout.
+func (r *repository) Transaction(ctx context.Context, fn func(repo interfaces.TaskRepository) error) error {
+	return wrapError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&repository{tx, r.options})
+	}))
+}
+
+// update sets values, the next version and updated_at on the task row, returning its new version in task.
+func update(db *gorm.DB, task *entities.TaskUpdate, now time.Time, values map[string]interface{}) error {
+	values["version"] = gorm.Expr("version + 1")
+	values["updated_at"] = now
+
+	var updated entities.Task
+	tx := db.Model(&updated).Clauses(clause.Returning{}).Where("id = ?", task.Id)
+	if task.ExpectedVersions != nil {
+		tx = tx.Where("version IN ?", task.ExpectedVersions)
+	}
+	result := tx.Updates(values)
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		if task.ExpectedVersions != nil {
+			return errVersionMismatch
+		}
+		return errTaskNotFound
+	}
+	task.Version = updated.Version
+	return nil
+}
+
+// withContext binds the queries of the returned session to ctx, bounded by the query timeout,
+// and scopes them to the workspace of ctx.
+func (r *repository) withContext(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	return r.db.WithContext(ctx).Scopes(inWorkspace(ctx)).Session(&gorm.Session{}), cancel
+}
+
+// inWorkspace restricts every query to the rows of the workspace of ctx, and fails the queries
+// when ctx has no workspace so a caller cannot read across workspaces by forgetting it.
+// Inserts ignore the condition; the repository sets their workspace_id itself.
+func inWorkspace(ctx context.Context) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		workspaceID, ok := helpers.WorkspaceIDFromContext(ctx)
+		if !ok {
+			_ = db.AddError(errNoWorkspace)
+			return db
+		}
+		return db.Where("workspace_id = ?", workspaceID)
+	}
+}
+
+// sortValue returns the value of the sort field of a task as stored in a cursor.
+func sortValue(task *entities.Task, sort string) string {
+	switch sort {
+	case "title":
+		return task.Title
+	case "status":
+		return string(task.Status)
+	case "priority":
+		return string(task.Priority)
+	case "due_at":
+		if task.DueAt == nil {
+			return noDueDate
+		}
+		return task.DueAt.UTC().Format(time.RFC3339Nano)
+	case "created_at":
+		return task.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case "updated_at":
+		return task.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	default:
+		return strconv.FormatUint(uint64(task.Id), 10)
+	}
+}
+
+// wrapError converts gorm errors into domain errors.
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled), isQueryCanceled(err):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errTaskNotFound
+	case isWorkspaceViolation(err):
+		return errWorkspaceNotFound
+	case isUniqueViolation(err, dependencyPrimaryKey):
+		return errDependencyExists
+	case isUniqueViolation(err, taskLabelPrimaryKey):
+		return errTaskLabelExists
+	case isUniqueViolation(err, occurrenceIndex):
+		return entities.ErrOccurrenceExists
+	default:
+		return domainerrors.Internal(err)
+	}
+}
+
+// isQueryCanceled reports whether Postgres canceled the query, as it does when its context is done.
+func isQueryCanceled(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == sqlStateQueryCanceled
+}
+
+// isWorkspaceViolation reports whether a row was written in a workspace that does not exist.
+func isWorkspaceViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == sqlStateForeignKeyViolation && workspaceForeignKeys[pgErr.ConstraintName]
+}
+
+// isUniqueViolation reports whether a row duplicated the unique key of constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == sqlStateUniqueViolation && pgErr.ConstraintName == constraint
+}
+
+// escapeLike escapes the wildcard characters of a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
 }
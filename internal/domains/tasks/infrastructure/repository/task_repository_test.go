@@ -0,0 +1,365 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgconn"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	workspaceA uint = 1
+	workspaceB uint = 2
+)
+
+func newRepository(t *testing.T) (interfaces.TaskRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewTaskRepository(db, repository.Options{}), mock
+}
+
+func inWorkspace(workspaceID uint) context.Context {
+	return helpers.ContextWithWorkspaceID(context.Background(), workspaceID)
+}
+
+func TestRepository_WorkspaceIsolation(t *testing.T) {
+	t.Run("GetByIDOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		// Task 7 belongs to workspace B: scoped to workspace A, the query matches no row.
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE "tasks"."id" = $1 AND workspace_id = $2 AND "tasks"."deleted_at" IS NULL`)).
+			WithArgs(7, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workspace_id"}))
+
+		task, err := repo.GetByID(inWorkspace(workspaceA), 7)
+
+		assert.Nil(t, task)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetByIDOfOwnWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE "tasks"."id" = $1 AND workspace_id = $2`)).
+			WithArgs(7, workspaceB).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workspace_id"}).AddRow(7, workspaceB))
+
+		task, err := repo.GetByID(inWorkspace(workspaceB), 7)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, workspaceB, task.WorkspaceId)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UnscopedReadsStayInWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE "tasks"."id" = $1 AND workspace_id = $2 ORDER BY`)).
+			WithArgs(7, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetByIDUnscoped(inWorkspace(workspaceA), 7)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "tasks" SET "title"=$1,"updated_at"=$2,"version"=version + 1 WHERE id = $3 AND workspace_id = $4`)).
+			WithArgs("New title", sqlmock.AnyArg(), 7, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		title := "New title"
+		err := repo.Update(inWorkspace(workspaceA), &entities.TaskUpdate{Id: 7, Title: &title})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeleteOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tasks" SET "deleted_at"=$1 WHERE "tasks"."id" = $2 AND workspace_id = $3`)).
+			WithArgs(sqlmock.AnyArg(), 7, workspaceA).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.DeleteByID(inWorkspace(workspaceA), 7)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE status IN ($1) AND workspace_id = $2`)).
+			WithArgs(entities.TaskStatusToDo, workspaceB).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, _, err := repo.List(inWorkspace(workspaceB), &models.ListTasksQuery{Status: []entities.TaskStatus{entities.TaskStatusToDo}, Sort: "id", Limit: 10})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SearchIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`WHERE tasks.search_vector @@ query AND tasks.deleted_at IS NULL AND workspace_id = $7 ORDER BY rank DESC, tasks.id LIMIT 11) AS page WHERE workspace_id = $8`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, _, err := repo.Search(inWorkspace(workspaceA), &models.SearchTasksQuery{Query: "login", Limit: 10})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SearchLanguageOfTheMigration", func(t *testing.T) {
+		// The queries must stem the words like the generated search_vector column
+		migration, err := os.ReadFile("../../../../../db/migrations/000005_add_search_vector_to_tasks.up.sql")
+		require.NoError(t, err)
+		languages := regexp.MustCompile(`to_tsvector\('(\w+)'`).FindAllStringSubmatch(string(migration), -1)
+		require.Len(t, languages, 2)
+		language := languages[0][1]
+		assert.Equal(t, language, languages[1][1])
+
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`to_tsquery($5::regconfig, $6)`)).
+			WithArgs(language, sqlmock.AnyArg(), language, sqlmock.AnyArg(), language, sqlmock.AnyArg(), workspaceA, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, _, err = repo.Search(inWorkspace(workspaceA), &models.SearchTasksQuery{Query: "login", Limit: 10})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListEventsIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "task_events" WHERE task_id = $1 AND workspace_id = $2`)).
+			WithArgs(7, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, _, err := repo.ListEvents(inWorkspace(workspaceA), 7, &models.ListTaskHistoryQuery{Limit: 10})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListEventsAfterIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "task_events" WHERE id > $1 AND workspace_id = $2 ORDER BY id LIMIT 501`)).
+			WithArgs(40, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "task_id"}).AddRow(41, 7).AddRow(43, 8))
+
+		events, err := repo.ListEventsAfter(inWorkspace(workspaceA), 40, 501)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{41, 43}, []uint{events[0].Id, events[1].Id})
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetEventOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "task_events" WHERE "task_events"."id" = $1 AND workspace_id = $2`)).
+			WithArgs(41, workspaceB).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetEvent(inWorkspace(workspaceB), 41)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateUsesContextWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "tasks" ("workspace_id","title"`)).
+			WithArgs(workspaceB, "Task", "Description", entities.TaskStatusToDo, entities.StatusCategoryTodo, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), entities.TaskPriorityMedium, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(1, 1))
+
+		// A workspace set by the caller is overwritten by the one of the context.
+		task := &entities.Task{WorkspaceId: workspaceA, Title: "Task", Description: "Description"}
+		err := repo.Create(inWorkspace(workspaceB), task)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, workspaceB, task.WorkspaceId)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateDuplicateOccurrence", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "tasks"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_tasks_recurrence_occurrence"})
+
+		occurrenceAt := time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC)
+		err := repo.Create(inWorkspace(workspaceB), &entities.Task{Title: "Task", RecurrenceId: lo.ToPtr(uint(3)), OccurrenceAt: &occurrenceAt})
+
+		assert.ErrorIs(t, err, entities.ErrOccurrenceExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("TransactionIsScoped", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE "tasks"."id" = $1 AND workspace_id = $2`)).
+			WithArgs(7, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		ctx := inWorkspace(workspaceA)
+		err := repo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+			_, err := repo.GetByIDForUpdate(ctx, 7)
+			return err
+		})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoWorkspaceRunsNoQuery", func(t *testing.T) {
+		repo, mock := newRepository(t)
+
+		_, err := repo.GetByID(context.Background(), 7)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+
+		_, _, err = repo.List(context.Background(), &models.ListTasksQuery{Sort: "id", Limit: 10})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+
+		err = repo.Create(context.Background(), &entities.Task{Title: "Task", Description: "Description"})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Schedule(t *testing.T) {
+	t.Run("ListOverdueByDueDate", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		now := time.Date(2026, 10, 18, 16, 0, 0, 0, time.FixedZone("ICT", 7*60*60))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE (due_at < $1 AND status_category <> $2) AND workspace_id = $3 AND "tasks"."deleted_at" IS NULL ORDER BY COALESCE(due_at, 'infinity'),"id" LIMIT 3`)).
+			WithArgs(now.UTC(), entities.StatusCategoryDone, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "due_at"}).AddRow(4, now.Add(-time.Hour)).AddRow(2, nil).AddRow(7, nil))
+
+		tasks, nextCursor, err := repo.List(inWorkspace(workspaceA), &models.ListTasksQuery{DueBefore: &now, Sort: "due_at", Limit: 2})
+
+		if assert.NoError(t, err) && assert.Len(t, tasks, 2) {
+			// The last task has no due date: the next page starts after the tasks due at infinity.
+			value, id, err := helpers.DecodeCursor(nextCursor)
+			assert.NoError(t, err)
+			assert.Equal(t, "infinity", value)
+			assert.Equal(t, uint(2), id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListByPriorityAfterCursor", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE (priority, id) < ($1, $2) AND workspace_id = $3 AND "tasks"."deleted_at" IS NULL ORDER BY "priority" DESC,"id" DESC LIMIT 11`)).
+			WithArgs(entities.TaskPriorityHigh, 9, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "priority"}))
+
+		_, _, err := repo.List(inWorkspace(workspaceA), &models.ListTasksQuery{
+			Sort: "priority", Order: models.SortOrderDesc, Limit: 10, Cursor: helpers.EncodeCursor("HIGH", 9),
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateReplacesSchedule", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		dueAt := time.Date(2026, 12, 1, 9, 0, 0, 0, time.FixedZone("ICT", 7*60*60))
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "tasks" SET "due_at"=$1,"estimate_minutes"=$2,"priority"=$3,"updated_at"=$4,"version"=version + 1 WHERE id = $5 AND workspace_id = $6`)).
+			WithArgs(dueAt.UTC(), nil, entities.TaskPriorityUrgent, sqlmock.AnyArg(), 7, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(7, 3))
+
+		task := &entities.TaskUpdate{Id: 7, TaskSchedule: &entities.TaskSchedule{DueAt: &dueAt, Priority: entities.TaskPriorityUrgent}}
+		err := repo.Update(inWorkspace(workspaceA), task)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, uint(3), task.Version)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateStatusToDoneCategory", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "tasks" SET "completed_at"=$1,"status"=$2,"status_category"=$3,"updated_at"=$4,"version"=version + 1 WHERE id = $5 AND workspace_id = $6`)).
+			WithArgs(sqlmock.AnyArg(), "SHIPPED", entities.StatusCategoryDone, sqlmock.AnyArg(), 7, workspaceA).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(7, 4))
+
+		status := entities.TaskStatus("SHIPPED")
+		err := repo.Update(inWorkspace(workspaceA), &entities.TaskUpdate{Id: 7, Status: &status, StatusCategory: entities.StatusCategoryDone})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Outbox(t *testing.T) {
+	t.Run("CreateEventWritesItsDomainEvent", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "task_events"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(40))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox" ("event_id","workspace_id","type","payload","attempts","next_attempt_at","last_error","published_sinks","created_at","published_at")`)).
+			WithArgs(sqlmock.AnyArg(), workspaceA, "TaskStatusChanged", sqlmock.AnyArg(), 0, sqlmock.AnyArg(), "", "", sqlmock.AnyArg(), nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+		event := &entities.TaskEvent{TaskId: 7, Action: entities.TaskEventStatusChanged, Changes: entities.JSON(`{}`)}
+		assert.NoError(t, repo.CreateEvent(inWorkspace(workspaceA), event))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateEventsWritesOneMessageEach", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "task_events"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(41).AddRow(42))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox"`)).
+			WithArgs(
+				sqlmock.AnyArg(), workspaceB, "TaskCreated", sqlmock.AnyArg(), 0, sqlmock.AnyArg(), "", "", sqlmock.AnyArg(), nil,
+				sqlmock.AnyArg(), workspaceB, "TaskUpdated", sqlmock.AnyArg(), 0, sqlmock.AnyArg(), "", "", sqlmock.AnyArg(), nil,
+			).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2).AddRow(3))
+
+		err := repo.CreateEvents(inWorkspace(workspaceB), []*entities.TaskEvent{
+			{TaskId: 8, Action: entities.TaskEventCreated, Changes: entities.JSON(`{}`)},
+			{TaskId: 8, Action: entities.TaskEventLabelAdded, Changes: entities.JSON(`{}`)},
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("OutboxFailureFailsTheEvent", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "task_events"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(43))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "outbox"`)).
+			WillReturnError(context.DeadlineExceeded)
+
+		err := repo.CreateEvent(inWorkspace(workspaceA), &entities.TaskEvent{TaskId: 7, Action: entities.TaskEventDeleted})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindUnavailable))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+const (
+	// searchLanguage is the text search configuration of the queries, which must be the one of the
+	// search_vector column in the migrations.
+	searchLanguage = "english"
+	// maxSearchWords is the number of words of a search query that are matched.
+	maxSearchWords = 10
+
+	titleHeadlineOptions       = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
+	descriptionHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MinWords=15, MaxWords=35, MaxFragments=3"
+)
+
+// searchWord matches the words of a search query; anything else, including tsquery operators, is dropped.
+var searchWord = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// Search returns one page of the tasks matching a full-text query, best match first, and the cursor of the next page.
+// Every word of the query must match the title or the description, as a prefix of a word.
+func (r *repository) Search(ctx context.Context, query *models.SearchTasksQuery) ([]models.TaskSearchResult, string, error) {
+	tsQuery := prefixTSQuery(query.Query)
+	if tsQuery == "" {
+		return nil, "", domainerrors.Validation("the search query has no words")
+	}
+	offset := 0
+	if query.Cursor != "" {
+		value, _, err := helpers.DecodeCursor(query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		if offset, err = strconv.Atoi(value); err != nil || offset < 0 {
+			return nil, "", helpers.ErrInvalidCursor
+		}
+	}
+
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+
+	// Rank and paginate first so the snippets are only built for the rows of the page.
+	// Fetch one extra row to know whether there is a next page.
+	page := db.Table("tasks, to_tsquery(?::regconfig, ?) AS query", searchLanguage, tsQuery).
+		Select("tasks.*, ts_rank_cd(tasks.search_vector, query) AS rank, query").
+		Where("tasks.search_vector @@ query").
+		Where("tasks.deleted_at IS NULL")
+	if query.VisibleTo != "" {
+		page = page.Where("(tasks.created_by = ? OR tasks.assignee_id = ?)", query.VisibleTo, query.VisibleTo)
+	}
+	page = page.Order("rank DESC, tasks.id").
+		Limit(query.Limit + 1).
+		Offset(offset)
+
+	// Deleted tasks are already left out of the page.
+	var results []models.TaskSearchResult
+	err := db.Unscoped().Table("(?) AS page", page).
+		Select("page.*, ts_headline(?::regconfig, page.title, page.query, ?) AS title_snippet, ts_headline(?::regconfig, page.description, page.query, ?) AS description_snippet",
+			searchLanguage, titleHeadlineOptions, searchLanguage, descriptionHeadlineOptions).
+		Order("page.rank DESC, page.id").
+		Find(&results).Error
+	if err != nil {
+		return nil, "", wrapError(err)
+	}
+	if len(results) <= query.Limit {
+		return results, "", nil
+	}
+	results = results[:query.Limit]
+	last := results[len(results)-1]
+	return results, helpers.EncodeCursor(strconv.Itoa(offset+query.Limit), last.Id), nil
+}
+
+// prefixTSQuery builds a tsquery matching every word of a search query as a prefix.
+func prefixTSQuery(q string) string {
+	words := searchWord.FindAllString(q, maxSearchWords)
+	terms := lo.Map(words, func(word string, _ int) string {
+		return word + ":*"
+	})
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// AddTaskDependency makes a task blocked by another task
+// @Summary Add a dependency
+// @Description Record that the task blocker_id blocks the task: it cannot move to IN_PROGRESS or DONE until the blocker is DONE. Only the owner, the assignee or an admin of the blocked task can, and a dependency that closes a cycle is refused.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Blocked task ID"
+// @Param body body models.AddTaskDependencyRequest true "Blocker"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=entities.TaskDependency} "Dependency added successfully"
+// @Failure 400 {object} models.ProblemDetails "Invalid input or blocker task not found"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner or the assignee of the task"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 409 {object} models.ProblemDetails "The dependency exists or would create a cycle"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/dependencies [post]
+func (h *Handler) AddTaskDependency(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	var req models.AddTaskDependencyRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	dependency, err := h.TaskUsecase.AddTaskDependency(c.Request().Context(), interfaces.ActorFrom(c), id, req.BlockerId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Dependency added", dependency))
+}
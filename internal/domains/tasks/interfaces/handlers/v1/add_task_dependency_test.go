@@ -0,0 +1,83 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestAddTaskDependency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+id+"/dependencies", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/dependencies")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().AddTaskDependency(gomock.Any(), anonymous, uint(15), uint(12)).
+			Return(&entities.TaskDependency{BlockerId: 12, BlockedId: 15}, nil)
+
+		c, rec := newContext("15", `{"blocker_id": 12}`)
+		if assert.NoError(t, handler.AddTaskDependency(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+
+			var response struct {
+				models.ResponseSuccess
+				Data entities.TaskDependency `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "Dependency added", response.Message)
+			assert.Equal(t, uint(12), response.Data.BlockerId)
+			assert.Equal(t, uint(15), response.Data.BlockedId)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		mockUsecase.EXPECT().AddTaskDependency(gomock.Any(), anonymous, uint(12), uint(15)).
+			Return(nil, domainerrors.Conflict("the dependency would create a cycle"))
+
+		c, rec := newContext("12", `{"blocker_id": 15}`)
+		if assert.Error(t, invoke(handler.AddTaskDependency, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_MissingBlocker", func(t *testing.T) {
+		c, rec := newContext("15", `{}`)
+		if assert.Error(t, invoke(handler.AddTaskDependency, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidID", func(t *testing.T) {
+		c, rec := newContext("abc", `{"blocker_id": 12}`)
+		if assert.Error(t, invoke(handler.AddTaskDependency, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
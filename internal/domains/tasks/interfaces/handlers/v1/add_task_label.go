@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// AddTaskLabel puts a label on a task
+// @Summary Add a label to a task
+// @Description Put a label of the workspace on the task. Only the owner, the assignee or an admin of the task can.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param body body models.AddTaskLabelRequest true "Label"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=entities.TaskLabel} "Label added successfully"
+// @Failure 400 {object} models.ProblemDetails "Invalid input or label not found"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner or the assignee of the task"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 409 {object} models.ProblemDetails "The task already has the label"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/labels [post]
+func (h *Handler) AddTaskLabel(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	var req models.AddTaskLabelRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	taskLabel, err := h.TaskUsecase.AddTaskLabel(c.Request().Context(), interfaces.ActorFrom(c), id, req.LabelId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Label added", taskLabel))
+}
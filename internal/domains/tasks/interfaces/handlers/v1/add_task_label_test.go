@@ -0,0 +1,75 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestAddTaskLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+id+"/labels", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/labels")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().AddTaskLabel(gomock.Any(), anonymous, uint(15), uint(7)).
+			Return(&entities.TaskLabel{TaskId: 15, LabelId: 7}, nil)
+
+		c, rec := newContext("15", `{"label_id": 7}`)
+		if assert.NoError(t, handler.AddTaskLabel(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+
+			var response struct {
+				models.ResponseSuccess
+				Data entities.TaskLabel `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "Label added", response.Message)
+			assert.Equal(t, uint(7), response.Data.LabelId)
+		}
+	})
+
+	t.Run("AlreadyLabeled", func(t *testing.T) {
+		mockUsecase.EXPECT().AddTaskLabel(gomock.Any(), anonymous, uint(15), uint(8)).
+			Return(nil, domainerrors.Conflict("the task already has this label"))
+
+		c, rec := newContext("15", `{"label_id": 8}`)
+		if assert.Error(t, invoke(handler.AddTaskLabel, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_MissingLabel", func(t *testing.T) {
+		c, rec := newContext("15", `{}`)
+		if assert.Error(t, invoke(handler.AddTaskLabel, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
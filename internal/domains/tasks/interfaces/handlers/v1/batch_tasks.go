@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	responseModels "github.com/supachai1998/task_services/internal/models"
+)
+
+// batchSuccessStatus is the status code of a successful operation of each kind.
+var batchSuccessStatus = map[string]int{
+	models.BatchOpCreate: http.StatusCreated,
+	models.BatchOpUpdate: http.StatusOK,
+	models.BatchOpStatus: http.StatusOK,
+	models.BatchOpDelete: http.StatusNoContent,
+}
+
+// BatchTasks applies several task operations in one request
+// @Summary Apply a batch of task operations
+// @Description Create, update, change the status of or delete up to 100 tasks. Each operation is validated on its own
+// @Description and reported with its index, status code and error. With atomic set, either every operation is applied
+// @Description or none is, and the operations that did not fail report 424.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param batch body models.BatchTasksRequest true "Batch of operations"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=[]models.BatchItemResult} "Every operation succeeded"
+// @Success 207 {object} models.ResponseSuccess{data=[]models.BatchItemResult} "At least one operation failed"
+// @Failure 400 {object} models.ProblemDetails "Invalid batch"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks:batch [post]
+func (h *Handler) BatchTasks(c echo.Context) error {
+	var req models.BatchTasksRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	errs := make([]error, len(req.Operations))
+	ops := make([]models.TaskOperation, 0, len(req.Operations))
+	for i, item := range req.Operations {
+		op, err := newTaskOperation(c, i, item)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		ops = append(ops, op)
+	}
+
+	if req.Atomic && len(ops) < len(req.Operations) {
+		// Nothing is applied when an operation of an atomic batch is invalid.
+		for i := range errs {
+			if errs[i] == nil {
+				errs[i] = usecases.ErrBatchAborted
+			}
+		}
+		return writeBatchResults(c, req.Operations, errs, nil)
+	}
+	results := h.TaskUsecase.ExecuteBatch(c.Request().Context(), interfaces.ActorFrom(c), ops, req.Atomic)
+	return writeBatchResults(c, req.Operations, errs, results)
+}
+
+// newTaskOperation validates an operation of a batch with the request model of its single-task endpoint.
+func newTaskOperation(c echo.Context, index int, item models.BatchOperation) (models.TaskOperation, error) {
+	op := models.TaskOperation{Index: index, Op: item.Op, Id: item.Id}
+	if err := c.Validate(item); err != nil {
+		return op, err
+	}
+
+	var expectedVersions []uint
+	if item.Version != nil {
+		expectedVersions = []uint{*item.Version}
+	}
+	switch item.Op {
+	case models.BatchOpCreate:
+		req := models.CreateTaskRequest{
+			Title:               item.Title,
+			Description:         item.Description,
+			ParentId:            item.ParentId,
+			TaskScheduleRequest: item.TaskScheduleRequest,
+		}
+		if err := c.Validate(req); err != nil {
+			return op, err
+		}
+		op.Task = &entities.Task{
+			Title:        req.Title,
+			Description:  req.Description,
+			ParentId:     req.ParentId,
+			TaskSchedule: req.Schedule(),
+		}
+	case models.BatchOpUpdate:
+		req := models.UpdateTaskRequest{
+			Title:               item.Title,
+			Description:         item.Description,
+			TaskScheduleRequest: item.TaskScheduleRequest,
+		}
+		if err := c.Validate(req); err != nil {
+			return op, err
+		}
+		schedule := req.Schedule()
+		op.Update = &entities.TaskUpdate{
+			Id:               item.Id,
+			Title:            &req.Title,
+			Description:      &req.Description,
+			TaskSchedule:     &schedule,
+			ExpectedVersions: expectedVersions,
+		}
+	case models.BatchOpStatus:
+		req := models.UpdateTaskStatusRequest{Status: item.Status, Force: item.Force}
+		if err := c.Validate(req); err != nil {
+			return op, err
+		}
+		status := entities.TaskStatus(req.Status)
+		op.Update = &entities.TaskUpdate{
+			Id:               item.Id,
+			Status:           &status,
+			ExpectedVersions: expectedVersions,
+			Force:            req.Force,
+		}
+	case models.BatchOpDelete:
+		// The batch route only requires tasks:write, deletes also need tasks:delete.
+		if !interfaces.HasScope(c, entities.ScopeTasksDelete) {
+			return op, interfaces.ErrMissingScope(entities.ScopeTasksDelete)
+		}
+	default:
+		return op, domainerrors.Validation("unknown operation " + item.Op)
+	}
+	return op, nil
+}
+
+// writeBatchResults writes one result per operation, from the validation errors and the usecase results.
+// The response is 207 when an operation failed.
+func writeBatchResults(c echo.Context, items []models.BatchOperation, errs []error, executed []models.TaskOperationResult) error {
+	results := make([]responseModels.BatchItemResult, len(items))
+	for i, err := range errs {
+		results[i] = responseModels.BatchItemResult{Index: i}
+		if err != nil {
+			setBatchError(c, &results[i], err)
+		}
+	}
+	for _, res := range executed {
+		result := &results[res.Index]
+		if res.Err != nil {
+			setBatchError(c, result, res.Err)
+			continue
+		}
+		result.Status = batchSuccessStatus[res.Op]
+		result.Data = res.Data
+	}
+
+	status := http.StatusOK
+	for _, result := range results {
+		if result.Error != nil {
+			status = http.StatusMultiStatus
+			break
+		}
+	}
+	return c.JSON(status, helpers.NewResponseSuccess("Batch executed", results))
+}
+
+func setBatchError(c echo.Context, result *responseModels.BatchItemResult, err error) {
+	problem := interfaces.NewProblemDetails(err)
+	if problem.Status >= http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+	result.Status = problem.Status
+	result.Error = &problem
+}
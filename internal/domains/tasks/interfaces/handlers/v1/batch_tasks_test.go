@@ -0,0 +1,254 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	taskModels "github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+// batchResponse is the body of a batch response.
+type batchResponse struct {
+	Message string                   `json:"message"`
+	Data    []models.BatchItemResult `json:"data"`
+}
+
+func TestBatchTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+	e.HTTPErrorHandler = interfaces.HTTPErrorHandler
+	handlers.NewTaskHandler(e, mockUsecase)
+
+	// serve sends the batch through the router, which must resolve the escaped colon of /v1/tasks:batch
+	serve := func(body string) (*httptest.ResponseRecorder, batchResponse) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks:batch", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		var response batchResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return rec, response
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		inProgress := entities.TaskStatusInProgress
+		version := uint(2)
+		expectedOps := []taskModels.TaskOperation{
+			{Index: 0, Op: taskModels.BatchOpCreate, Task: &entities.Task{Title: "Imported task", Description: "Imported description"}},
+			{Index: 1, Op: taskModels.BatchOpStatus, Id: 7, Update: &entities.TaskUpdate{Id: 7, Status: &inProgress, ExpectedVersions: []uint{version}}},
+			{Index: 2, Op: taskModels.BatchOpDelete, Id: 8},
+		}
+		mockUsecase.EXPECT().ExecuteBatch(gomock.Any(), anonymous, expectedOps, false).Return([]taskModels.TaskOperationResult{
+			{Index: 0, Op: taskModels.BatchOpCreate, Data: &entities.Task{Id: 1, Title: "Imported task"}},
+			{Index: 1, Op: taskModels.BatchOpStatus, Data: &entities.TaskUpdate{Id: 7, Status: &inProgress, Version: 3}},
+			{Index: 2, Op: taskModels.BatchOpDelete},
+		})
+
+		rec, response := serve(`{"operations":[
+			{"op":"create","title":"Imported task","description":"Imported description"},
+			{"op":"status","id":7,"status":"IN_PROGRESS","version":2},
+			{"op":"delete","id":8}
+		]}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "Batch executed", response.Message)
+		if assert.Len(t, response.Data, 3) {
+			assert.Equal(t, http.StatusCreated, response.Data[0].Status)
+			assert.Equal(t, http.StatusOK, response.Data[1].Status)
+			assert.Equal(t, http.StatusNoContent, response.Data[2].Status)
+			assert.Nil(t, response.Data[2].Error)
+		}
+	})
+
+	t.Run("Success_Schedule", func(t *testing.T) {
+		dueAt := time.Date(2026, 11, 30, 17, 0, 0, 0, time.UTC)
+		created := &entities.Task{
+			Title:        "Imported task",
+			Description:  "Imported description",
+			TaskSchedule: entities.TaskSchedule{DueAt: &dueAt, Priority: entities.TaskPriorityHigh, EstimateMinutes: lo.ToPtr(90)},
+		}
+		// Like PUT, the update replaces the schedule: its omitted due_at and estimate_minutes are cleared
+		updated := &entities.TaskUpdate{
+			Id:           7,
+			Title:        lo.ToPtr("Updated title"),
+			Description:  lo.ToPtr("Updated description"),
+			TaskSchedule: &entities.TaskSchedule{Priority: entities.TaskPriorityUrgent},
+		}
+		mockUsecase.EXPECT().ExecuteBatch(gomock.Any(), anonymous, []taskModels.TaskOperation{
+			{Index: 0, Op: taskModels.BatchOpCreate, Task: created},
+			{Index: 1, Op: taskModels.BatchOpUpdate, Id: 7, Update: updated},
+		}, false).DoAndReturn(func(_ context.Context, _ entities.Actor, ops []taskModels.TaskOperation, _ bool) []taskModels.TaskOperationResult {
+			ops[0].Task.Id = 4
+			return []taskModels.TaskOperationResult{
+				{Index: 0, Op: taskModels.BatchOpCreate, Data: ops[0].Task},
+				{Index: 1, Op: taskModels.BatchOpUpdate, Data: ops[1].Update},
+			}
+		})
+
+		rec, response := serve(`{"operations":[
+			{"op":"create","title":"Imported task","description":"Imported description",
+				"due_at":"2026-11-30T17:00:00Z","priority":"HIGH","estimate_minutes":90},
+			{"op":"update","id":7,"title":"Updated title","description":"Updated description","priority":"URGENT"}
+		]}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, response.Data, 2) {
+			data, err := json.Marshal(response.Data[0].Data)
+			assert.NoError(t, err)
+			var task entities.Task
+			assert.NoError(t, json.Unmarshal(data, &task))
+			assert.True(t, dueAt.Equal(*task.DueAt))
+			assert.Equal(t, entities.TaskPriorityHigh, task.Priority)
+			assert.Equal(t, lo.ToPtr(90), task.EstimateMinutes)
+		}
+	})
+
+	t.Run("Success_Subtask", func(t *testing.T) {
+		mockUsecase.EXPECT().ExecuteBatch(gomock.Any(), anonymous, []taskModels.TaskOperation{
+			{Index: 0, Op: taskModels.BatchOpCreate, Task: &entities.Task{Title: "Imported subtask", Description: "Imported description", ParentId: lo.ToPtr(uint(3))}},
+		}, false).Return([]taskModels.TaskOperationResult{
+			{Index: 0, Op: taskModels.BatchOpCreate, Data: &entities.Task{Id: 4, ParentId: lo.ToPtr(uint(3))}},
+		})
+
+		rec, response := serve(`{"operations":[{"op":"create","title":"Imported subtask","description":"Imported description","parent_id":3}]}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, response.Data, 1) {
+			assert.Equal(t, http.StatusCreated, response.Data[0].Status)
+		}
+	})
+
+	t.Run("BadRequest_InvalidSchedule", func(t *testing.T) {
+		mockUsecase.EXPECT().ExecuteBatch(gomock.Any(), anonymous, []taskModels.TaskOperation{}, false).Return(nil)
+
+		rec, response := serve(`{"operations":[{"op":"create","title":"Imported task","description":"Imported description","priority":"SOON"}]}`)
+
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		if assert.Len(t, response.Data, 1) {
+			assert.Equal(t, http.StatusBadRequest, response.Data[0].Status)
+			assert.Contains(t, response.Data[0].Error.Detail, "priority")
+		}
+	})
+
+	t.Run("BestEffort_PartialFailure", func(t *testing.T) {
+		// The invalid operation is reported without reaching the usecase
+		mockUsecase.EXPECT().ExecuteBatch(gomock.Any(), anonymous, []taskModels.TaskOperation{
+			{Index: 1, Op: taskModels.BatchOpDelete, Id: 9},
+		}, false).Return([]taskModels.TaskOperationResult{
+			{Index: 1, Op: taskModels.BatchOpDelete, Err: domainerrors.NotFound("task not found")},
+		})
+
+		rec, response := serve(`{"operations":[{"op":"create","title":"x"},{"op":"delete","id":9}]}`)
+
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		if assert.Len(t, response.Data, 2) {
+			assert.Equal(t, 0, response.Data[0].Index)
+			assert.Equal(t, http.StatusBadRequest, response.Data[0].Status)
+			assert.Equal(t, http.StatusBadRequest, response.Data[0].Error.Status)
+			assert.Equal(t, http.StatusNotFound, response.Data[1].Status)
+			assert.Equal(t, "task not found", response.Data[1].Error.Detail)
+		}
+	})
+
+	t.Run("Atomic_InvalidOperation", func(t *testing.T) {
+		// No operation of an atomic batch runs when one is invalid
+		rec, response := serve(`{"atomic":true,"operations":[{"op":"delete","id":1},{"op":"update","title":"Valid title"}]}`)
+
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		if assert.Len(t, response.Data, 2) {
+			assert.Equal(t, http.StatusFailedDependency, response.Data[0].Status)
+			assert.Equal(t, usecases.ErrBatchAborted.Message, response.Data[0].Error.Detail)
+			assert.Equal(t, http.StatusBadRequest, response.Data[1].Status)
+		}
+	})
+
+	t.Run("Atomic_RolledBack", func(t *testing.T) {
+		done := entities.TaskStatusDone
+		mockUsecase.EXPECT().ExecuteBatch(gomock.Any(), anonymous, []taskModels.TaskOperation{
+			{Index: 0, Op: taskModels.BatchOpStatus, Id: 1, Update: &entities.TaskUpdate{Id: 1, Status: &done}},
+			{Index: 1, Op: taskModels.BatchOpStatus, Id: 2, Update: &entities.TaskUpdate{Id: 2, Status: &done}},
+		}, true).Return([]taskModels.TaskOperationResult{
+			{Index: 0, Op: taskModels.BatchOpStatus, Err: usecases.ErrBatchAborted},
+			{Index: 1, Op: taskModels.BatchOpStatus, Err: domainerrors.Conflict("cannot change task status from DONE to DONE")},
+		})
+
+		rec, response := serve(`{"atomic":true,"operations":[{"op":"status","id":1,"status":"DONE"},{"op":"status","id":2,"status":"DONE"}]}`)
+
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		if assert.Len(t, response.Data, 2) {
+			assert.Equal(t, http.StatusFailedDependency, response.Data[0].Status)
+			assert.Equal(t, http.StatusConflict, response.Data[1].Status)
+		}
+	})
+
+	t.Run("ApiKeyWithoutDeleteScope", func(t *testing.T) {
+		// A key without tasks:delete has its delete operations rejected one by one
+		keyEcho := echo.New()
+		keyEcho.Validator = interfaces.NewCustomValidator()
+		keyEcho.HTTPErrorHandler = interfaces.HTTPErrorHandler
+		keyEcho.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				c.Set(auth.ContextKeyPrincipal, &auth.Principal{
+					Subject:  "alice",
+					Scopes:   entities.ApiKeyScopes{entities.ScopeTasksRead, entities.ScopeTasksWrite},
+					ApiKeyId: 3,
+				})
+				c.Set(interfaces.ContextKeyWorkspaceRole, entities.WorkspaceRoleMember)
+				return next(c)
+			}
+		})
+		handlers.NewTaskHandler(keyEcho, mockUsecase)
+
+		actor := entities.Actor{Name: "alice", UserId: "alice", ApiKeyId: 3, Role: entities.WorkspaceRoleMember}
+		mockUsecase.EXPECT().ExecuteBatch(gomock.Any(), actor, []taskModels.TaskOperation{
+			{Index: 0, Op: taskModels.BatchOpCreate, Task: &entities.Task{Title: "Imported task", Description: "Imported description"}},
+		}, false).Return([]taskModels.TaskOperationResult{
+			{Index: 0, Op: taskModels.BatchOpCreate, Data: &entities.Task{Id: 1, Title: "Imported task"}},
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks:batch", strings.NewReader(`{"operations":[{"op":"create","title":"Imported task","description":"Imported description"},{"op":"delete","id":8}]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		keyEcho.ServeHTTP(rec, req)
+
+		var response batchResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, http.StatusMultiStatus, rec.Code)
+		if assert.Len(t, response.Data, 2) {
+			assert.Equal(t, http.StatusCreated, response.Data[0].Status)
+			assert.Equal(t, http.StatusForbidden, response.Data[1].Status)
+		}
+	})
+
+	t.Run("BadRequest_EmptyBatch", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks:batch", strings.NewReader(`{"operations":[]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, interfaces.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+	})
+}
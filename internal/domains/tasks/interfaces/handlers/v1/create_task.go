@@ -8,32 +8,39 @@ import (
 	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
 	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
 )
 
 // CreateTask handles task creation
 // @Summary Create a new task
-// @Description Create a new task with the provided details
+// @Description Create a new task with the provided details, as a subtask of parent_id when it is set
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Param task body models.CreateTaskRequest true "Task object"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
 // @Success 201 {object} models.ResponseSuccess{data=entities.Task} "Task created successfully"
-// @Failure 400 {object} models.ResponseError "Invalid input"
-// @Failure 500 {object} models.ResponseError "Internal server error"
+// @Failure 400 {object} models.ProblemDetails "Invalid input or parent task not found"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/tasks [post]
 func (h *Handler) CreateTask(c echo.Context) error {
 	req := new(models.CreateTaskRequest)
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError(err.Error(), "error"))
+		return err
 	}
 	if err := c.Validate(req); err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError(err.Error(), "error"))
+		return err
 	}
 
 	task := new(entities.Task)
 	copier.Copy(&task, req)
-	if err := h.TaskUsecase.CreateTask(task); err != nil {
-		return c.JSON(http.StatusInternalServerError, helpers.NewResponseError(err.Error(), "error"))
+	task.TaskSchedule = req.Schedule()
+	if err := h.TaskUsecase.CreateTask(c.Request().Context(), interfaces.ActorFrom(c), task); err != nil {
+		return err
 	}
 	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Task created", task))
 }
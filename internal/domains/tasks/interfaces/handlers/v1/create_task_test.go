@@ -2,13 +2,17 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
@@ -44,8 +48,8 @@ func TestCreateTask(t *testing.T) {
 		}
 
 		// Set expectation: CreateTask should be called with a task matching expectedTask
-		mockUsecase.EXPECT().CreateTask(gomock.AssignableToTypeOf(&entities.Task{})).DoAndReturn(
-			func(task *entities.Task) error {
+		mockUsecase.EXPECT().CreateTask(gomock.Any(), anonymous, gomock.AssignableToTypeOf(&entities.Task{})).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.Task) error {
 				// Verify that the task fields match the request
 				assert.Equal(t, expectedTask.Title, task.Title)
 				assert.Equal(t, expectedTask.Description, task.Description)
@@ -86,6 +90,53 @@ func TestCreateTask(t *testing.T) {
 		}
 	})
 
+	t.Run("Success_Actor", func(t *testing.T) {
+		createTaskReq := &taskModels.CreateTaskRequest{
+			Title:       "Audited Task",
+			Description: "Audited Description",
+		}
+		payload, err := json.Marshal(createTaskReq)
+		assert.NoError(t, err)
+
+		// Set expectation: CreateTask should receive the actor and request ID of the request
+		expectedActor := entities.Actor{Name: "alice", RequestId: "request-1"}
+		mockUsecase.EXPECT().CreateTask(gomock.Any(), expectedActor, gomock.AssignableToTypeOf(&entities.Task{})).Return(nil)
+
+		// Create a new HTTP POST request on behalf of alice
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks", bytes.NewBuffer(payload))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("X-Actor", "alice")
+		rec := httptest.NewRecorder()
+		rec.Header().Set(echo.HeaderXRequestID, "request-1")
+		c := e.NewContext(req, rec)
+
+		// Invoke the handler
+		if assert.NoError(t, handler.CreateTask(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+		}
+	})
+
+	t.Run("Success_Schedule", func(t *testing.T) {
+		dueAt := time.Date(2026, 11, 30, 17, 0, 0, 0, time.UTC)
+		expectedSchedule := entities.TaskSchedule{DueAt: &dueAt, Priority: entities.TaskPriorityHigh, EstimateMinutes: lo.ToPtr(90)}
+		mockUsecase.EXPECT().CreateTask(gomock.Any(), anonymous, gomock.AssignableToTypeOf(&entities.Task{})).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.Task) error {
+				assert.Equal(t, expectedSchedule, task.TaskSchedule)
+				return nil
+			},
+		)
+
+		payload := `{"title": "Plan the sprint", "description": "Pick the stories", "due_at": "2026-11-30T17:00:00Z", "priority": "HIGH", "estimate_minutes": 90}`
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks", strings.NewReader(payload))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, handler.CreateTask(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+		}
+	})
+
 	t.Run("BindError", func(t *testing.T) {
 		// Define an invalid JSON payload
 		invalidPayload := []byte(`{"title": "Incomplete JSON`)
@@ -97,18 +148,18 @@ func TestCreateTask(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		// Invoke the handler
-		if assert.NoError(t, handler.CreateTask(c)) {
+		if assert.Error(t, invoke(handler.CreateTask, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
 
-			// Unmarshal the response body into models.ResponseError
-			var response models.ResponseError
+			// Unmarshal the response body into models.ProblemDetails
+			var response models.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Contains(t, response.Message, "unexpected")
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Contains(t, response.Detail, "unexpected")
 		}
 	})
 
@@ -131,8 +182,8 @@ func TestCreateTask(t *testing.T) {
 		usecaseError := assert.AnError
 
 		// Set expectation: CreateTask should be called with a task matching expectedTask and return an error
-		mockUsecase.EXPECT().CreateTask(gomock.AssignableToTypeOf(&entities.Task{})).DoAndReturn(
-			func(task *entities.Task) error {
+		mockUsecase.EXPECT().CreateTask(gomock.Any(), anonymous, gomock.AssignableToTypeOf(&entities.Task{})).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.Task) error {
 				// Verify that the task fields match the request
 				assert.Equal(t, expectedTask.Title, task.Title)
 				assert.Equal(t, expectedTask.Description, task.Description)
@@ -148,18 +199,19 @@ func TestCreateTask(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		// Invoke the handler
-		if assert.NoError(t, handler.CreateTask(c)) {
+		if assert.Error(t, invoke(handler.CreateTask, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusInternalServerError, rec.Code)
 
-			// Unmarshal the response body into models.ResponseError
-			var response models.ResponseError
+			// Unmarshal the response body into models.ProblemDetails
+			var response models.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Equal(t, usecaseError.Error(), response.Message)
+			assert.Equal(t, http.StatusInternalServerError, response.Status)
+			// Internal errors are not leaked to the client
+			assert.Equal(t, "internal server error", response.Detail)
 		}
 	})
 
@@ -179,18 +231,35 @@ func TestCreateTask(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		// Invoke the handler
-		if assert.NoError(t, handler.CreateTask(c)) {
+		if assert.Error(t, invoke(handler.CreateTask, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
 
-			// Unmarshal the response body into models.ResponseError
-			var response models.ResponseError
+			// Unmarshal the response body into models.ProblemDetails
+			var response models.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Contains(t, response.Message, "title")
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Contains(t, response.Detail, "title")
+		}
+	})
+
+	t.Run("ValidateError_Schedule", func(t *testing.T) {
+		payloads := []string{
+			`{"title": "Plan the sprint", "description": "Pick the stories", "priority": "SOMEDAY"}`,
+			`{"title": "Plan the sprint", "description": "Pick the stories", "estimate_minutes": -5}`,
+		}
+		for _, payload := range payloads {
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks", strings.NewReader(payload))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if assert.Error(t, invoke(handler.CreateTask, c)) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+			}
 		}
 	})
 }
@@ -2,10 +2,9 @@ package handlers
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/labstack/echo/v4"
-	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
 )
 
 // UpdateTask updates task details
@@ -15,17 +14,23 @@ import (
 // @Accept json
 // @Produce json
 // @Param id path int true "Task ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
 // @Success 204 "Task deleted successfully"
-// @Failure 400 {object} models.ResponseError "Invalid input"
-// @Failure 500 {object} models.ResponseError "Internal server error"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner of the task"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/tasks/{id} [delete]
 func (h *Handler) DeleteTaskByID(c echo.Context) error {
-	id, err := strconv.Atoi(c.Param("id"))
+	id, err := parseTaskID(c)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError("Invalid ID format", "error"))
+		return err
 	}
-	if err := h.TaskUsecase.DeleteTaskByID(uint(id)); err != nil {
-		return c.JSON(http.StatusNotFound, helpers.NewResponseError("Task not found", "error"))
+	if err := h.TaskUsecase.DeleteTaskByID(c.Request().Context(), interfaces.ActorFrom(c), id); err != nil {
+		return err
 	}
 	return c.NoContent(http.StatusNoContent)
 }
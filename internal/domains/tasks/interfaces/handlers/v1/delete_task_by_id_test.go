@@ -2,7 +2,6 @@ package handlers_test
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -11,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
 	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
 	"github.com/supachai1998/task_services/internal/interfaces"
 	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
@@ -30,7 +30,7 @@ func TestDeleteTaskByID(t *testing.T) {
 		taskID := 1
 
 		// Expect the DeleteTaskByID method to be called with the correct ID and return no error
-		mockUsecase.EXPECT().DeleteTaskByID(uint(taskID)).Return(nil)
+		mockUsecase.EXPECT().DeleteTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(nil)
 
 		// Create a new HTTP DELETE request
 		req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+strconv.Itoa(taskID), nil)
@@ -53,7 +53,7 @@ func TestDeleteTaskByID(t *testing.T) {
 
 	t.Run("TaskNotFound", func(t *testing.T) {
 		taskID := 2
-		mockUsecase.EXPECT().DeleteTaskByID(uint(taskID)).Return(errors.New("task not found"))
+		mockUsecase.EXPECT().DeleteTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(domainerrors.NotFound("task not found"))
 
 		req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+strconv.Itoa(taskID), nil)
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
@@ -63,14 +63,14 @@ func TestDeleteTaskByID(t *testing.T) {
 		c.SetParamNames("id")
 		c.SetParamValues(strconv.Itoa(taskID))
 
-		if assert.NoError(t, handler.DeleteTaskByID(c)) {
+		if assert.Error(t, invoke(handler.DeleteTaskByID, c)) {
 			assert.Equal(t, http.StatusNotFound, rec.Code)
 
-			var response models.ResponseError
+			var response models.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
-			assert.Equal(t, "error", response.Status)
-			assert.Equal(t, "Task not found", response.Message)
+			assert.Equal(t, http.StatusNotFound, response.Status)
+			assert.Equal(t, "task not found", response.Detail)
 		}
 	})
 
@@ -84,15 +84,15 @@ func TestDeleteTaskByID(t *testing.T) {
 		c.SetParamValues("invalid")
 
 		// Execute the handler
-		err := handler.DeleteTaskByID(c)
-		assert.NoError(t, err)
+		err := invoke(handler.DeleteTaskByID, c)
+		assert.Error(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 
 		// Verify the response
-		var response models.ResponseError
+		var response models.ProblemDetails
 		err = json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "error", response.Status)
-		assert.Equal(t, "Invalid ID format", response.Message)
+		assert.Equal(t, http.StatusBadRequest, response.Status)
+		assert.Equal(t, "Invalid ID format", response.Detail)
 	})
 }
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfMatch     = "If-Match"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// etag returns the strong entity tag of a task version.
+func etag(version uint) string {
+	return `"` + strconv.FormatUint(uint64(version), 10) + `"`
+}
+
+// taskETag returns the strong entity tag of a task: its version, followed by a hash of its
+// computed fields when it has any, since they change without a new version, such as when a
+// blocker is done, a subtask changes status, a label is renamed or a comment is added.
+func taskETag(task *entities.Task) string {
+	if !task.Blocked && task.Progress == nil && len(task.Labels) == 0 && task.CommentCount == 0 {
+		return etag(task.Version)
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "blocked=%t;comments=%d", task.Blocked, task.CommentCount)
+	if task.Progress != nil {
+		fmt.Fprintf(h, ";progress=%d/%d", task.Progress.Done, task.Progress.Total)
+	}
+	for _, label := range task.Labels {
+		fmt.Fprintf(h, ";label=%d:%s:%s", label.Id, label.Name, label.Color)
+	}
+	return fmt.Sprintf(`"%d-%x"`, task.Version, h.Sum64())
+}
+
+// setETag writes the ETag header.
+func setETag(c echo.Context, tag string) {
+	c.Response().Header().Set(headerETag, tag)
+}
+
+// parseIfMatch returns the task versions listed in the If-Match header.
+// It returns nil when the header is absent or "*", meaning the update is unconditional.
+// Only the versions are compared: a tag whose hash of computed fields is stale still matches,
+// as the computed fields come from other tasks and resources that the update does not overwrite.
+func parseIfMatch(c echo.Context) ([]uint, error) {
+	header := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	versions := []uint{}
+	for _, tag := range strings.Split(header, ",") {
+		// If-Match uses the strong comparison, weak tags never match.
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		version, ok := parseETag(tag)
+		if ok {
+			versions = append(versions, version)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, domainerrors.PreconditionFailed("If-Match does not match any task version")
+	}
+	return versions, nil
+}
+
+// matchIfNoneMatch reports whether the If-None-Match header matches the entity tag of a task.
+func matchIfNoneMatch(c echo.Context, current string) bool {
+	header := strings.TrimSpace(c.Request().Header.Get(headerIfNoneMatch))
+	if header == "" {
+		return false
+	}
+	if header == "*" {
+		return true
+	}
+	for _, tag := range strings.Split(header, ",") {
+		// If-None-Match uses the weak comparison.
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == current {
+			return true
+		}
+	}
+	return false
+}
+
+// parseETag returns the version of an entity tag. The hash of the computed fields is ignored:
+// If-Match guards the updates of the task, which do not change them.
+func parseETag(tag string) (uint, bool) {
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+	version, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+	parsed, err := strconv.ParseUint(version, 10, 0)
+	if err != nil {
+		return 0, false
+	}
+	return uint(parsed), true
+}
@@ -2,10 +2,10 @@ package handlers
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
 )
 
 // GetTaskByID retrieves a task by its ID
@@ -15,18 +15,31 @@ import (
 // @Accept json
 // @Produce json
 // @Param id path int true "Task ID"
+// @Param If-None-Match header string false "ETag of a cached copy of the task"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
 // @Success 200 {object} models.ResponseSuccess{data=entities.Task} "Task found successfully"
-// @Failure 400 {object} models.ResponseError "Invalid ID format"
-// @Failure 404 {object} models.ResponseError "Task not found"
+// @Header 200 {string} ETag "Version of the task, with a hash of its computed fields"
+// @Success 304 "Task not modified"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/tasks/{id} [get]
 func (h *Handler) GetTaskByID(c echo.Context) error {
-	id, err := strconv.Atoi(c.Param("id"))
+	id, err := parseTaskID(c)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError("Invalid ID format", "error"))
+		return err
 	}
-	task, err := h.TaskUsecase.GetTaskByID(uint(id))
+	task, err := h.TaskUsecase.GetTaskByID(c.Request().Context(), interfaces.ActorFrom(c), id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, helpers.NewResponseError("Task not found", "error"))
+		return err
+	}
+	tag := taskETag(task)
+	setETag(c, tag)
+	if matchIfNoneMatch(c, tag) {
+		return c.NoContent(http.StatusNotModified)
 	}
 	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Task found", task))
 }
@@ -1,8 +1,8 @@
 package handlers_test
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -11,8 +11,10 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
 	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
 	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
 	"github.com/supachai1998/task_services/internal/interfaces"
 	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
 	"github.com/supachai1998/task_services/internal/models"
@@ -34,10 +36,11 @@ func TestGetTaskByID(t *testing.T) {
 			Title:       "Test Task",
 			Description: "This is a test task",
 			Status:      entities.TaskStatusDone,
+			Version:     4,
 		}
 
 		// Expect the GetTaskByID to be called with the correct ID and return the expected task without error
-		mockUsecase.EXPECT().GetTaskByID(uint(taskID)).Return(expectedTask, nil)
+		mockUsecase.EXPECT().GetTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(expectedTask, nil)
 
 		// Create a new HTTP GET request
 		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+strconv.Itoa(taskID), nil)
@@ -70,14 +73,120 @@ func TestGetTaskByID(t *testing.T) {
 			assert.Equal(t, expectedTask.Title, taskResponse.Title)
 			assert.Equal(t, expectedTask.Description, taskResponse.Description)
 			assert.Equal(t, expectedTask.Status, taskResponse.Status)
+			assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
 		}
 	})
 
+	t.Run("NotModified", func(t *testing.T) {
+		taskID := 3
+		expectedTask := &entities.Task{
+			Id:          uint(taskID),
+			Title:       "Cached Task",
+			Description: "This task is cached by the client",
+			Status:      entities.TaskStatusToDo,
+			Version:     2,
+		}
+
+		// Expect the GetTaskByID to be called with the correct ID and return the expected task without error
+		mockUsecase.EXPECT().GetTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(expectedTask, nil)
+
+		// Create a new HTTP GET request with the ETag of the cached copy
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+strconv.Itoa(taskID), nil)
+		req.Header.Set("If-None-Match", `"1", W/"2"`)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		// Invoke the handler
+		if assert.NoError(t, handler.GetTaskByID(c)) {
+			// Assert the HTTP status code, the ETag and the empty body
+			assert.Equal(t, http.StatusNotModified, rec.Code)
+			assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+			assert.Empty(t, rec.Body.String())
+		}
+	})
+
+	t.Run("Modified_ComputedFields", func(t *testing.T) {
+		taskID := 3
+		newContext := func(ifNoneMatch string) (echo.Context, *httptest.ResponseRecorder) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+strconv.Itoa(taskID), nil)
+			req.Header.Set("If-None-Match", ifNoneMatch)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/tasks/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(strconv.Itoa(taskID))
+			return c, rec
+		}
+		cached := &entities.Task{Id: uint(taskID), Version: 2, Blocked: true}
+		// The blocker is done, which does not change the version
+		current := &entities.Task{Id: uint(taskID), Version: 2}
+
+		mockUsecase.EXPECT().GetTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(cached, nil)
+		c, rec := newContext("")
+		assert.NoError(t, handler.GetTaskByID(c))
+		cachedETag := rec.Header().Get("ETag")
+		assert.Regexp(t, `^"2-[0-9a-f]+"$`, cachedETag)
+
+		mockUsecase.EXPECT().GetTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(cached, nil)
+		c, rec = newContext(cachedETag)
+		if assert.NoError(t, handler.GetTaskByID(c)) {
+			assert.Equal(t, http.StatusNotModified, rec.Code)
+		}
+
+		mockUsecase.EXPECT().GetTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(current, nil)
+		c, rec = newContext(cachedETag)
+		if assert.NoError(t, handler.GetTaskByID(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		}
+	})
+
+	t.Run("Modified_WithoutNewVersion", func(t *testing.T) {
+		taskID := 5
+		getETag := func(task *entities.Task) string {
+			mockUsecase.EXPECT().GetTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(task, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/tasks/"+strconv.Itoa(taskID), nil), rec)
+			c.SetPath("/v1/tasks/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(strconv.Itoa(taskID))
+			assert.NoError(t, handler.GetTaskByID(c))
+			return rec.Header().Get("ETag")
+		}
+		bug := entities.Label{Id: 1, Name: "bug", Color: "#d73a4a"}
+		base := entities.Task{Id: uint(taskID), Version: 7, Labels: []entities.Label{bug}, CommentCount: 1}
+		progress := entities.NewTaskProgress(2, 1)
+		base.Progress = &progress
+		baseETag := getETag(&base)
+
+		// None of these changes bumps the version of the task
+		for name, change := range map[string]func(task *entities.Task){
+			"LabelAdded": func(task *entities.Task) {
+				task.Labels = append(task.Labels, entities.Label{Id: 2, Name: "ui", Color: "#0e8a16"})
+			},
+			"LabelRemoved":  func(task *entities.Task) { task.Labels = []entities.Label{} },
+			"LabelRenamed":  func(task *entities.Task) { task.Labels = []entities.Label{{Id: 1, Name: "defect", Color: "#d73a4a"}} },
+			"SubtaskDone":   func(task *entities.Task) { p := entities.NewTaskProgress(2, 2); task.Progress = &p },
+			"CommentAdded":  func(task *entities.Task) { task.CommentCount++ },
+			"BlockerAdded":  func(task *entities.Task) { task.Blocked = true },
+			"SubtasksMoved": func(task *entities.Task) { task.Progress = nil },
+		} {
+			changed := base
+			changed.Labels = append([]entities.Label{}, base.Labels...)
+			change(&changed)
+			assert.NotEqual(t, baseETag, getETag(&changed), name)
+		}
+		assert.Equal(t, baseETag, getETag(&base))
+	})
+
 	t.Run("TaskNotFound", func(t *testing.T) {
 		taskID := 2
 
 		// Expect the GetTaskByID to be called with the correct ID and return an error
-		mockUsecase.EXPECT().GetTaskByID(uint(taskID)).Return(nil, errors.New("task not found"))
+		mockUsecase.EXPECT().GetTaskByID(gomock.Any(), anonymous, uint(taskID)).Return(nil, domainerrors.NotFound("task not found"))
 
 		// Create a new HTTP GET request
 		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+strconv.Itoa(taskID), nil)
@@ -89,21 +198,40 @@ func TestGetTaskByID(t *testing.T) {
 		c.SetParamValues(strconv.Itoa(taskID))
 
 		// Invoke the handler
-		if assert.NoError(t, handler.GetTaskByID(c)) {
+		if assert.Error(t, invoke(handler.GetTaskByID, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusNotFound, rec.Code)
 
 			// Unmarshal the response body
-			var response models.ResponseError
+			var response models.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Equal(t, "Task not found", response.Message)
+			assert.Equal(t, http.StatusNotFound, response.Status)
+			assert.Equal(t, "task not found", response.Detail)
 		}
 	})
 
+	t.Run("PassesRequestContext", func(t *testing.T) {
+		// The usecase gets the request context, carrying the request ID and cancellation
+		ctx, cancel := context.WithCancel(helpers.ContextWithRequestID(context.Background(), "request-1"))
+		defer cancel()
+		mockUsecase.EXPECT().GetTaskByID(ctx, anonymous, uint(5)).DoAndReturn(func(ctx context.Context, _ entities.Actor, _ uint) (*entities.Task, error) {
+			assert.Equal(t, "request-1", helpers.RequestIDFromContext(ctx))
+			return &entities.Task{Id: 5, Version: 1}, nil
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/5", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id")
+		c.SetParamNames("id")
+		c.SetParamValues("5")
+
+		assert.NoError(t, handler.GetTaskByID(c))
+	})
+
 	t.Run("InvalidID", func(t *testing.T) {
 		invalidID := "abc"
 
@@ -117,15 +245,15 @@ func TestGetTaskByID(t *testing.T) {
 		c.SetParamValues(invalidID)
 
 		// Invoke the handler
-		err := handler.GetTaskByID(c)
-		assert.NoError(t, err)
+		err := invoke(handler.GetTaskByID, c)
+		assert.Error(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 
 		// Verify the response
-		var response models.ResponseError
+		var response models.ProblemDetails
 		err = json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "error", response.Status)
-		assert.Equal(t, "Invalid ID format", response.Message)
+		assert.Equal(t, http.StatusBadRequest, response.Status)
+		assert.Equal(t, "Invalid ID format", response.Detail)
 	})
 }
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// GetTaskTree retrieves a task with its subtasks
+// @Summary Retrieve the tree of a task
+// @Description Get a task and its subtasks, nested in children, down to depth levels. Every task carries the progress of its subtasks.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param depth query int false "Number of subtask levels" minimum(1) maximum(10) default(3)
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=models.TaskTree} "Task tree found successfully"
+// @Failure 400 {object} models.ProblemDetails "Invalid query or tree too large for the depth"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/tree [get]
+func (h *Handler) GetTaskTree(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	query := new(models.GetTaskTreeQuery)
+	if err := c.Bind(query); err != nil {
+		return err
+	}
+	if err := c.Validate(query); err != nil {
+		return err
+	}
+
+	tree, err := h.TaskUsecase.GetTaskTree(c.Request().Context(), interfaces.ActorFrom(c), id, query)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Task tree found", tree))
+}
@@ -0,0 +1,75 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	taskModels "github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestGetTaskTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, rawQuery string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+id+"/tree?"+rawQuery, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/tree")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		progress := entities.NewTaskProgress(1, 0)
+		tree := &taskModels.TaskTree{
+			Task: entities.Task{Id: 1, Title: "Release", Progress: &progress},
+			Children: []taskModels.TaskTree{
+				{Task: entities.Task{Id: 2, Title: "Write the notes", ParentId: lo.ToPtr(uint(1))}, Children: []taskModels.TaskTree{}},
+			},
+		}
+		mockUsecase.EXPECT().GetTaskTree(gomock.Any(), anonymous, uint(1), &taskModels.GetTaskTreeQuery{Depth: 2}).Return(tree, nil)
+
+		c, rec := newContext("1", "depth=2")
+		if assert.NoError(t, handler.GetTaskTree(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response struct {
+				models.ResponseSuccess
+				Data taskModels.TaskTree `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "Task tree found", response.Message)
+			assert.Equal(t, uint(1), response.Data.Id)
+			assert.Equal(t, 0, response.Data.Progress.Percent)
+			if assert.Len(t, response.Data.Children, 1) {
+				assert.Equal(t, uint(2), response.Data.Children[0].Id)
+				assert.Empty(t, response.Data.Children[0].Children)
+			}
+		}
+	})
+
+	t.Run("BadRequest_DepthTooLarge", func(t *testing.T) {
+		c, rec := newContext("1", "depth=11")
+		if assert.Error(t, invoke(handler.GetTaskTree, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -1,8 +1,13 @@
 package handlers
 
 import (
+	"strconv"
+
 	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
 	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
 )
 
 type Handler struct {
@@ -13,10 +18,56 @@ func NewTaskHandler(e *echo.Echo, taskUsecase usecases.TaskUsecase) {
 	handler := &Handler{
 		TaskUsecase: taskUsecase,
 	}
-	e.POST("/v1/tasks", handler.CreateTask)
-	e.GET("/v1/tasks/:id", handler.GetTaskByID)
-	e.PUT("/v1/tasks/:id", handler.UpdateTask)
-	e.PATCH("/v1/tasks/:id/status", handler.UpdateTaskStatus)
-	e.DELETE("/v1/tasks/:id", handler.DeleteTaskByID)
-	e.GET("/v1/tasks", handler.ListTasks)
+	// The workspace role and the API key scope each route requires; the usecases check the rest,
+	// such as ownership and the role of each status transition.
+	read := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleViewer),
+		interfaces.RequireScope(entities.ScopeTasksRead),
+	}
+	write := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleMember),
+		interfaces.RequireScope(entities.ScopeTasksWrite),
+	}
+	remove := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleMember),
+		interfaces.RequireScope(entities.ScopeTasksDelete),
+	}
+	restore := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleAdmin),
+		interfaces.RequireScope(entities.ScopeTasksWrite),
+	}
+
+	e.POST("/v1/tasks", handler.CreateTask, write...)
+	e.POST("/v1/tasks\\:batch", handler.BatchTasks, write...)
+	e.GET("/v1/tasks/:id", handler.GetTaskByID, read...)
+	e.PUT("/v1/tasks/:id", handler.UpdateTask, write...)
+	e.PATCH("/v1/tasks/:id/status", handler.UpdateTaskStatus, write...)
+	e.PATCH("/v1/tasks/:id/assignee", handler.UpdateTaskAssignee, write...)
+	e.PATCH("/v1/tasks/:id/parent", handler.UpdateTaskParent, write...)
+	e.DELETE("/v1/tasks/:id", handler.DeleteTaskByID, remove...)
+	e.POST("/v1/tasks/:id/restore", handler.RestoreTask, restore...)
+	e.GET("/v1/tasks", handler.ListTasks, read...)
+	e.GET("/v1/tasks/search", handler.SearchTasks, read...)
+	e.GET("/v1/tasks/:id/history", handler.ListTaskHistory, read...)
+	e.GET("/v1/tasks/:id/children", handler.ListTaskChildren, read...)
+	e.GET("/v1/tasks/:id/tree", handler.GetTaskTree, read...)
+	e.GET("/v1/tasks/:id/dependencies", handler.ListTaskDependencies, read...)
+	e.POST("/v1/tasks/:id/dependencies", handler.AddTaskDependency, write...)
+	e.DELETE("/v1/tasks/:id/dependencies/:blocker_id", handler.RemoveTaskDependency, write...)
+	e.POST("/v1/tasks/:id/labels", handler.AddTaskLabel, write...)
+	e.DELETE("/v1/tasks/:id/labels/:label_id", handler.RemoveTaskLabel, write...)
+}
+
+// parseTaskID reads the task ID path parameter.
+func parseTaskID(c echo.Context) (uint, error) {
+	return parseIDParam(c, "id")
+}
+
+// parseIDParam reads an ID path parameter named name.
+func parseIDParam(c echo.Context, name string) (uint, error) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 0)
+	if err != nil {
+		return 0, domainerrors.Validation("Invalid ID format")
+	}
+	return uint(id), nil
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
 	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
 )
 
@@ -26,11 +28,24 @@ func TestNewTaskHandler(t *testing.T) {
 		Path   string
 	}{
 		{"POST", "/v1/tasks"},
+		{"POST", "/v1/tasks\\:batch"},
 		{"GET", "/v1/tasks/:id"},
 		{"PUT", "/v1/tasks/:id"},
 		{"PATCH", "/v1/tasks/:id/status"},
+		{"PATCH", "/v1/tasks/:id/assignee"},
+		{"PATCH", "/v1/tasks/:id/parent"},
 		{"DELETE", "/v1/tasks/:id"},
+		{"POST", "/v1/tasks/:id/restore"},
 		{"GET", "/v1/tasks"},
+		{"GET", "/v1/tasks/search"},
+		{"GET", "/v1/tasks/:id/history"},
+		{"GET", "/v1/tasks/:id/children"},
+		{"GET", "/v1/tasks/:id/tree"},
+		{"GET", "/v1/tasks/:id/dependencies"},
+		{"POST", "/v1/tasks/:id/dependencies"},
+		{"DELETE", "/v1/tasks/:id/dependencies/:blocker_id"},
+		{"POST", "/v1/tasks/:id/labels"},
+		{"DELETE", "/v1/tasks/:id/labels/:label_id"},
 	}
 
 	for _, er := range expectedRoutes {
@@ -44,3 +59,15 @@ func TestNewTaskHandler(t *testing.T) {
 		assert.True(t, found, "Route not registered: %s %s", er.Method, er.Path)
 	}
 }
+
+// anonymous is the actor of requests without an X-Actor header.
+var anonymous = entities.Actor{Name: "anonymous"}
+
+// invoke runs a handler and, like Echo, writes a returned error through the central error handler.
+func invoke(handler echo.HandlerFunc, c echo.Context) error {
+	err := handler(c)
+	if err != nil {
+		interfaces.HTTPErrorHandler(err, c)
+	}
+	return err
+}
@@ -0,0 +1,85 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	taskModels "github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestListTaskChildren(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, rawQuery string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+id+"/children?"+rawQuery, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/children")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		progress := entities.NewTaskProgress(2, 1)
+		children := []entities.Task{
+			{Id: 2, Title: "Write the tests", ParentId: lo.ToPtr(uint(1)), Progress: &progress},
+			{Id: 5, Title: "Review", ParentId: lo.ToPtr(uint(1))},
+		}
+		nextCursor := helpers.EncodeCursor("", 5)
+		mockUsecase.EXPECT().ListTaskChildren(gomock.Any(), anonymous, uint(1), &taskModels.ListTaskChildrenQuery{Limit: 2}).Return(children, nextCursor, nil)
+
+		c, rec := newContext("1", "limit=2")
+		if assert.NoError(t, handler.ListTaskChildren(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response struct {
+				models.ResponsePaginated
+				Data []entities.Task `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "Subtasks listed", response.Message)
+			assert.Equal(t, nextCursor, response.NextCursor)
+			if assert.Len(t, response.Data, 2) {
+				assert.Equal(t, uint(1), *response.Data[0].ParentId)
+				assert.Equal(t, 50, response.Data[0].Progress.Percent)
+				assert.Nil(t, response.Data[1].Progress)
+			}
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().ListTaskChildren(gomock.Any(), anonymous, uint(9), gomock.Any()).Return(nil, "", domainerrors.NotFound("task not found"))
+
+		c, rec := newContext("9", "")
+		if assert.Error(t, invoke(handler.ListTaskChildren, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidLimit", func(t *testing.T) {
+		c, rec := newContext("1", "limit=500")
+		if assert.Error(t, invoke(handler.ListTaskChildren, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestListTaskDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+id+"/dependencies", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/dependencies")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		dependencies := []entities.TaskDependency{{BlockerId: 12, BlockedId: 15}, {BlockerId: 15, BlockedId: 20}}
+		mockUsecase.EXPECT().ListTaskDependencies(gomock.Any(), anonymous, uint(15)).Return(dependencies, nil)
+
+		c, rec := newContext("15")
+		if assert.NoError(t, handler.ListTaskDependencies(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response struct {
+				models.ResponseSuccess
+				Data []entities.TaskDependency `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "Dependencies listed", response.Message)
+			assert.Len(t, response.Data, 2)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().ListTaskDependencies(gomock.Any(), anonymous, uint(99)).Return(nil, domainerrors.NotFound("task not found"))
+
+		c, rec := newContext("99")
+		if assert.Error(t, invoke(handler.ListTaskDependencies, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// ListTaskHistory lists the audit trail of a task
+// @Summary List the history of a task
+// @Description List every create, update, status change and delete of a task in chronological order
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param limit query int false "Page size" minimum(1) maximum(100) default(20)
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponsePaginated{data=[]entities.TaskEvent} "Task history listed successfully"
+// @Failure 400 {object} models.ProblemDetails "Invalid query"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/history [get]
+func (h *Handler) ListTaskHistory(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	query := new(models.ListTaskHistoryQuery)
+	if err := c.Bind(query); err != nil {
+		return err
+	}
+	if err := c.Validate(query); err != nil {
+		return err
+	}
+
+	events, nextCursor, err := h.TaskUsecase.ListTaskHistory(c.Request().Context(), interfaces.ActorFrom(c), id, query)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponsePaginated("Task history listed", events, nextCursor))
+}
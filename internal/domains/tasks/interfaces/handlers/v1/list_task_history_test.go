@@ -0,0 +1,128 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	taskModels "github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestListTaskHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	t.Run("Success", func(t *testing.T) {
+		taskID := 1
+		expectedEvents := []entities.TaskEvent{
+			{
+				Id:        10,
+				TaskId:    uint(taskID),
+				Action:    entities.TaskEventStatusChanged,
+				Changes:   entities.JSON(`{"status":{"old":"TO_DO","new":"DONE"}}`),
+				Actor:     "alice",
+				RequestId: "task-services-1",
+				CreatedAt: time.Date(2024, 10, 1, 9, 0, 0, 0, time.UTC),
+			},
+		}
+		nextCursor := helpers.EncodeCursor("", 10)
+
+		// Expect the ListTaskHistory method to be called with the task ID and the page query
+		mockUsecase.EXPECT().ListTaskHistory(gomock.Any(), anonymous, uint(taskID), &taskModels.ListTaskHistoryQuery{Limit: 1}).Return(expectedEvents, nextCursor, nil)
+
+		// Create a new HTTP GET request
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+strconv.Itoa(taskID)+"/history?limit=1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/history")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		// Invoke the handler
+		if assert.NoError(t, handler.ListTaskHistory(c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			// Unmarshal the response body
+			var response struct {
+				models.ResponsePaginated
+				Data []entities.TaskEvent `json:"data"`
+			}
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			// Assert the response message, data and next cursor
+			assert.Equal(t, "Task history listed", response.Message)
+			assert.Equal(t, nextCursor, response.NextCursor)
+			if assert.Len(t, response.Data, 1) {
+				assert.Equal(t, entities.TaskEventStatusChanged, response.Data[0].Action)
+				assert.Equal(t, "alice", response.Data[0].Actor)
+				assert.JSONEq(t, string(expectedEvents[0].Changes), string(response.Data[0].Changes))
+			}
+		}
+	})
+
+	t.Run("TaskNotFound", func(t *testing.T) {
+		taskID := 2
+
+		// Expect the ListTaskHistory method to return a not found error
+		mockUsecase.EXPECT().ListTaskHistory(gomock.Any(), anonymous, uint(taskID), &taskModels.ListTaskHistoryQuery{}).Return(nil, "", domainerrors.NotFound("task not found"))
+
+		// Create a new HTTP GET request
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+strconv.Itoa(taskID)+"/history", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/history")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		// Invoke the handler
+		if assert.Error(t, invoke(handler.ListTaskHistory, c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+
+			// Unmarshal the response body
+			var response models.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			// Assert the response status and message
+			assert.Equal(t, http.StatusNotFound, response.Status)
+			assert.Equal(t, "task not found", response.Detail)
+		}
+	})
+
+	t.Run("BadRequest_ValidationFailure", func(t *testing.T) {
+		// Create a new HTTP GET request with a page size over the maximum
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/3/history?limit=1000", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/history")
+		c.SetParamNames("id")
+		c.SetParamValues("3")
+
+		// Invoke the handler
+		if assert.Error(t, invoke(handler.ListTaskHistory, c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
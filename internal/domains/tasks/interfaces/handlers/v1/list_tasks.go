@@ -4,22 +4,53 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
 )
 
 // ListTasks handles task listing
-// @Summary List all tasks
-// @Description List all tasks
+// @Summary List tasks
+// @Description List tasks with optional status filter, title/description search, sorting and cursor pagination. Priorities sort from LOW to URGENT and tasks without a due date sort after the others.
 // @Tags tasks
 // @Accept json
 // @Produce json
-// @Success 200 {object} models.ResponseSuccess{data=[]entities.Task} "Tasks listed successfully"
-// @Failure 500 {object} models.ResponseError "Internal server error"
+// @Param status query []string false "Filter by status" collectionFormat(multi) Enums(TO_DO,IN_PROGRESS,DONE)
+// @Param q query string false "Substring search on title and description"
+// @Param created_after query string false "Only tasks created after this RFC 3339 time" format(date-time)
+// @Param updated_before query string false "Only tasks last updated before this RFC 3339 time" format(date-time)
+// @Param owner query string false "Only the tasks created by this user, or \"me\""
+// @Param assignee query string false "Only the tasks assigned to this user, or \"me\""
+// @Param overdue query bool false "Only the tasks that are not DONE and were due before now"
+// @Param label query []string false "Only the tasks with these labels, by name ignoring case" collectionFormat(multi)
+// @Param label_match query string false "Whether a task needs any or all of the labels" Enums(any,all) default(any)
+// @Param include_deleted query bool false "Also list soft-deleted tasks; admin only"
+// @Param X-Admin-Key header string false "Admin key"
+// @Param sort query string false "Sort field" Enums(id,title,status,priority,due_at,created_at,updated_at) default(id)
+// @Param order query string false "Sort direction" Enums(asc,desc) default(asc)
+// @Param limit query int false "Page size" minimum(1) maximum(100) default(20)
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponsePaginated{data=[]entities.Task} "Tasks listed successfully"
+// @Failure 400 {object} models.ProblemDetails "Invalid query"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or include_deleted without admin privileges"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/tasks [get]
 func (h *Handler) ListTasks(c echo.Context) error {
-	tasks, err := h.TaskUsecase.ListTasks()
+	query := new(models.ListTasksQuery)
+	if err := c.Bind(query); err != nil {
+		return err
+	}
+	if err := c.Validate(query); err != nil {
+		return err
+	}
+
+	tasks, nextCursor, err := h.TaskUsecase.ListTasks(c.Request().Context(), interfaces.ActorFrom(c), query)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, helpers.NewResponseError(err.Error(), "error"))
+		return err
 	}
-	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Tasks listed", tasks))
+	return c.JSON(http.StatusOK, helpers.NewResponsePaginated("Tasks listed", tasks, nextCursor))
 }
@@ -6,12 +6,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
 	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	taskModels "github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
 	"github.com/supachai1998/task_services/internal/interfaces"
 	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
 	"github.com/supachai1998/task_services/internal/models"
@@ -43,8 +47,8 @@ func TestListTasks(t *testing.T) {
 			},
 		}
 
-		// Expect the ListTasks method to be called and return the expected tasks without error
-		mockUsecase.EXPECT().ListTasks().Return(expectedTasks, nil)
+		// Expect the ListTasks method to be called with an empty query and return the expected tasks without error
+		mockUsecase.EXPECT().ListTasks(gomock.Any(), anonymous, &taskModels.ListTasksQuery{}).Return(expectedTasks, "", nil)
 
 		// Create a new HTTP GET request
 		req := httptest.NewRequest(http.MethodGet, "/v1/tasks", nil)
@@ -59,7 +63,7 @@ func TestListTasks(t *testing.T) {
 			assert.Equal(t, http.StatusOK, rec.Code)
 
 			// Unmarshal the response body
-			var response models.ResponseSuccess
+			var response models.ResponsePaginated
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
@@ -74,6 +78,183 @@ func TestListTasks(t *testing.T) {
 			// Assert the response message and data
 			assert.Equal(t, "Tasks listed", response.Message)
 			assert.Equal(t, expectedTasks, tasksResponse)
+			assert.Empty(t, response.NextCursor)
+		}
+	})
+
+	t.Run("Success_WithQuery", func(t *testing.T) {
+		expectedTasks := []entities.Task{
+			{
+				Id:          3,
+				Title:       "Task Three",
+				Description: "Third task description",
+				Status:      entities.TaskStatusToDo,
+			},
+		}
+		expectedQuery := &taskModels.ListTasksQuery{
+			Status: []entities.TaskStatus{entities.TaskStatusToDo, entities.TaskStatusDone},
+			Search: "three",
+			Sort:   "title",
+			Order:  "desc",
+			Limit:  1,
+		}
+		nextCursor := helpers.EncodeCursor("Task Three", 3)
+
+		// Expect the ListTasks method to be called with the bound query and return a next cursor
+		mockUsecase.EXPECT().ListTasks(gomock.Any(), anonymous, expectedQuery).Return(expectedTasks, nextCursor, nil)
+
+		// Create a new HTTP GET request with filter, sort and pagination params
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks?status=TO_DO&status=DONE&q=three&sort=title&order=desc&limit=1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks")
+
+		// Invoke the handler
+		if assert.NoError(t, handler.ListTasks(c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			// Unmarshal the response body
+			var response models.ResponsePaginated
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			// Assert the next cursor is returned in the envelope
+			assert.Equal(t, nextCursor, response.NextCursor)
+		}
+	})
+
+	t.Run("Success_WithTimeFiltersAndDeleted", func(t *testing.T) {
+		createdAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		updatedBefore := time.Date(2024, 6, 1, 12, 30, 0, 0, time.UTC)
+		expectedQuery := &taskModels.ListTasksQuery{
+			CreatedAfter:   &createdAfter,
+			UpdatedBefore:  &updatedBefore,
+			IncludeDeleted: true,
+			Sort:           "updated_at",
+		}
+		admin := entities.Actor{Name: "anonymous", Admin: true}
+
+		// Expect the ListTasks method to be called by an admin with the bound time filters
+		mockUsecase.EXPECT().ListTasks(gomock.Any(), admin, expectedQuery).Return([]entities.Task{}, "", nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks?created_after=2024-01-01T00:00:00Z&updated_before=2024-06-01T12:30:00Z&include_deleted=true&sort=updated_at", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks")
+		c.Set(interfaces.ContextKeyAdmin, true)
+
+		if assert.NoError(t, handler.ListTasks(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("Success_OverdueByDueDate", func(t *testing.T) {
+		expectedQuery := &taskModels.ListTasksQuery{Overdue: true, Sort: "due_at"}
+		mockUsecase.EXPECT().ListTasks(gomock.Any(), anonymous, expectedQuery).Return([]entities.Task{}, "", nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks?overdue=true&sort=due_at", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks")
+
+		if assert.NoError(t, handler.ListTasks(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("Success_AllLabels", func(t *testing.T) {
+		expectedQuery := &taskModels.ListTasksQuery{Label: []string{"bug", "ui"}, LabelMatch: "all"}
+		mockUsecase.EXPECT().ListTasks(gomock.Any(), anonymous, expectedQuery).Return([]entities.Task{}, "", nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks?label=bug&label=ui&label_match=all", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks")
+
+		if assert.NoError(t, handler.ListTasks(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_LabelMatch", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks?label=bug&label_match=some", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks")
+
+		if assert.Error(t, invoke(handler.ListTasks, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("Forbidden_IncludeDeleted", func(t *testing.T) {
+		expectedQuery := &taskModels.ListTasksQuery{IncludeDeleted: true}
+		mockUsecase.EXPECT().ListTasks(gomock.Any(), anonymous, expectedQuery).Return(nil, "", domainerrors.Forbidden("admin privileges required"))
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks?include_deleted=true", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks")
+
+		if assert.Error(t, invoke(handler.ListTasks, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+
+			var response models.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "admin privileges required", response.Detail)
+		}
+	})
+
+	t.Run("BadRequest_ValidationFailure", func(t *testing.T) {
+		// Create a new HTTP GET request with an unknown status and sort field
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks?status=UNKNOWN&sort=assignee", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks")
+
+		// Invoke the handler
+		if assert.Error(t, invoke(handler.ListTasks, c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			// Unmarshal the response body
+			var response models.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			// Assert the response status and message
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Contains(t, response.Detail, "invalid input")
+		}
+	})
+
+	t.Run("BadRequest_InvalidCursor", func(t *testing.T) {
+		expectedQuery := &taskModels.ListTasksQuery{Cursor: "not-a-cursor"}
+
+		// Expect the ListTasks method to reject the cursor
+		mockUsecase.EXPECT().ListTasks(gomock.Any(), anonymous, expectedQuery).Return(nil, "", helpers.ErrInvalidCursor)
+
+		// Create a new HTTP GET request with a malformed cursor
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks?cursor=not-a-cursor", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks")
+
+		// Invoke the handler
+		if assert.Error(t, invoke(handler.ListTasks, c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			// Unmarshal the response body
+			var response models.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			// Assert the response status and message
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Equal(t, helpers.ErrInvalidCursor.Error(), response.Detail)
 		}
 	})
 
@@ -82,7 +263,7 @@ func TestListTasks(t *testing.T) {
 		usecaseError := errors.New("database connection failed")
 
 		// Expect the ListTasks method to be called and return an error
-		mockUsecase.EXPECT().ListTasks().Return(nil, usecaseError)
+		mockUsecase.EXPECT().ListTasks(gomock.Any(), anonymous, &taskModels.ListTasksQuery{}).Return(nil, "", usecaseError)
 
 		// Create a new HTTP GET request
 		req := httptest.NewRequest(http.MethodGet, "/v1/tasks", nil)
@@ -92,18 +273,19 @@ func TestListTasks(t *testing.T) {
 		c.SetPath("/v1/tasks")
 
 		// Invoke the handler
-		if assert.NoError(t, handler.ListTasks(c)) {
+		if assert.Error(t, invoke(handler.ListTasks, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusInternalServerError, rec.Code)
 
 			// Unmarshal the response body
-			var response models.ResponseError
+			var response models.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Equal(t, "database connection failed", response.Message)
+			assert.Equal(t, http.StatusInternalServerError, response.Status)
+			// Internal errors are not leaked to the client
+			assert.Equal(t, "internal server error", response.Detail)
 		}
 	})
 }
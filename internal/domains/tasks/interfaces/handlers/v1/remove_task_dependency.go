@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// RemoveTaskDependency removes a dependency of a task
+// @Summary Remove a dependency
+// @Description Delete the dependency of a task on the task blocker_id. Only the owner, the assignee or an admin of the blocked task can.
+// @Tags tasks
+// @Param id path int true "Blocked task ID"
+// @Param blocker_id path int true "Blocker task ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 204 "Dependency removed successfully"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner or the assignee of the task"
+// @Failure 404 {object} models.ProblemDetails "Task or dependency not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/dependencies/{blocker_id} [delete]
+func (h *Handler) RemoveTaskDependency(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	blockerID, err := parseIDParam(c, "blocker_id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.TaskUsecase.RemoveTaskDependency(c.Request().Context(), interfaces.ActorFrom(c), id, blockerID); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,59 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+)
+
+func TestRemoveTaskDependency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id, blockerID string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+id+"/dependencies/"+blockerID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/dependencies/:blocker_id")
+		c.SetParamNames("id", "blocker_id")
+		c.SetParamValues(id, blockerID)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().RemoveTaskDependency(gomock.Any(), anonymous, uint(15), uint(12)).Return(nil)
+
+		c, rec := newContext("15", "12")
+		if assert.NoError(t, handler.RemoveTaskDependency(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().RemoveTaskDependency(gomock.Any(), anonymous, uint(15), uint(13)).
+			Return(domainerrors.NotFound("dependency not found"))
+
+		c, rec := newContext("15", "13")
+		if assert.Error(t, invoke(handler.RemoveTaskDependency, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidBlockerID", func(t *testing.T) {
+		c, rec := newContext("15", "abc")
+		if assert.Error(t, invoke(handler.RemoveTaskDependency, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
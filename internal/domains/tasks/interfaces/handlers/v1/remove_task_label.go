@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// RemoveTaskLabel takes a label off a task
+// @Summary Remove a label from a task
+// @Description Take the label label_id off the task. Only the owner, the assignee or an admin of the task can.
+// @Tags tasks
+// @Param id path int true "Task ID"
+// @Param label_id path int true "Label ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 204 "Label removed successfully"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner or the assignee of the task"
+// @Failure 404 {object} models.ProblemDetails "Task not found or the task does not have the label"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/labels/{label_id} [delete]
+func (h *Handler) RemoveTaskLabel(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	labelID, err := parseIDParam(c, "label_id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.TaskUsecase.RemoveTaskLabel(c.Request().Context(), interfaces.ActorFrom(c), id, labelID); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,59 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+)
+
+func TestRemoveTaskLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id, labelID string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+id+"/labels/"+labelID, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/labels/:label_id")
+		c.SetParamNames("id", "label_id")
+		c.SetParamValues(id, labelID)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().RemoveTaskLabel(gomock.Any(), anonymous, uint(15), uint(7)).Return(nil)
+
+		c, rec := newContext("15", "7")
+		if assert.NoError(t, handler.RemoveTaskLabel(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().RemoveTaskLabel(gomock.Any(), anonymous, uint(15), uint(8)).
+			Return(domainerrors.NotFound("the task does not have this label"))
+
+		c, rec := newContext("15", "8")
+		if assert.Error(t, invoke(handler.RemoveTaskLabel, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidLabelID", func(t *testing.T) {
+		c, rec := newContext("15", "abc")
+		if assert.Error(t, invoke(handler.RemoveTaskLabel, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// RestoreTask undoes the soft delete of a task
+// @Summary Restore a deleted task
+// @Description Undo the soft delete of a task by its unique ID; requires X-Admin-Key or the admin role of the workspace
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param X-Admin-Key header string true "Admin key"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=entities.Task} "Task restored successfully"
+// @Header 200 {string} ETag "Version of the task, with a hash of its computed fields"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Admin privileges required"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 409 {object} models.ProblemDetails "Task is not deleted"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/restore [post]
+func (h *Handler) RestoreTask(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	task, err := h.TaskUsecase.RestoreTask(c.Request().Context(), interfaces.ActorFrom(c), id)
+	if err != nil {
+		return err
+	}
+	setETag(c, taskETag(task))
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Task restored", task))
+}
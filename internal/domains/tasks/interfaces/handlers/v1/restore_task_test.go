@@ -0,0 +1,123 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestRestoreTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+	admin := entities.Actor{Name: "anonymous", Admin: true}
+
+	newContext := func(id string, isAdmin bool) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+id+"/restore", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/restore")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		if isAdmin {
+			c.Set(interfaces.ContextKeyAdmin, true)
+		}
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		restored := &entities.Task{
+			Id:          1,
+			Title:       "Restored task",
+			Description: "Restored task description",
+			Status:      entities.TaskStatusToDo,
+			Version:     3,
+		}
+		mockUsecase.EXPECT().RestoreTask(gomock.Any(), admin, uint(1)).Return(restored, nil)
+
+		c, rec := newContext("1", true)
+		if assert.NoError(t, handler.RestoreTask(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+			var response models.ResponseSuccess
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Task restored", response.Message)
+
+			taskBytes, err := json.Marshal(response.Data)
+			assert.NoError(t, err)
+			var task entities.Task
+			assert.NoError(t, json.Unmarshal(taskBytes, &task))
+			assert.Equal(t, *restored, task)
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockUsecase.EXPECT().RestoreTask(gomock.Any(), anonymous, uint(1)).Return(nil, domainerrors.Forbidden("admin privileges required"))
+
+		c, rec := newContext("1", false)
+		if assert.Error(t, invoke(handler.RestoreTask, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("AdminRoleInToken", func(t *testing.T) {
+		restored := &entities.Task{Id: 1, Title: "Restored task", Status: entities.TaskStatusToDo, Version: 3}
+		mockUsecase.EXPECT().RestoreTask(gomock.Any(), entities.Actor{Name: "alice", UserId: "alice", Admin: true}, uint(1)).Return(restored, nil)
+
+		c, rec := newContext("1", false)
+		c.Request().Header.Set("X-Actor", "mallory")
+		c.Set(auth.ContextKeyPrincipal, &auth.Principal{Subject: "alice", Roles: []string{auth.RoleAdmin}})
+		if assert.NoError(t, handler.RestoreTask(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("NoAdminRoleInToken", func(t *testing.T) {
+		mockUsecase.EXPECT().RestoreTask(gomock.Any(), entities.Actor{Name: "bob", UserId: "bob"}, uint(1)).Return(nil, domainerrors.Forbidden("admin privileges required"))
+
+		c, rec := newContext("1", false)
+		c.Set(auth.ContextKeyPrincipal, &auth.Principal{Subject: "bob"})
+		if assert.Error(t, invoke(handler.RestoreTask, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("NotDeleted", func(t *testing.T) {
+		mockUsecase.EXPECT().RestoreTask(gomock.Any(), admin, uint(2)).Return(nil, domainerrors.Conflict("task is not deleted"))
+
+		c, rec := newContext("2", true)
+		if assert.Error(t, invoke(handler.RestoreTask, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+
+			var response models.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "task is not deleted", response.Detail)
+		}
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		c, rec := newContext("invalid", true)
+		if assert.Error(t, invoke(handler.RestoreTask, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
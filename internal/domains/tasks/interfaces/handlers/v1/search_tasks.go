@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// SearchTasks handles full-text task search
+// @Summary Search tasks
+// @Description Full-text search on title and description, best match first. Every word of q must match a word
+// @Description prefix. Snippets wrap the matching words in <mark> tags and are not otherwise HTML-escaped.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param q query string true "Search words" maxlength(200)
+// @Param limit query int false "Page size" minimum(1) maximum(100) default(20)
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponsePaginated{data=[]models.TaskSearchResult} "Tasks found"
+// @Failure 400 {object} models.ProblemDetails "Invalid query"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/search [get]
+func (h *Handler) SearchTasks(c echo.Context) error {
+	query := new(models.SearchTasksQuery)
+	if err := c.Bind(query); err != nil {
+		return err
+	}
+	if err := c.Validate(query); err != nil {
+		return err
+	}
+
+	results, nextCursor, err := h.TaskUsecase.SearchTasks(c.Request().Context(), interfaces.ActorFrom(c), query)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponsePaginated("Tasks found", results, nextCursor))
+}
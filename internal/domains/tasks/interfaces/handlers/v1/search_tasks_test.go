@@ -0,0 +1,94 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	taskModels "github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestSearchTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	t.Run("Success", func(t *testing.T) {
+		expectedResults := []taskModels.TaskSearchResult{
+			{
+				Task:               entities.Task{Id: 3, Title: "Fix login", Description: "Login fails on Safari", Status: entities.TaskStatusToDo},
+				Rank:               0.5,
+				TitleSnippet:       "Fix <mark>login</mark>",
+				DescriptionSnippet: "<mark>Login</mark> fails on Safari",
+			},
+		}
+		nextCursor := helpers.EncodeCursor("1", 3)
+
+		// Expect the SearchTasks method to be called with the bound query
+		mockUsecase.EXPECT().SearchTasks(gomock.Any(), anonymous, &taskModels.SearchTasksQuery{Query: "log", Limit: 1}).Return(expectedResults, nextCursor, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/search?q=log&limit=1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/search")
+
+		if assert.NoError(t, handler.SearchTasks(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response struct {
+				Message    string                        `json:"message"`
+				Data       []taskModels.TaskSearchResult `json:"data"`
+				NextCursor string                        `json:"next_cursor"`
+			}
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Tasks found", response.Message)
+			assert.Equal(t, expectedResults, response.Data)
+			assert.Equal(t, nextCursor, response.NextCursor)
+		}
+	})
+
+	t.Run("BadRequest_MissingQuery", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/search", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/search")
+
+		if assert.Error(t, invoke(handler.SearchTasks, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_NoWords", func(t *testing.T) {
+		mockUsecase.EXPECT().SearchTasks(gomock.Any(), anonymous, &taskModels.SearchTasksQuery{Query: "&!"}).Return(nil, "", domainerrors.Validation("the search query has no words"))
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/tasks/search?q=%26%21", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/search")
+
+		if assert.Error(t, invoke(handler.SearchTasks, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var response models.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "the search query has no words", response.Detail)
+		}
+	})
+}
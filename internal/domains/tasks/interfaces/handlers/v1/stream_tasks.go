@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+const (
+	defaultHeartbeat          = 15 * time.Second
+	defaultStreamWriteTimeout = 10 * time.Second
+)
+
+// errSubscriptionEnded ends a stream whose subscription ended first, when the subscriber fell behind.
+var errSubscriptionEnded = errors.New("the subscription ended")
+
+// StreamOptions tunes the task streams.
+type StreamOptions struct {
+	// Heartbeat is the interval of the heartbeats keeping idle streams open through proxies,
+	// 15 seconds by default.
+	Heartbeat time.Duration
+	// WriteTimeout bounds every write to a subscriber, 10 seconds by default.
+	WriteTimeout time.Duration
+}
+
+type StreamHandler struct {
+	TaskStream usecases.TaskStream
+	Options    StreamOptions
+}
+
+func NewTaskStreamHandler(e *echo.Echo, taskStream usecases.TaskStream, options StreamOptions) {
+	if options.Heartbeat <= 0 {
+		options.Heartbeat = defaultHeartbeat
+	}
+	if options.WriteTimeout <= 0 {
+		options.WriteTimeout = defaultStreamWriteTimeout
+	}
+	handler := &StreamHandler{
+		TaskStream: taskStream,
+		Options:    options,
+	}
+	read := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleViewer),
+		interfaces.RequireScope(entities.ScopeTasksRead),
+	}
+
+	e.GET("/v1/tasks/stream", handler.StreamTasks, read...)
+	e.GET("/v1/tasks/stream/ws", handler.StreamTasksWebSocket, read...)
+}
+
+// StreamTasks handles the task stream over Server-Sent Events
+// @Summary Stream task changes
+// @Description Stream the changes of the tasks matching the filters as Server-Sent Events, named after the type of the change and with the task event ID as event ID. A change with matches false took its task out of the filters. A Reset event asks the client to list its tasks again, as it missed too many changes to replay them. Comments are sent as heartbeats. The stream ends when the client falls behind; it resumes with the Last-Event-ID header.
+// @Tags tasks
+// @Produce text/event-stream
+// @Param status query []string false "Filter by status" collectionFormat(multi)
+// @Param label query []string false "Only the tasks with these labels, by name ignoring case" collectionFormat(multi)
+// @Param label_match query string false "Whether a task needs any or all of the labels" Enums(any,all) default(any)
+// @Param last_event_id query int false "Replay the changes after this task event ID"
+// @Param Last-Event-ID header int false "Replay the changes after this task event ID, sent by EventSource on reconnection"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.TaskChange "Stream of task changes"
+// @Failure 400 {object} models.ProblemDetails "Invalid query"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/stream [get]
+func (h *StreamHandler) StreamTasks(c echo.Context) error {
+	query, err := bindStreamQuery(c)
+	if err != nil {
+		return err
+	}
+	subscription, err := h.TaskStream.Subscribe(c.Request().Context(), interfaces.ActorFrom(c), query)
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+	conn, rw, err := hijack(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	sse := &sseWriter{conn: conn, w: rw.Writer, timeout: h.Options.WriteTimeout}
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	// Keep reverse proxies such as nginx from buffering the stream.
+	header.Set("X-Accel-Buffering", "no")
+	if err := sse.write(func(w *bufio.Writer) {
+		// The body ends with the connection.
+		fmt.Fprint(w, "HTTP/1.1 200 OK\r\nConnection: close\r\n")
+		_ = header.Write(w)
+		fmt.Fprint(w, "\r\n")
+	}); err != nil {
+		return nil
+	}
+
+	// The client sends nothing more; reading only tells when it goes away.
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+	go func() {
+		_, _ = io.Copy(io.Discard, rw.Reader)
+		cancel()
+	}()
+	_ = h.pump(ctx, subscription, sse)
+	return nil
+}
+
+// StreamTasksWebSocket handles the task stream over WebSocket
+// @Summary Stream task changes over WebSocket
+// @Description Stream the changes of the tasks matching the filters as WebSocket text messages, each a task change. A change with matches false took its task out of the filters. A Reset change asks the client to list its tasks again, as it missed too many changes to replay them. The server pings the client as heartbeats and closes the connection with code 1013 when the client falls behind; it resumes with last_event_id.
+// @Tags tasks
+// @Param status query []string false "Filter by status" collectionFormat(multi)
+// @Param label query []string false "Only the tasks with these labels, by name ignoring case" collectionFormat(multi)
+// @Param label_match query string false "Whether a task needs any or all of the labels" Enums(any,all) default(any)
+// @Param last_event_id query int false "Replay the changes after this task event ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 101 {object} models.TaskChange "Switching to the WebSocket protocol"
+// @Failure 400 {object} models.ProblemDetails "Invalid query or WebSocket handshake"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/stream/ws [get]
+func (h *StreamHandler) StreamTasksWebSocket(c echo.Context) error {
+	key, err := websocketKey(c.Request())
+	if err != nil {
+		return err
+	}
+	query, err := bindStreamQuery(c)
+	if err != nil {
+		return err
+	}
+	subscription, err := h.TaskStream.Subscribe(c.Request().Context(), interfaces.ActorFrom(c), query)
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+	conn, rw, err := hijack(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ws := &webSocket{conn: conn, r: rw.Reader, w: rw.Writer, timeout: h.Options.WriteTimeout}
+	if err := ws.accept(key); err != nil {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+	go func() {
+		// The client answers every ping, so two heartbeats without a frame mean it is gone.
+		ws.readLoop(2*h.Options.Heartbeat + h.Options.WriteTimeout)
+		cancel()
+	}()
+	if err := h.pump(ctx, subscription, ws); errors.Is(err, errSubscriptionEnded) {
+		_ = ws.close(closeTryAgainLater, "resume after the last change")
+	} else {
+		_ = ws.close(closeNormal, "")
+	}
+	return nil
+}
+
+// bindStreamQuery reads the query of a stream and its Last-Event-ID header.
+func bindStreamQuery(c echo.Context) (*models.StreamTasksQuery, error) {
+	query := new(models.StreamTasksQuery)
+	if err := c.Bind(query); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(query); err != nil {
+		return nil, err
+	}
+	if lastEventID := c.Request().Header.Get("Last-Event-ID"); lastEventID != "" {
+		id, err := strconv.ParseUint(lastEventID, 10, 0)
+		if err != nil {
+			return nil, domainerrors.Validation("Invalid Last-Event-ID")
+		}
+		query.LastEventId = uint(id)
+	}
+	return query, nil
+}
+
+// hijack takes the connection of a stream over from the HTTP server, whose write timeout would
+// otherwise end the stream, and clears its deadlines.
+func hijack(c echo.Context) (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := c.Response().Hijack()
+	if err != nil {
+		return nil, nil, domainerrors.Internal(err)
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return conn, rw, nil
+}
+
+// streamWriter writes the messages of a stream to its connection.
+type streamWriter interface {
+	writeChange(change models.TaskChange) error
+	writeHeartbeat() error
+}
+
+// pump writes the replay, then the live changes, with heartbeats in between, until ctx is done,
+// the subscription ends or a write fails.
+func (h *StreamHandler) pump(ctx context.Context, subscription *usecases.Subscription, w streamWriter) error {
+	for _, change := range subscription.Replay {
+		if err := w.writeChange(change); err != nil {
+			return err
+		}
+	}
+	heartbeat := time.NewTicker(h.Options.Heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case change, ok := <-subscription.Changes:
+			if !ok {
+				return errSubscriptionEnded
+			}
+			if subscription.Replayed(change.Id) {
+				continue
+			}
+			if err := w.writeChange(change); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			if err := w.writeHeartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sseWriter writes Server-Sent Events.
+type sseWriter struct {
+	conn    net.Conn
+	w       *bufio.Writer
+	timeout time.Duration
+}
+
+// writeChange writes change as an event named after its type, with its ID unless it is a reset.
+func (s *sseWriter) writeChange(change models.TaskChange) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	return s.write(func(w *bufio.Writer) {
+		if change.Id != 0 {
+			fmt.Fprintf(w, "id: %d\n", change.Id)
+		}
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", change.Type, data)
+	})
+}
+
+// writeHeartbeat writes a comment, which clients ignore.
+func (s *sseWriter) writeHeartbeat() error {
+	return s.write(func(w *bufio.Writer) {
+		fmt.Fprint(w, ": heartbeat\n\n")
+	})
+}
+
+// write writes and flushes a message within the write timeout.
+func (s *sseWriter) write(fn func(w *bufio.Writer)) error {
+	_ = s.conn.SetWriteDeadline(time.Now().Add(s.timeout))
+	fn(s.w)
+	return s.w.Flush()
+}
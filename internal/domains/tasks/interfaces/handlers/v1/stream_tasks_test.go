@@ -0,0 +1,264 @@
+package handlers_test
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	taskModels "github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+)
+
+// newStreamServer serves the task streams of mockStream over a real connection, as they take it over.
+func newStreamServer(t *testing.T, mockStream *mocks.MockTaskStream, options handlers.StreamOptions) *httptest.Server {
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+	e.HTTPErrorHandler = interfaces.HTTPErrorHandler
+	handlers.NewTaskStreamHandler(e, mockStream, options)
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewTaskStreamHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	e := echo.New()
+
+	handlers.NewTaskStreamHandler(e, mocks.NewMockTaskStream(ctrl), handlers.StreamOptions{})
+
+	paths := []string{}
+	for _, route := range e.Routes() {
+		paths = append(paths, route.Method+" "+route.Path)
+	}
+	assert.ElementsMatch(t, []string{"GET /v1/tasks/stream", "GET /v1/tasks/stream/ws"}, paths)
+}
+
+func TestStreamTasks(t *testing.T) {
+	created := taskModels.TaskChange{
+		Id: 41, Type: entities.DomainEventTaskCreated, Matches: true,
+		Task: &entities.Task{Id: 7, Title: "Write the docs", Status: "TO_DO"},
+	}
+	moved := taskModels.TaskChange{
+		Id: 42, Type: entities.DomainEventTaskStatusChanged,
+		Task: &entities.Task{Id: 7, Title: "Write the docs", Status: "DONE"},
+	}
+
+	t.Run("ReplayLiveAndHeartbeats", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStream := mocks.NewMockTaskStream(ctrl)
+		server := newStreamServer(t, mockStream, handlers.StreamOptions{Heartbeat: 20 * time.Millisecond})
+		changes := make(chan taskModels.TaskChange, 1)
+		changes <- moved
+
+		// The Last-Event-ID header of a reconnecting EventSource overrides the query.
+		mockStream.EXPECT().Subscribe(gomock.Any(), anonymous, &taskModels.StreamTasksQuery{
+			Status: []entities.TaskStatus{"TO_DO"}, LastEventId: 40,
+		}).Return(&usecases.Subscription{Replay: []taskModels.TaskChange{created}, Changes: changes}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/tasks/stream?status=TO_DO&last_event_id=1", nil)
+		require.NoError(t, err)
+		req.Header.Set("Last-Event-ID", "40")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get(echo.HeaderContentType))
+		body := bufio.NewReader(resp.Body)
+		assert.Equal(t, "id: 41\nevent: TaskCreated\ndata: "+marshal(t, created)+"\n\n", readEvent(t, body))
+		assert.Equal(t, "id: 42\nevent: TaskStatusChanged\ndata: "+marshal(t, moved)+"\n\n", readEvent(t, body))
+		assert.Equal(t, ": heartbeat\n\n", readEvent(t, body))
+	})
+
+	t.Run("EndsWhenTheSubscriberFellBehind", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStream := mocks.NewMockTaskStream(ctrl)
+		server := newStreamServer(t, mockStream, handlers.StreamOptions{})
+		changes := make(chan taskModels.TaskChange)
+		close(changes)
+
+		mockStream.EXPECT().Subscribe(gomock.Any(), anonymous, &taskModels.StreamTasksQuery{}).
+			Return(&usecases.Subscription{
+				Replay:  []taskModels.TaskChange{{Type: taskModels.TaskChangeReset}},
+				Changes: changes,
+			}, nil)
+
+		resp, err := http.Get(server.URL + "/v1/tasks/stream")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "event: Reset\ndata: {\"id\":0,\"type\":\"Reset\",\"matches\":false}\n\n", string(body))
+	})
+
+	t.Run("InvalidLastEventID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		server := newStreamServer(t, mocks.NewMockTaskStream(ctrl), handlers.StreamOptions{})
+
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/tasks/stream", nil)
+		require.NoError(t, err)
+		req.Header.Set("Last-Event-ID", "latest")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestStreamTasksWebSocket(t *testing.T) {
+	change := taskModels.TaskChange{
+		Id: 41, Type: entities.DomainEventTaskCreated, Matches: true,
+		Task: &entities.Task{Id: 7, Title: "Write the docs", Status: "TO_DO"},
+	}
+
+	t.Run("Stream", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStream := mocks.NewMockTaskStream(ctrl)
+		server := newStreamServer(t, mockStream, handlers.StreamOptions{Heartbeat: time.Minute})
+		changes := make(chan taskModels.TaskChange, 1)
+		changes <- change
+
+		mockStream.EXPECT().Subscribe(gomock.Any(), anonymous, &taskModels.StreamTasksQuery{
+			Label: []string{"docs"}, LastEventId: 40,
+		}).Return(&usecases.Subscription{Changes: changes}, nil)
+
+		conn, r := dialWebSocket(t, server, "/v1/tasks/stream/ws?label=docs&last_event_id=40")
+
+		opcode, payload := readFrame(t, r)
+		assert.Equal(t, byte(0x1), opcode)
+		assert.JSONEq(t, marshal(t, change), string(payload))
+
+		// Pings are answered with their payload.
+		writeFrame(t, conn, 0x9, []byte("are you there"))
+		opcode, payload = readFrame(t, r)
+		assert.Equal(t, byte(0xA), opcode)
+		assert.Equal(t, "are you there", string(payload))
+
+		// A subscriber that fell behind is told to resume later.
+		close(changes)
+		opcode, payload = readFrame(t, r)
+		assert.Equal(t, byte(0x8), opcode)
+		assert.Equal(t, uint16(1013), binary.BigEndian.Uint16(payload))
+	})
+
+	t.Run("UnmaskedFrame", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStream := mocks.NewMockTaskStream(ctrl)
+		server := newStreamServer(t, mockStream, handlers.StreamOptions{Heartbeat: time.Minute})
+
+		mockStream.EXPECT().Subscribe(gomock.Any(), anonymous, &taskModels.StreamTasksQuery{}).
+			Return(&usecases.Subscription{Changes: make(chan taskModels.TaskChange)}, nil)
+
+		conn, r := dialWebSocket(t, server, "/v1/tasks/stream/ws")
+		_, err := conn.Write([]byte{0x89, 0x00})
+		require.NoError(t, err)
+
+		opcode, payload := readFrame(t, r)
+		assert.Equal(t, byte(0x8), opcode)
+		assert.Equal(t, uint16(1002), binary.BigEndian.Uint16(payload))
+	})
+
+	t.Run("NotAnUpgrade", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		server := newStreamServer(t, mocks.NewMockTaskStream(ctrl), handlers.StreamOptions{})
+
+		resp, err := http.Get(server.URL + "/v1/tasks/stream/ws")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func marshal(t *testing.T, v any) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(data)
+}
+
+// readEvent reads the lines of a Server-Sent Event up to its blank line.
+func readEvent(t *testing.T, r *bufio.Reader) string {
+	var event strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		event.WriteString(line)
+		if line == "\n" {
+			return event.String()
+		}
+	}
+}
+
+// dialWebSocket opens a WebSocket connection to path, checking the handshake of the server.
+func dialWebSocket(t *testing.T, server *httptest.Server, path string) (net.Conn, *bufio.Reader) {
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+	require.NoError(t, err)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	// The key and accept value of the example of RFC 6455.
+	req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	require.NoError(t, req.Write(conn))
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+	assert.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", resp.Header.Get("Sec-WebSocket-Accept"))
+	return conn, r
+}
+
+// writeFrame writes a masked frame of a client.
+func writeFrame(t *testing.T, conn net.Conn, opcode byte, payload []byte) {
+	mask := make([]byte, 4)
+	_, err := rand.Read(mask)
+	require.NoError(t, err)
+	frame := append([]byte{0x80 | opcode, 0x80 | byte(len(payload))}, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	_, err = conn.Write(frame)
+	require.NoError(t, err)
+}
+
+// readFrame reads an unfragmented frame of the server.
+func readFrame(t *testing.T, r *bufio.Reader) (byte, []byte) {
+	header := make([]byte, 2)
+	_, err := io.ReadFull(r, header)
+	require.NoError(t, err)
+	require.Zero(t, header[1]&0x80, "server frames are not masked")
+	length := int(header[1] & 0x7F)
+	if length == 126 {
+		extended := make([]byte, 2)
+		_, err := io.ReadFull(r, extended)
+		require.NoError(t, err)
+		length = int(binary.BigEndian.Uint16(extended))
+	}
+	payload := make([]byte, length)
+	_, err = io.ReadFull(r, payload)
+	require.NoError(t, err)
+	return header[0] & 0x0F, payload
+}
@@ -1,53 +1,66 @@
 package handlers
 
 import (
-	"errors"
 	"net/http"
-	"strconv"
 
 	"github.com/jinzhu/copier"
 	"github.com/labstack/echo/v4"
 	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
 	"github.com/supachai1998/task_services/internal/helpers"
-	"gorm.io/gorm"
+	"github.com/supachai1998/task_services/internal/interfaces"
 )
 
-// GetTaskByID retrieves a task by its ID
-// @Summary Retrieve a task by ID
-// @Description Get a task using its unique ID
+// UpdateTask replaces the details of a task
+// @Summary Replace the details of a task
+// @Description Replace the title, description and schedule of a task: an omitted due_at or estimate_minutes
+// @Description is cleared and an omitted priority becomes MEDIUM, like the fields of a new task.
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Param id path int true "Task ID"
 // @Param task body models.UpdateTaskRequest true "Task object"
-// @Success 200 {object} models.ResponseSuccess{data=entities.Task} "Task found successfully"
-// @Failure 400 {object} models.ResponseError "Invalid ID format"
-// @Failure 404 {object} models.ResponseError "Task not found"
+// @Param If-Match header string false "ETag the update is conditional on; only its version is compared"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=entities.TaskUpdate} "Task found successfully"
+// @Header 200 {string} ETag "Version of the updated task"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner or the assignee of the task"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 409 {object} models.ProblemDetails "Task is done and cannot be updated"
+// @Failure 412 {object} models.ProblemDetails "Task version does not match If-Match"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/tasks/{id} [put]
 func (h *Handler) UpdateTask(c echo.Context) error {
-	id, err := strconv.Atoi(c.Param("id"))
+	id, err := parseTaskID(c)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError("Invalid ID format", "error"))
+		return err
+	}
+	expectedVersions, err := parseIfMatch(c)
+	if err != nil {
+		return err
 	}
 	req := new(models.UpdateTaskRequest)
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError(err.Error(), "error"))
+		return err
 	}
 	if err := c.Validate(req); err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError(err.Error(), "error"))
+		return err
 	}
 
 	var task entities.TaskUpdate
 	copier.Copy(&task, req)
+	schedule := req.Schedule()
+	task.TaskSchedule = &schedule
 
-	task.Id = uint(id)
+	task.Id = id
+	task.ExpectedVersions = expectedVersions
 
-	if err := h.TaskUsecase.UpdateTask(&task); err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return c.JSON(http.StatusNotFound, helpers.NewResponseError("Task not found", "error"))
-		}
-		return c.JSON(http.StatusInternalServerError, helpers.NewResponseError(err.Error(), "error"))
+	if err := h.TaskUsecase.UpdateTask(c.Request().Context(), interfaces.ActorFrom(c), &task); err != nil {
+		return err
 	}
+	setETag(c, etag(task.Version))
 	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Task updated", task))
 }
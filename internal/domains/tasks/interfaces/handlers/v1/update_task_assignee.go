@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// UpdateTaskAssignee assigns a task
+// @Summary Assign a task
+// @Description Assign a task to a user, to the caller with "me", or to nobody with null. Only the owner, the assignee or an admin can.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param body body models.UpdateTaskAssigneeRequest true "Assignee"
+// @Param If-Match header string false "ETag the update is conditional on; only its version is compared"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{} "Task assigned successfully"
+// @Header 200 {string} ETag "Version of the updated task"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner or the assignee of the task"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 412 {object} models.ProblemDetails "Task version does not match If-Match"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/assignee [patch]
+func (h *Handler) UpdateTaskAssignee(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	expectedVersions, err := parseIfMatch(c)
+	if err != nil {
+		return err
+	}
+	var req models.UpdateTaskAssigneeRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	task := &entities.TaskUpdate{
+		Id:               id,
+		ExpectedVersions: expectedVersions,
+	}
+	if err := h.TaskUsecase.AssignTask(c.Request().Context(), interfaces.ActorFrom(c), task, req.AssigneeId); err != nil {
+		return err
+	}
+	setETag(c, etag(task.Version))
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Task assigned", ""))
+}
@@ -0,0 +1,110 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestUpdateTaskAssignee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+	alice := entities.Actor{Name: "alice", UserId: "alice"}
+
+	newContext := func(id, body string, principal *auth.Principal) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPatch, "/v1/tasks/"+id+"/assignee", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/assignee")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		if principal != nil {
+			c.Set(auth.ContextKeyPrincipal, principal)
+		}
+		return c, rec
+	}
+
+	t.Run("AssignToMe", func(t *testing.T) {
+		mockUsecase.EXPECT().AssignTask(gomock.Any(), alice, &entities.TaskUpdate{Id: 1, ExpectedVersions: []uint{2}}, lo.ToPtr("me")).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.TaskUpdate, _ *string) error {
+				task.Version = 3
+				return nil
+			},
+		)
+
+		c, rec := newContext("1", `{"assignee_id": "me"}`, &auth.Principal{Subject: "alice"})
+		c.Request().Header.Set("If-Match", `"2"`)
+		if assert.NoError(t, handler.UpdateTaskAssignee(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+
+			var response models.ResponseSuccess
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "Task assigned", response.Message)
+		}
+	})
+
+	t.Run("Unassign", func(t *testing.T) {
+		var assignee *string
+		mockUsecase.EXPECT().AssignTask(gomock.Any(), alice, &entities.TaskUpdate{Id: 1}, assignee).Return(nil)
+
+		c, rec := newContext("1", `{"assignee_id": null}`, &auth.Principal{Subject: "alice"})
+		if assert.NoError(t, handler.UpdateTaskAssignee(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		mockUsecase.EXPECT().AssignTask(gomock.Any(), anonymous, gomock.Any(), lo.ToPtr("bob")).Return(domainerrors.Unauthorized("authentication required"))
+
+		c, rec := newContext("1", `{"assignee_id": "bob"}`, nil)
+		if assert.Error(t, invoke(handler.UpdateTaskAssignee, c)) {
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		mockUsecase.EXPECT().AssignTask(gomock.Any(), entities.Actor{Name: "carol", UserId: "carol"}, gomock.Any(), lo.ToPtr("carol")).
+			Return(domainerrors.Forbidden("only the owner or the assignee of the task can change it"))
+
+		c, rec := newContext("1", `{"assignee_id": "carol"}`, &auth.Principal{Subject: "carol"})
+		if assert.Error(t, invoke(handler.UpdateTaskAssignee, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	})
+
+	t.Run("EmptyAssignee", func(t *testing.T) {
+		c, rec := newContext("1", `{"assignee_id": ""}`, &auth.Principal{Subject: "alice"})
+		if assert.Error(t, invoke(handler.UpdateTaskAssignee, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		c, rec := newContext("invalid", `{"assignee_id": "me"}`, &auth.Principal{Subject: "alice"})
+		if assert.Error(t, invoke(handler.UpdateTaskAssignee, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
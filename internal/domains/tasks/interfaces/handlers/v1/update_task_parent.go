@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// UpdateTaskParent moves a task under another task
+// @Summary Move a task
+// @Description Make a task a subtask of another task, or a top-level task with null. Only the owner, the assignee or an admin can, and the new parent cannot be the task or one of its subtasks.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param body body models.UpdateTaskParentRequest true "Parent"
+// @Param If-Match header string false "ETag the update is conditional on; only its version is compared"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{} "Task moved successfully"
+// @Header 200 {string} ETag "Version of the updated task"
+// @Failure 400 {object} models.ProblemDetails "Invalid input or parent task not found"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner or the assignee of the task"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 409 {object} models.ProblemDetails "The parent is the task or one of its subtasks"
+// @Failure 412 {object} models.ProblemDetails "Task version does not match If-Match"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/tasks/{id}/parent [patch]
+func (h *Handler) UpdateTaskParent(c echo.Context) error {
+	id, err := parseTaskID(c)
+	if err != nil {
+		return err
+	}
+	expectedVersions, err := parseIfMatch(c)
+	if err != nil {
+		return err
+	}
+	var req models.UpdateTaskParentRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	task := &entities.TaskUpdate{
+		Id:               id,
+		ExpectedVersions: expectedVersions,
+	}
+	if err := h.TaskUsecase.MoveTask(c.Request().Context(), interfaces.ActorFrom(c), task, req.ParentId); err != nil {
+		return err
+	}
+	setETag(c, etag(task.Version))
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Task moved", ""))
+}
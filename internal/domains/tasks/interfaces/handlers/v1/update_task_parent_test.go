@@ -0,0 +1,89 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+func TestUpdateTaskParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockTaskUsecase(ctrl)
+	handler := &handlers.Handler{TaskUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPatch, "/v1/tasks/"+id+"/parent", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/parent")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("MoveUnderParent", func(t *testing.T) {
+		mockUsecase.EXPECT().MoveTask(gomock.Any(), anonymous, &entities.TaskUpdate{Id: 2, ExpectedVersions: []uint{4}}, lo.ToPtr(uint(1))).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.TaskUpdate, _ *uint) error {
+				task.Version = 5
+				return nil
+			},
+		)
+
+		c, rec := newContext("2", `{"parent_id": 1}`)
+		c.Request().Header.Set("If-Match", `"4"`)
+		if assert.NoError(t, handler.UpdateTaskParent(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, `"5"`, rec.Header().Get("ETag"))
+
+			var response models.ResponseSuccess
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "Task moved", response.Message)
+		}
+	})
+
+	t.Run("MoveToTopLevel", func(t *testing.T) {
+		var parent *uint
+		mockUsecase.EXPECT().MoveTask(gomock.Any(), anonymous, &entities.TaskUpdate{Id: 2}, parent).Return(nil)
+
+		c, rec := newContext("2", `{"parent_id": null}`)
+		if assert.NoError(t, handler.UpdateTaskParent(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		mockUsecase.EXPECT().MoveTask(gomock.Any(), anonymous, gomock.Any(), lo.ToPtr(uint(3))).
+			Return(domainerrors.Conflict("a task cannot be moved under itself or one of its subtasks"))
+
+		c, rec := newContext("1", `{"parent_id": 3}`)
+		if assert.Error(t, invoke(handler.UpdateTaskParent, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidParent", func(t *testing.T) {
+		c, rec := newContext("1", `{"parent_id": 0}`)
+		if assert.Error(t, invoke(handler.UpdateTaskParent, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
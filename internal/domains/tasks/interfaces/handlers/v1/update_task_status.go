@@ -2,42 +2,64 @@ package handlers
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
 	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
 )
 
 // UpdateTask updates task details
 // @Summary Update task details
-// @Description Update a task by its unique ID
+// @Description Change the status of a task by its unique ID. A task cannot become DONE while its subtasks are open, unless force is true, nor change status while a task blocking it is open.
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Param id path int true "Task ID"
 // @Param body body models.UpdateTaskStatusRequest true "Task details"
+// @Param If-Match header string false "ETag the update is conditional on; only its version is compared"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
 // @Success 200 {object} models.ResponseSuccess{} "Task updated successfully"
-// @Failure 400 {object} models.ResponseError "Invalid input"
-// @Failure 500 {object} models.ResponseError "Internal server error"
+// @Header 200 {string} ETag "Version of the updated task"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed or not the owner or the assignee of the task"
+// @Failure 404 {object} models.ProblemDetails "Task not found"
+// @Failure 409 {object} models.ProblemDetails "Status transition not allowed; the body carries current_status and allowed_statuses, open_subtasks or blocked_by"
+// @Failure 412 {object} models.ProblemDetails "Task version does not match If-Match"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Router /v1/tasks/{id}/status [patch]
 func (h *Handler) UpdateTaskStatus(c echo.Context) error {
-	id, err := strconv.Atoi(c.Param("id"))
+	id, err := parseTaskID(c)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError("Invalid ID format", "error"))
+		return err
+	}
+	expectedVersions, err := parseIfMatch(c)
+	if err != nil {
+		return err
 	}
 	var req models.UpdateTaskStatusRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError(err.Error(), "error"))
+		return err
 	}
 
 	if err := c.Validate(req); err != nil {
-		return c.JSON(http.StatusBadRequest, helpers.NewResponseError(err.Error(), "error"))
+		return err
 	}
 
-	if err := h.TaskUsecase.UpdateTaskStatus(uint(id), entities.TaskStatus(req.Status)); err != nil {
-		return c.JSON(http.StatusInternalServerError, helpers.NewResponseError(err.Error(), "error"))
+	task := &entities.TaskUpdate{
+		Id:               id,
+		Status:           lo.ToPtr(entities.TaskStatus(req.Status)),
+		ExpectedVersions: expectedVersions,
+		Force:            req.Force,
+	}
+	if err := h.TaskUsecase.UpdateTaskStatus(c.Request().Context(), interfaces.ActorFrom(c), task); err != nil {
+		return err
 	}
+	setETag(c, etag(task.Version))
 	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Task status updated", ""))
 }
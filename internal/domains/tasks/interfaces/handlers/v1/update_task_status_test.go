@@ -2,16 +2,20 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
 	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
 	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
@@ -36,7 +40,12 @@ func TestUpdateTaskStatus(t *testing.T) {
 		}
 
 		// Expect the UpdateTaskStatus method to be called with the correct ID and status, returning no error
-		mockUsecase.EXPECT().UpdateTaskStatus(uint(taskID), entities.TaskStatus(updateReq.Status)).Return(nil)
+		mockUsecase.EXPECT().UpdateTaskStatus(gomock.Any(), anonymous, &entities.TaskUpdate{Id: uint(taskID), Status: lo.ToPtr(entities.TaskStatus(updateReq.Status))}).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.TaskUpdate) error {
+				task.Version = 2
+				return nil
+			},
+		)
 
 		// Marshal the request body to JSON
 		reqBody, err := json.Marshal(updateReq)
@@ -64,6 +73,24 @@ func TestUpdateTaskStatus(t *testing.T) {
 			// Assert the response message and data
 			assert.Equal(t, "Task status updated", response.Message)
 			assert.Equal(t, "", response.Data)
+			assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		}
+	})
+
+	t.Run("ForceComplete", func(t *testing.T) {
+		// force reaches the usecase, which then completes a task with open subtasks
+		mockUsecase.EXPECT().UpdateTaskStatus(gomock.Any(), anonymous, &entities.TaskUpdate{Id: 1, Status: lo.ToPtr(entities.TaskStatusDone), Force: true}).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPatch, "/v1/tasks/1/status", strings.NewReader(`{"status":"DONE","force":true}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/status")
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		if assert.NoError(t, handler.UpdateTaskStatus(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
 		}
 	})
 
@@ -81,18 +108,18 @@ func TestUpdateTaskStatus(t *testing.T) {
 		c.SetParamValues(strconv.Itoa(taskID))
 
 		// Invoke the handler
-		if assert.NoError(t, handler.UpdateTaskStatus(c)) {
+		if assert.Error(t, invoke(handler.UpdateTaskStatus, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
 
 			// Unmarshal the response body
-			var response globalModels.ResponseError
+			var response globalModels.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Contains(t, response.Message, "unexpected")
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Contains(t, response.Detail, "unexpected")
 		}
 	})
 
@@ -104,7 +131,7 @@ func TestUpdateTaskStatus(t *testing.T) {
 		usecaseError := errors.New("database update failed")
 
 		// Expect the UpdateTaskStatus method to be called with the correct ID and status, returning an error
-		mockUsecase.EXPECT().UpdateTaskStatus(uint(taskID), entities.TaskStatus(updateReq.Status)).Return(usecaseError)
+		mockUsecase.EXPECT().UpdateTaskStatus(gomock.Any(), anonymous, &entities.TaskUpdate{Id: uint(taskID), Status: lo.ToPtr(entities.TaskStatus(updateReq.Status))}).Return(usecaseError)
 
 		// Marshal the request body to JSON
 		reqBody, err := json.Marshal(updateReq)
@@ -120,18 +147,106 @@ func TestUpdateTaskStatus(t *testing.T) {
 		c.SetParamValues(strconv.Itoa(taskID))
 
 		// Invoke the handler
-		if assert.NoError(t, handler.UpdateTaskStatus(c)) {
+		if assert.Error(t, invoke(handler.UpdateTaskStatus, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusInternalServerError, rec.Code)
 
 			// Unmarshal the response body
-			var response globalModels.ResponseError
+			var response globalModels.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Equal(t, "database update failed", response.Message)
+			assert.Equal(t, http.StatusInternalServerError, response.Status)
+			// Internal errors are not leaked to the client
+			assert.Equal(t, "internal server error", response.Detail)
+		}
+	})
+
+	t.Run("Conflict_InvalidTransition", func(t *testing.T) {
+		taskID := 6
+		updateReq := models.UpdateTaskStatusRequest{
+			Status: string(entities.TaskStatusInProgress),
+		}
+		usecaseError := domainerrors.Conflict("cannot change task status from DONE to IN_PROGRESS").
+			WithDetail("current_status", entities.TaskStatusDone).
+			WithDetail("allowed_statuses", []entities.TaskStatus{})
+
+		// Expect the UpdateTaskStatus method to reject the transition
+		mockUsecase.EXPECT().UpdateTaskStatus(gomock.Any(), anonymous, &entities.TaskUpdate{Id: uint(taskID), Status: lo.ToPtr(entities.TaskStatus(updateReq.Status))}).Return(usecaseError)
+
+		// Marshal the request body to JSON
+		reqBody, err := json.Marshal(updateReq)
+		assert.NoError(t, err)
+
+		// Create a new HTTP PATCH request
+		req := httptest.NewRequest(http.MethodPatch, "/v1/tasks/"+strconv.Itoa(taskID)+"/status", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/status")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		// Invoke the handler
+		if assert.Error(t, invoke(handler.UpdateTaskStatus, c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusConflict, rec.Code)
+
+			// Unmarshal the response body
+			var response struct {
+				globalModels.ProblemDetails
+				CurrentStatus   entities.TaskStatus   `json:"current_status"`
+				AllowedStatuses []entities.TaskStatus `json:"allowed_statuses"`
+			}
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			// Assert the problem carries the current and allowed statuses
+			assert.Equal(t, interfaces.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+			assert.Equal(t, http.StatusConflict, response.Status)
+			assert.Equal(t, usecaseError.Message, response.Detail)
+			assert.Equal(t, entities.TaskStatusDone, response.CurrentStatus)
+			assert.NotNil(t, response.AllowedStatuses)
+			assert.Empty(t, response.AllowedStatuses)
+		}
+	})
+
+	t.Run("NotFound_TaskDoesNotExist", func(t *testing.T) {
+		taskID := 7
+		updateReq := models.UpdateTaskStatusRequest{
+			Status: string(entities.TaskStatusDone),
+		}
+
+		// Expect the UpdateTaskStatus method to return a not found error
+		mockUsecase.EXPECT().UpdateTaskStatus(gomock.Any(), anonymous, &entities.TaskUpdate{Id: uint(taskID), Status: lo.ToPtr(entities.TaskStatus(updateReq.Status))}).Return(domainerrors.NotFound("task not found"))
+
+		// Marshal the request body to JSON
+		reqBody, err := json.Marshal(updateReq)
+		assert.NoError(t, err)
+
+		// Create a new HTTP PATCH request
+		req := httptest.NewRequest(http.MethodPatch, "/v1/tasks/"+strconv.Itoa(taskID)+"/status", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id/status")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		// Invoke the handler
+		if assert.Error(t, invoke(handler.UpdateTaskStatus, c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+
+			// Unmarshal the response body
+			var response globalModels.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			// Assert the response status and message
+			assert.Equal(t, http.StatusNotFound, response.Status)
+			assert.Equal(t, "task not found", response.Detail)
 		}
 	})
 
@@ -155,23 +270,23 @@ func TestUpdateTaskStatus(t *testing.T) {
 		c.SetParamValues(invalidID)
 
 		// Invoke the handler
-		err = handler.UpdateTaskStatus(c)
-		assert.NoError(t, err)
+		err = invoke(handler.UpdateTaskStatus, c)
+		assert.Error(t, err)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 
 		// Verify the response
-		var response globalModels.ResponseError
+		var response globalModels.ProblemDetails
 		err = json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "error", response.Status)
-		assert.Equal(t, "Invalid ID format", response.Message)
+		assert.Equal(t, http.StatusBadRequest, response.Status)
+		assert.Equal(t, "Invalid ID format", response.Detail)
 
 	})
 
 	t.Run("BadRequest_ValidationFailure", func(t *testing.T) {
 		taskID := 5
 		updateReq := models.UpdateTaskStatusRequest{
-			Status: "TO_DO",
+			Status: "in review",
 		}
 
 		// Marshal the request body to JSON
@@ -188,18 +303,18 @@ func TestUpdateTaskStatus(t *testing.T) {
 		c.SetParamValues(strconv.Itoa(taskID))
 
 		// Invoke the handler
-		if assert.NoError(t, handler.UpdateTaskStatus(c)) {
+		if assert.Error(t, invoke(handler.UpdateTaskStatus, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
 
 			// Unmarshal the response body
-			var response globalModels.ResponseError
+			var response globalModels.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Contains(t, response.Message, "invalid input")
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Contains(t, response.Detail, "invalid input")
 		}
 	})
 }
@@ -2,23 +2,25 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
 	handlers "github.com/supachai1998/task_services/internal/domains/tasks/interfaces/handlers/v1"
 	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
 	"github.com/supachai1998/task_services/internal/interfaces"
 	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/usecases"
 	globalModels "github.com/supachai1998/task_services/internal/models"
-	"gorm.io/gorm"
 )
 
 // Mocking copier.Copy is not straightforward since it's a function.
@@ -49,8 +51,8 @@ func TestUpdateTask(t *testing.T) {
 		}
 
 		// Set expectation: UpdateTask should be called with a TaskUpdate matching expectedTask
-		mockUsecase.EXPECT().UpdateTask(gomock.AssignableToTypeOf(&entities.TaskUpdate{})).DoAndReturn(
-			func(task *entities.TaskUpdate) error {
+		mockUsecase.EXPECT().UpdateTask(gomock.Any(), anonymous, gomock.AssignableToTypeOf(&entities.TaskUpdate{})).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.TaskUpdate) error {
 				// Verify that the task fields match the request
 				assert.Equal(t, expectedTask.Id, task.Id)
 				assert.Equal(t, expectedTask.Title, task.Title)
@@ -109,18 +111,153 @@ func TestUpdateTask(t *testing.T) {
 		c.SetParamValues(strconv.Itoa(taskID))
 
 		// Invoke the handler
-		if assert.NoError(t, handler.UpdateTask(c)) {
+		if assert.Error(t, invoke(handler.UpdateTask, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
 
 			// Unmarshal the response body
-			var response globalModels.ResponseError
+			var response globalModels.ProblemDetails
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+
+			// Assert the response status and message
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Contains(t, response.Detail, "unexpected")
+		}
+	})
+
+	t.Run("Success_IfMatch", func(t *testing.T) {
+		taskID := 6
+		updateReq := models.UpdateTaskRequest{
+			Title:       "Conditional Title",
+			Description: "Conditional Description",
+		}
+
+		// Set expectation: UpdateTask should receive the If-Match versions and bump the version
+		mockUsecase.EXPECT().UpdateTask(gomock.Any(), anonymous, gomock.AssignableToTypeOf(&entities.TaskUpdate{})).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.TaskUpdate) error {
+				assert.Equal(t, uint(taskID), task.Id)
+				assert.Equal(t, []uint{2}, task.ExpectedVersions)
+				task.Version = 3
+				return nil
+			},
+		)
+
+		// Marshal the request body to JSON
+		reqBody, err := json.Marshal(updateReq)
+		assert.NoError(t, err)
+
+		// Create a new HTTP PUT request conditional on version 2
+		req := httptest.NewRequest(http.MethodPut, "/v1/tasks/"+strconv.Itoa(taskID), bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("If-Match", `"2"`)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		// Invoke the handler
+		if assert.NoError(t, handler.UpdateTask(c)) {
+			// Assert the HTTP status code and the ETag of the new version
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+		}
+	})
+
+	t.Run("Success_IfMatchWithStaleComputedFields", func(t *testing.T) {
+		taskID := 6
+
+		// If-Match only compares the version: the hash of the computed fields is not checked, even stale
+		mockUsecase.EXPECT().UpdateTask(gomock.Any(), anonymous, gomock.AssignableToTypeOf(&entities.TaskUpdate{})).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, task *entities.TaskUpdate) error {
+				assert.Equal(t, []uint{2}, task.ExpectedVersions)
+				task.Version = 3
+				return nil
+			},
+		)
+
+		req := httptest.NewRequest(http.MethodPut, "/v1/tasks/"+strconv.Itoa(taskID),
+			strings.NewReader(`{"title": "Conditional Title", "description": "Conditional Description"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("If-Match", `"2-8c2c5b0e1f9a4d37"`)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		if assert.NoError(t, handler.UpdateTask(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("PreconditionFailed_VersionMismatch", func(t *testing.T) {
+		taskID := 7
+		updateReq := models.UpdateTaskRequest{
+			Title:       "Stale Title",
+			Description: "Stale Description",
+		}
+
+		// Set expectation: UpdateTask should reject the stale version
+		mockUsecase.EXPECT().UpdateTask(gomock.Any(), anonymous, gomock.AssignableToTypeOf(&entities.TaskUpdate{})).
+			Return(domainerrors.PreconditionFailed("task has been modified since it was read"))
+
+		// Marshal the request body to JSON
+		reqBody, err := json.Marshal(updateReq)
+		assert.NoError(t, err)
+
+		// Create a new HTTP PUT request conditional on an old version
+		req := httptest.NewRequest(http.MethodPut, "/v1/tasks/"+strconv.Itoa(taskID), bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("If-Match", `"1"`)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		// Invoke the handler
+		if assert.Error(t, invoke(handler.UpdateTask, c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+			// Unmarshal the response body
+			var response globalModels.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Contains(t, response.Message, "unexpected")
+			assert.Equal(t, http.StatusPreconditionFailed, response.Status)
+			assert.Equal(t, "task has been modified since it was read", response.Detail)
+		}
+	})
+
+	t.Run("PreconditionFailed_WeakIfMatch", func(t *testing.T) {
+		taskID := 8
+		updateReq := models.UpdateTaskRequest{
+			Title:       "Weak Title",
+			Description: "Weak Description",
+		}
+
+		// Marshal the request body to JSON
+		reqBody, err := json.Marshal(updateReq)
+		assert.NoError(t, err)
+
+		// Create a new HTTP PUT request with a weak tag, which never matches If-Match
+		req := httptest.NewRequest(http.MethodPut, "/v1/tasks/"+strconv.Itoa(taskID), bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("If-Match", `W/"1"`)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/tasks/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(strconv.Itoa(taskID))
+
+		// Invoke the handler
+		if assert.Error(t, invoke(handler.UpdateTask, c)) {
+			// Assert the HTTP status code
+			assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
 		}
 	})
 
@@ -133,16 +270,17 @@ func TestUpdateTask(t *testing.T) {
 
 		// Expected task after update
 		expectedTask := &entities.TaskUpdate{
-			Id:          uint(taskID),
-			Title:       &updateReq.Title,
-			Description: &updateReq.Description,
+			Id:           uint(taskID),
+			Title:        &updateReq.Title,
+			Description:  &updateReq.Description,
+			TaskSchedule: &entities.TaskSchedule{},
 		}
 
 		// Define the use case error
 		usecaseError := errors.New("database update failed")
 
 		// Expect the UpdateTask method to be called with the correct task and return an error
-		mockUsecase.EXPECT().UpdateTask(expectedTask).Return(usecaseError)
+		mockUsecase.EXPECT().UpdateTask(gomock.Any(), anonymous, expectedTask).Return(usecaseError)
 
 		// Marshal the request body to JSON
 		reqBody, err := json.Marshal(updateReq)
@@ -158,18 +296,19 @@ func TestUpdateTask(t *testing.T) {
 		c.SetParamValues(strconv.Itoa(taskID))
 
 		// Invoke the handler
-		if assert.NoError(t, handler.UpdateTask(c)) {
+		if assert.Error(t, invoke(handler.UpdateTask, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusInternalServerError, rec.Code)
 
 			// Unmarshal the response body
-			var response globalModels.ResponseError
+			var response globalModels.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Equal(t, usecaseError.Error(), response.Message)
+			assert.Equal(t, http.StatusInternalServerError, response.Status)
+			// Internal errors are not leaked to the client
+			assert.Equal(t, "internal server error", response.Detail)
 		}
 	})
 
@@ -194,18 +333,18 @@ func TestUpdateTask(t *testing.T) {
 		c.SetParamValues(invalidID)
 
 		// Invoke the handler
-		if assert.NoError(t, handler.UpdateTask(c)) {
+		if assert.Error(t, invoke(handler.UpdateTask, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
 
 			// Unmarshal the response body
-			var response globalModels.ResponseError
+			var response globalModels.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Equal(t, "Invalid ID format", response.Message)
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Equal(t, "Invalid ID format", response.Detail)
 		}
 	})
 
@@ -218,16 +357,17 @@ func TestUpdateTask(t *testing.T) {
 
 		// Expected task after update
 		expectedTask := &entities.TaskUpdate{
-			Id:          uint(taskID),
-			Title:       &updateReq.Title,
-			Description: &updateReq.Description,
+			Id:           uint(taskID),
+			Title:        &updateReq.Title,
+			Description:  &updateReq.Description,
+			TaskSchedule: &entities.TaskSchedule{},
 		}
 
 		// Define the use case error as a not found error
-		usecaseError := gorm.ErrRecordNotFound
+		usecaseError := domainerrors.NotFound("task not found")
 
 		// Expect the UpdateTask method to be called with the correct task and return a not found error
-		mockUsecase.EXPECT().UpdateTask(expectedTask).Return(usecaseError)
+		mockUsecase.EXPECT().UpdateTask(gomock.Any(), anonymous, expectedTask).Return(usecaseError)
 
 		// Marshal the request body to JSON
 		reqBody, err := json.Marshal(updateReq)
@@ -243,18 +383,18 @@ func TestUpdateTask(t *testing.T) {
 		c.SetParamValues(strconv.Itoa(taskID))
 
 		// Invoke the handler
-		if assert.NoError(t, handler.UpdateTask(c)) {
+		if assert.Error(t, invoke(handler.UpdateTask, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusNotFound, rec.Code)
 
 			// Unmarshal the response body
-			var response globalModels.ResponseError
+			var response globalModels.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Equal(t, "Task not found", response.Message)
+			assert.Equal(t, http.StatusNotFound, response.Status)
+			assert.Equal(t, "task not found", response.Detail)
 		}
 	})
 
@@ -279,18 +419,18 @@ func TestUpdateTask(t *testing.T) {
 		c.SetParamValues(strconv.Itoa(taskID))
 
 		// Invoke the handler
-		if assert.NoError(t, handler.UpdateTask(c)) {
+		if assert.Error(t, invoke(handler.UpdateTask, c)) {
 			// Assert the HTTP status code
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
 
 			// Unmarshal the response body
-			var response globalModels.ResponseError
+			var response globalModels.ProblemDetails
 			err := json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 
 			// Assert the response status and message
-			assert.Equal(t, "error", response.Status)
-			assert.Contains(t, response.Message, "title")
+			assert.Equal(t, http.StatusBadRequest, response.Status)
+			assert.Contains(t, response.Detail, "title")
 		}
 	})
 }
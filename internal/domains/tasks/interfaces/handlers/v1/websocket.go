@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+)
+
+// The subset of RFC 6455 the task stream needs: the server sends text messages and pings, and
+// answers the pings and the close of the client, whose other messages are ignored.
+
+// websocketGUID is appended to the key of the client to compute Sec-WebSocket-Accept.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	opText  byte = 0x1
+	opClose byte = 0x8
+	opPing  byte = 0x9
+	opPong  byte = 0xA
+)
+
+const (
+	closeNormal        uint16 = 1000
+	closeProtocolError uint16 = 1002
+	closeTooBig        uint16 = 1009
+	// closeTryAgainLater ends the stream of a subscriber that fell behind; it resumes after its last change.
+	closeTryAgainLater uint16 = 1013
+)
+
+// maxClientMessage bounds the frames of the client, which has nothing to send but control frames.
+const maxClientMessage = 4096
+
+var (
+	errWebSocketProtocol = errors.New("websocket: protocol error")
+	errWebSocketTooBig   = errors.New("websocket: message too big")
+)
+
+// websocketKey checks the opening handshake of a WebSocket client and returns its key.
+func websocketKey(r *http.Request) (string, error) {
+	if !headerHasToken(r.Header, "Connection", "upgrade") || !headerHasToken(r.Header, "Upgrade", "websocket") {
+		return "", domainerrors.Validation("WebSocket upgrade required")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		return "", domainerrors.Validation("Unsupported WebSocket version").WithDetail("supported_version", "13")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if decoded, err := base64.StdEncoding.DecodeString(key); err != nil || len(decoded) != 16 {
+		return "", domainerrors.Validation("Invalid Sec-WebSocket-Key")
+	}
+	return key, nil
+}
+
+// headerHasToken reports whether the comma-separated header name has token, ignoring case.
+func headerHasToken(header http.Header, name, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, t := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// websocketAccept returns the Sec-WebSocket-Accept of key.
+func websocketAccept(key string) string {
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// webSocket is the server side of a WebSocket connection; its writes are safe for concurrent use.
+type webSocket struct {
+	conn    net.Conn
+	r       *bufio.Reader
+	w       *bufio.Writer
+	timeout time.Duration
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// accept completes the opening handshake of the client of key.
+func (ws *webSocket) accept(key string) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	_ = ws.conn.SetWriteDeadline(time.Now().Add(ws.timeout))
+	fmt.Fprintf(ws.w, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n", websocketAccept(key))
+	return ws.w.Flush()
+}
+
+func (ws *webSocket) writeChange(change models.TaskChange) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	return ws.writeFrame(opText, data)
+}
+
+func (ws *webSocket) writeHeartbeat() error {
+	return ws.writeFrame(opPing, nil)
+}
+
+// close sends a close frame, once; the connection is closed by the caller.
+func (ws *webSocket) close(code uint16, reason string) error {
+	payload := make([]byte, 2, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, code)
+	payload = append(payload, reason...)
+	return ws.writeFrame(opClose, payload)
+}
+
+// writeFrame writes an unfragmented, unmasked frame; nothing is written after the close frame.
+func (ws *webSocket) writeFrame(opcode byte, payload []byte) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if ws.closed {
+		return net.ErrClosed
+	}
+	ws.closed = opcode == opClose
+	_ = ws.conn.SetWriteDeadline(time.Now().Add(ws.timeout))
+
+	header := []byte{0x80 | opcode}
+	switch length := len(payload); {
+	case length < 126:
+		header = append(header, byte(length))
+	case length <= 0xFFFF:
+		header = append(header, 126, 0, 0)
+		binary.BigEndian.PutUint16(header[2:], uint16(length))
+	default:
+		header = append(header, 127, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint64(header[2:], uint64(length))
+	}
+	if _, err := ws.w.Write(header); err != nil {
+		return err
+	}
+	if _, err := ws.w.Write(payload); err != nil {
+		return err
+	}
+	return ws.w.Flush()
+}
+
+// readFrame reads a frame of the client and unmasks its payload.
+func (ws *webSocket) readFrame() (byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(ws.r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	final, opcode := header[0]&0x80 != 0, header[0]&0x0F
+	masked, length := header[1]&0x80 != 0, uint64(header[1]&0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(ws.r, extended[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(ws.r, extended[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+	// Clients mask every frame; control frames are short and never fragmented.
+	if !masked || (opcode >= opClose && (!final || length > 125)) {
+		return 0, nil, errWebSocketProtocol
+	}
+	if length > maxClientMessage {
+		return 0, nil, errWebSocketTooBig
+	}
+	var mask [4]byte
+	if _, err := io.ReadFull(ws.r, mask[:]); err != nil {
+		return 0, nil, err
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(ws.r, payload); err != nil {
+		return 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return opcode, payload, nil
+}
+
+// readLoop answers the control frames of the client until it closes the connection, breaks the
+// protocol or sends nothing, not even a pong, for timeout.
+func (ws *webSocket) readLoop(timeout time.Duration) {
+	for {
+		_ = ws.conn.SetReadDeadline(time.Now().Add(timeout))
+		opcode, payload, err := ws.readFrame()
+		switch {
+		case errors.Is(err, errWebSocketProtocol):
+			_ = ws.close(closeProtocolError, "")
+			return
+		case errors.Is(err, errWebSocketTooBig):
+			_ = ws.close(closeTooBig, "")
+			return
+		case err != nil:
+			return
+		}
+		switch opcode {
+		case opPing:
+			if err := ws.writeFrame(opPong, payload); err != nil {
+				return
+			}
+		case opClose:
+			_ = ws.close(closeNormal, "")
+			return
+		}
+	}
+}
@@ -1,13 +1,78 @@
 package interfaces
 
 import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
 )
 
 type TaskRepository interface {
-	Create(task *entities.Task) error
-	Update(task *entities.TaskUpdate) error
-	GetByID(id uint) (*entities.Task, error)
-	DeleteByID(id uint) error
-	List() ([]entities.Task, error)
+	Create(ctx context.Context, task *entities.Task) error
+	// CreateBatch inserts tasks with a single multi-row insert.
+	CreateBatch(ctx context.Context, tasks []*entities.Task) error
+	Update(ctx context.Context, task *entities.TaskUpdate) error
+	// UpdateAssignee assigns a task to assigneeID, or to nobody when it is nil, like Update.
+	UpdateAssignee(ctx context.Context, task *entities.TaskUpdate, assigneeID *string) error
+	// UpdateParent makes a task a subtask of parentID, or a top-level task when it is nil, like Update.
+	UpdateParent(ctx context.Context, task *entities.TaskUpdate, parentID *uint) error
+	GetByID(ctx context.Context, id uint) (*entities.Task, error)
+	// GetByIDUnscoped is GetByID that also finds soft-deleted tasks.
+	GetByIDUnscoped(ctx context.Context, id uint) (*entities.Task, error)
+	GetByIDForUpdate(ctx context.Context, id uint) (*entities.Task, error)
+	// GetByIDUnscopedForUpdate is GetByIDForUpdate that also finds soft-deleted tasks.
+	GetByIDUnscopedForUpdate(ctx context.Context, id uint) (*entities.Task, error)
+	DeleteByID(ctx context.Context, id uint) error
+	// Restore undoes the soft delete of a task and refreshes task from the restored row.
+	Restore(ctx context.Context, task *entities.Task) error
+	List(ctx context.Context, query *models.ListTasksQuery) ([]entities.Task, string, error)
+	// Search returns one page of the tasks matching a full-text query, best match first.
+	Search(ctx context.Context, query *models.SearchTasksQuery) ([]models.TaskSearchResult, string, error)
+	// LockHierarchy serializes the changes of the parents of the tasks of the workspace until the
+	// end of the transaction, so concurrent moves cannot form a cycle.
+	LockHierarchy(ctx context.Context) error
+	// ListAncestorIDs returns the ID of a task followed by the IDs of its ancestors, soft-deleted or not.
+	ListAncestorIDs(ctx context.Context, id uint) ([]uint, error)
+	// ListDescendants returns the subtasks of a task down to depth levels, level by level,
+	// stopping after limit+1 subtasks so the caller can tell the tree is larger than limit.
+	ListDescendants(ctx context.Context, id uint, depth int, limit int) ([]entities.Task, error)
+	// ChildProgress returns the progress of the tasks of ids that have subtasks.
+	ChildProgress(ctx context.Context, ids []uint) (map[uint]entities.TaskProgress, error)
+	// CreateDependency records that dependency.BlockerId blocks dependency.BlockedId.
+	CreateDependency(ctx context.Context, dependency *entities.TaskDependency) error
+	DeleteDependency(ctx context.Context, blockerID, blockedID uint) error
+	// ListDependencies returns the dependencies a task is the blocker or the blocked task of.
+	ListDependencies(ctx context.Context, taskID uint) ([]entities.TaskDependency, error)
+	// LockDependencies serializes the changes of the dependencies of the workspace until the
+	// end of the transaction, so concurrent inserts cannot form a cycle.
+	LockDependencies(ctx context.Context) error
+	// ListUpstreamBlockerIDs returns the IDs of the tasks that block a task, directly or through other tasks.
+	ListUpstreamBlockerIDs(ctx context.Context, id uint) ([]uint, error)
+	// ListOpenBlockers returns the IDs of the blockers that are not DONE of the tasks of ids,
+	// by blocked task; soft-deleted blockers do not block.
+	ListOpenBlockers(ctx context.Context, ids []uint) (map[uint][]uint, error)
+	// CreateTaskLabel puts taskLabel.LabelId on taskLabel.TaskId.
+	CreateTaskLabel(ctx context.Context, taskLabel *entities.TaskLabel) error
+	DeleteTaskLabel(ctx context.Context, taskID, labelID uint) error
+	// ListLabels returns the labels of the tasks of ids, by task, each sorted by name.
+	ListLabels(ctx context.Context, ids []uint) (map[uint][]entities.Label, error)
+	// CountComments returns the number of comments of the tasks of ids that have comments.
+	CountComments(ctx context.Context, ids []uint) (map[uint]int, error)
+	// CreateEvent records an entry of the history of a task and writes its domain event to the
+	// outbox; callers run it in the transaction of the change it records.
+	CreateEvent(ctx context.Context, event *entities.TaskEvent) error
+	// CreateEvents inserts events, and their domain events, with a multi-row insert each.
+	CreateEvents(ctx context.Context, events []*entities.TaskEvent) error
+	ListEvents(ctx context.Context, taskID uint, query *models.ListTaskHistoryQuery) ([]entities.TaskEvent, string, error)
+	GetEvent(ctx context.Context, id uint) (*entities.TaskEvent, error)
+	// ListEventsAfter returns the first limit events of the tasks of the workspace after the event afterID.
+	ListEventsAfter(ctx context.Context, afterID uint, limit int) ([]entities.TaskEvent, error)
+	// Transaction runs fn with a repository bound to a single database transaction.
+	Transaction(ctx context.Context, fn func(repo TaskRepository) error) error
+}
+
+// WorkflowReader returns the workflow of the tasks of the workspace of ctx.
+type WorkflowReader interface {
+	// GetActiveWorkflow returns the active workflow of the workspace, or the default workflow when none is.
+	GetActiveWorkflow(ctx context.Context) (*entities.Workflow, error)
 }
@@ -0,0 +1,58 @@
+package models
+
+import "github.com/supachai1998/task_services/internal/entities"
+
+const (
+	// MaxBatchSize is the largest number of operations in one batch request.
+	MaxBatchSize = 100
+
+	BatchOpCreate = "create"
+	BatchOpUpdate = "update"
+	BatchOpStatus = "status"
+	BatchOpDelete = "delete"
+)
+
+// BatchTasksRequest is the body of POST /v1/tasks:batch.
+type BatchTasksRequest struct {
+	// Atomic applies all operations in one transaction, or none of them if one fails.
+	Atomic     bool             `json:"atomic" example:"false"`
+	Operations []BatchOperation `json:"operations" validate:"required,min=1,max=100"`
+}
+
+// BatchOperation is one operation of a batch request; the fields used depend on Op.
+type BatchOperation struct {
+	Op          string `json:"op" validate:"required,oneof=create update status delete" example:"create"`
+	Id          uint   `json:"id,omitempty" validate:"required_unless=Op create"`
+	Title       string `json:"title,omitempty" example:"Later is never"`
+	Description string `json:"description,omitempty" example:"When 'later' turns into 'never', it's just your code's way of saying it loves the TODO comments."`
+	Status      string `json:"status,omitempty" example:"IN_PROGRESS"`
+	// Version makes an update or status operation conditional, like If-Match.
+	Version *uint `json:"version,omitempty"`
+	// Force completes a task whose subtasks are not all DONE in a status operation.
+	Force bool `json:"force,omitempty"`
+	// ParentId makes the task of a create operation a subtask of another task.
+	ParentId *uint `json:"parent_id,omitempty" example:"1"`
+	// The schedule fields of a create or update operation, like those of POST and PUT /v1/tasks.
+	TaskScheduleRequest
+}
+
+// TaskOperation is a validated batch operation, as executed by the usecase.
+type TaskOperation struct {
+	// Index is the position of the operation in the batch request.
+	Index int
+	Op    string
+	// Task is the task to create.
+	Task *entities.Task
+	// Update is the update of an update or status operation.
+	Update *entities.TaskUpdate
+	// Id is the task to delete.
+	Id uint
+}
+
+// TaskOperationResult is the outcome of a TaskOperation; Data is the created or updated task.
+type TaskOperationResult struct {
+	Index int
+	Op    string
+	Data  interface{}
+	Err   error
+}
@@ -1,14 +1,185 @@
 package models
 
+import (
+	"time"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+const (
+	// DefaultListLimit is the page size used when the list query has no limit.
+	DefaultListLimit = 20
+	// MaxListLimit is the largest page size a client may request.
+	MaxListLimit = 100
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+
+	// LabelMatchAny lists the tasks with any of the labels of the label filter, LabelMatchAll those with all of them.
+	LabelMatchAny = "any"
+	LabelMatchAll = "all"
+
+	// Me stands for the authenticated user in the owner and assignee filters and assignments.
+	Me = "me"
+
+	// DefaultTreeDepth is the number of subtask levels of a tree when the query has no depth.
+	DefaultTreeDepth = 3
+	// MaxTreeDepth is the largest number of subtask levels a client may request.
+	MaxTreeDepth = 10
+	// MaxTreeSize is the largest number of subtasks in a tree.
+	MaxTreeSize = 500
+)
+
+// TaskScheduleRequest holds the planning fields of a task in a create or update request.
+type TaskScheduleRequest struct {
+	DueAt *time.Time `json:"due_at" example:"2026-11-30T17:00:00Z"`
+	// Priority is MEDIUM when omitted.
+	Priority entities.TaskPriority `json:"priority" validate:"omitempty,oneof=LOW MEDIUM HIGH URGENT" example:"HIGH"`
+	// EstimateMinutes is at most a year.
+	EstimateMinutes *int `json:"estimate_minutes" validate:"omitempty,min=0,max=525600" example:"90"`
+}
+
+// Schedule returns the schedule of the request.
+func (r TaskScheduleRequest) Schedule() entities.TaskSchedule {
+	return entities.TaskSchedule{DueAt: r.DueAt, Priority: r.Priority, EstimateMinutes: r.EstimateMinutes}
+}
+
 type UpdateTaskStatusRequest struct {
-	Status string `json:"status" validate:"required,oneof=IN_PROGRESS DONE"`
+	// Status is a status of the workflow of the workspace that the current status can move to.
+	Status string `json:"status" validate:"required,task_status" example:"IN_PROGRESS"`
+	// Force completes a task whose subtasks are not all done.
+	Force bool `json:"force" example:"false"`
+}
+
+// UpdateTaskAssigneeRequest assigns a task to a user, "me", or nobody when AssigneeId is null.
+type UpdateTaskAssigneeRequest struct {
+	AssigneeId *string `json:"assignee_id" validate:"omitempty,min=1,max=255" example:"me"`
+}
+
+// UpdateTaskParentRequest makes a task a subtask of ParentId, or a top-level task when it is null.
+type UpdateTaskParentRequest struct {
+	ParentId *uint `json:"parent_id" validate:"omitempty,min=1" example:"1"`
+}
+
+// AddTaskDependencyRequest makes the task of the path blocked by the task BlockerId.
+type AddTaskDependencyRequest struct {
+	BlockerId uint `json:"blocker_id" validate:"required,min=1" example:"12"`
+}
+
+// AddTaskLabelRequest puts the label LabelId on the task of the path.
+type AddTaskLabelRequest struct {
+	LabelId uint `json:"label_id" validate:"required,min=1" example:"3"`
 }
 
 type CreateTaskRequest struct {
 	Title       string `json:"title" validate:"required,min=3,max=100" example:"Later is never"`
 	Description string `json:"description" validate:"required,min=3,max=25500" example:"When 'later' turns into 'never', it's just your code's way of saying it loves the TODO comments."`
+	// ParentId makes the new task a subtask of another task.
+	ParentId *uint `json:"parent_id" validate:"omitempty,min=1" example:"1"`
+	TaskScheduleRequest
 }
+
+// UpdateTaskRequest replaces a task: an omitted due_at or estimate_minutes is cleared and an omitted
+// priority becomes MEDIUM.
 type UpdateTaskRequest struct {
 	Title       string `json:"title" validate:"required,min=3,max=100" example:"Code runs, coffee fuels"`
 	Description string `json:"description" validate:"required,min=3,max=25500" example:"Coding without coffee is like debugging without a console log."`
+	TaskScheduleRequest
+}
+
+// ListTasksQuery is the typed list query of GET /v1/tasks, passed from the handler down to the repository.
+type ListTasksQuery struct {
+	Status        []entities.TaskStatus `query:"status" validate:"omitempty,dive,task_status"`
+	Search        string                `query:"q" validate:"omitempty,max=100"`
+	CreatedAfter  *time.Time            `query:"created_after"`
+	UpdatedBefore *time.Time            `query:"updated_before"`
+	// Owner and Assignee filter by user; "me" is the authenticated user.
+	Owner    string `query:"owner" validate:"omitempty,max=255"`
+	Assignee string `query:"assignee" validate:"omitempty,max=255"`
+	// Overdue only lists the tasks that are not done and were due before now.
+	Overdue bool `query:"overdue"`
+	// Label filters by label name, ignoring case; LabelMatch tells whether a task needs any or all of them.
+	Label      []string `query:"label" validate:"omitempty,max=20,dive,min=1,max=50"`
+	LabelMatch string   `query:"label_match" validate:"omitempty,oneof=any all"`
+	// IncludeDeleted also lists soft-deleted tasks; admin only.
+	IncludeDeleted bool   `query:"include_deleted"`
+	Sort           string `query:"sort" validate:"omitempty,oneof=id title status priority due_at created_at updated_at"`
+	Order          string `query:"order" validate:"omitempty,oneof=asc desc"`
+	Limit          int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor         string `query:"cursor" validate:"omitempty,max=512"`
+	// VisibleTo, set by the usecase, restricts the list to the tasks this user owns or is assigned to.
+	VisibleTo string `query:"-" json:"-" swaggerignore:"true"`
+	// ParentId, set by the usecase, restricts the list to the subtasks of this task.
+	ParentId *uint `query:"-" json:"-" swaggerignore:"true"`
+	// DueBefore, set by the usecase when Overdue is true, restricts the list to the tasks that are not done
+	// and were due before this time.
+	DueBefore *time.Time `query:"-" json:"-" swaggerignore:"true"`
+}
+
+// ListTaskChildrenQuery is the pagination query of GET /v1/tasks/{id}/children.
+type ListTaskChildrenQuery struct {
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `query:"cursor" validate:"omitempty,max=512"`
+}
+
+// GetTaskTreeQuery is the query of GET /v1/tasks/{id}/tree.
+type GetTaskTreeQuery struct {
+	// Depth is the number of subtask levels of the tree.
+	Depth int `query:"depth" validate:"omitempty,min=1,max=10"`
+}
+
+// TaskTree is a task and its subtasks, down to the depth of the tree query.
+type TaskTree struct {
+	entities.Task
+	Children []TaskTree `json:"children"`
+}
+
+// ListTaskHistoryQuery is the pagination query of GET /v1/tasks/{id}/history.
+type ListTaskHistoryQuery struct {
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `query:"cursor" validate:"omitempty,max=512"`
+}
+
+// SearchTasksQuery is the full-text search query of GET /v1/tasks/search.
+type SearchTasksQuery struct {
+	Query  string `query:"q" validate:"required,max=200"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `query:"cursor" validate:"omitempty,max=512"`
+	// VisibleTo, set by the usecase, restricts the results to the tasks this user owns or is assigned to.
+	VisibleTo string `query:"-" json:"-" swaggerignore:"true"`
+}
+
+// TaskSearchResult is a task matching a full-text search, with its rank and snippets
+// where the matching words are wrapped in <mark> tags.
+type TaskSearchResult struct {
+	entities.Task
+	Rank               float64 `json:"rank" example:"0.6"`
+	TitleSnippet       string  `json:"title_snippet" example:"<mark>Later</mark> is never"`
+	DescriptionSnippet string  `json:"description_snippet" example:"When '<mark>later</mark>' turns into 'never'"`
+}
+
+// StreamTasksQuery is the query of GET /v1/tasks/stream and GET /v1/tasks/stream/ws; its filters
+// work like those of ListTasksQuery.
+type StreamTasksQuery struct {
+	Status     []entities.TaskStatus `query:"status" validate:"omitempty,dive,task_status"`
+	Label      []string              `query:"label" validate:"omitempty,max=20,dive,min=1,max=50"`
+	LabelMatch string                `query:"label_match" validate:"omitempty,oneof=any all"`
+	// LastEventId resumes the stream after this change; the Last-Event-ID header overrides it.
+	LastEventId uint `query:"last_event_id"`
+}
+
+// TaskChangeReset is the type of the change telling a resuming subscriber that it missed too many
+// changes to replay them and must list its tasks again.
+const TaskChangeReset entities.DomainEventType = "Reset"
+
+// TaskChange is a message of the task stream: an entry of the history of a task, with the task as
+// it was when the change was streamed.
+type TaskChange struct {
+	// Id is the ID of the task event, the ID to resume the stream after.
+	Id   uint                     `json:"id" example:"42"`
+	Type entities.DomainEventType `json:"type" example:"TaskStatusChanged"`
+	// Matches is false when the change took the task out of the filters of the stream.
+	Matches bool                `json:"matches" example:"true"`
+	Event   *entities.TaskEvent `json:"event,omitempty"`
+	Task    *entities.Task      `json:"task,omitempty"`
 }
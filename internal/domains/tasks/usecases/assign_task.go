@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// AssignTask assigns a task to assigneeID, "me" or nobody; only an authenticated owner, assignee or admin can.
+func (u *usecase) AssignTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate, assigneeID *string) error {
+	if actor.UserId == "" {
+		return errAuthenticationRequired
+	}
+	if assigneeID != nil {
+		resolved, err := resolveUser(actor, *assigneeID)
+		if err != nil {
+			return err
+		}
+		assigneeID = &resolved
+	}
+
+	return u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		currentTask, err := repo.GetByIDForUpdate(ctx, task.Id)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeChange(actor, currentTask); err != nil {
+			return err
+		}
+		if err := checkVersion(currentTask, task.ExpectedVersions); err != nil {
+			return err
+		}
+		if err := repo.UpdateAssignee(ctx, task, assigneeID); err != nil {
+			return err
+		}
+		changes := map[string]entities.FieldChange{
+			"assignee_id": {Old: currentTask.AssigneeId, New: assigneeID},
+		}
+		return recordEvent(ctx, repo, actor, entities.TaskEventAssigned, task.Id, changes)
+	})
+}
@@ -0,0 +1,87 @@
+package usecases_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestAssignTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{Visibility: usecases.VisibilityOwn})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	owned := &entities.Task{Id: 1, Version: 2, CreatedBy: lo.ToPtr("alice")}
+
+	inTransaction := func() {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+
+	t.Run("AssignToMe", func(t *testing.T) {
+		task := &entities.TaskUpdate{Id: 1, ExpectedVersions: []uint{2}}
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(owned, nil)
+		mockRepo.EXPECT().UpdateAssignee(ctx, task, lo.ToPtr("alice")).DoAndReturn(func(_ context.Context, task *entities.TaskUpdate, _ *string) error {
+			task.Version = 3
+			return nil
+		})
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.Equal(t, entities.TaskEventAssigned, event.Action)
+			var changes map[string]entities.FieldChange
+			assert.NoError(t, json.Unmarshal(event.Changes, &changes))
+			assert.Equal(t, entities.FieldChange{Old: nil, New: "alice"}, changes["assignee_id"])
+			return nil
+		})
+
+		err := usecase.AssignTask(ctx, alice, task, lo.ToPtr("me"))
+		if assert.NoError(t, err) {
+			assert.Equal(t, uint(3), task.Version)
+		}
+	})
+
+	t.Run("AssigneeUnassigns", func(t *testing.T) {
+		assigned := &entities.Task{Id: 2, CreatedBy: lo.ToPtr("alice"), AssigneeId: lo.ToPtr("bob")}
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(assigned, nil)
+		mockRepo.EXPECT().UpdateAssignee(ctx, gomock.Any(), nil).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+
+		err := usecase.AssignTask(ctx, entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleMember}, &entities.TaskUpdate{Id: 2}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		err := usecase.AssignTask(ctx, entities.Actor{Name: "anonymous"}, &entities.TaskUpdate{Id: 1}, lo.ToPtr("bob"))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindUnauthorized))
+	})
+
+	t.Run("HiddenFromOthers", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(owned, nil)
+
+		err := usecase.AssignTask(ctx, entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleMember}, &entities.TaskUpdate{Id: 1}, lo.ToPtr("carol"))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+	})
+
+	t.Run("VersionMismatch", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(owned, nil)
+
+		err := usecase.AssignTask(ctx, alice, &entities.TaskUpdate{Id: 1, ExpectedVersions: []uint{1}}, lo.ToPtr("bob"))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindPreconditionFailed))
+	})
+}
@@ -0,0 +1,186 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// ErrBatchAborted is the result of the operations of an atomic batch that were not applied
+// because another operation failed.
+var ErrBatchAborted = domainerrors.FailedDependency("operation not applied, another operation of the atomic batch failed")
+
+// batchError reports which operations of a batch made its transaction fail.
+type batchError struct {
+	positions []int
+	err       error
+}
+
+func (e *batchError) Error() string {
+	return e.err.Error()
+}
+
+func (e *batchError) Unwrap() error {
+	return e.err
+}
+
+// ExecuteBatch applies the operations of a batch and returns one result per operation.
+// Creates are inserted first with a multi-row insert, then the other operations run in order.
+// An atomic batch runs in one transaction that the first failure rolls back;
+// otherwise every operation succeeds or fails on its own.
+func (u *usecase) ExecuteBatch(ctx context.Context, actor entities.Actor, ops []models.TaskOperation, atomic bool) []models.TaskOperationResult {
+	results := make([]models.TaskOperationResult, len(ops))
+	var creates, others []int
+	for i, op := range ops {
+		results[i] = models.TaskOperationResult{Index: op.Index, Op: op.Op}
+		if op.Op == models.BatchOpCreate {
+			creates = append(creates, i)
+		} else {
+			others = append(others, i)
+		}
+	}
+
+	if atomic {
+		err := u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+			if err := u.createTasks(ctx, repo, actor, ops, creates, results); err != nil {
+				var batchErr *batchError
+				if errors.As(err, &batchErr) {
+					return batchErr
+				}
+				// The multi-row insert fails as a whole.
+				return &batchError{creates, err}
+			}
+			for _, i := range others {
+				if err := u.applyOperation(ctx, repo, actor, &ops[i], &results[i]); err != nil {
+					return &batchError{[]int{i}, err}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			abortBatch(results, err)
+		}
+		return results
+	}
+
+	if len(creates) > 0 {
+		err := u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+			return u.createTasks(ctx, repo, actor, ops, creates, results)
+		})
+		if err != nil {
+			// Fall back to one insert per task so one failing task does not fail the others.
+			for _, i := range creates {
+				err := u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+					return u.createTasks(ctx, repo, actor, ops, []int{i}, results)
+				})
+				var batchErr *batchError
+				if errors.As(err, &batchErr) {
+					err = batchErr.err
+				}
+				results[i].Err = err
+			}
+		}
+	}
+	for _, i := range others {
+		results[i].Err = u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+			return u.applyOperation(ctx, repo, actor, &ops[i], &results[i])
+		})
+	}
+	return results
+}
+
+// createTasks inserts the tasks of the create operations at positions and their events,
+// with one multi-row insert each. A create that fails on its own, such as with a missing parent,
+// is reported as a batchError of its position.
+func (u *usecase) createTasks(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, ops []models.TaskOperation, positions []int, results []models.TaskOperationResult) error {
+	if len(positions) == 0 {
+		return nil
+	}
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "create tasks"); err != nil {
+		return err
+	}
+	for _, i := range positions {
+		if parentID := ops[i].Task.ParentId; parentID != nil {
+			if err := u.checkParent(ctx, repo, actor, *parentID); err != nil {
+				return &batchError{[]int{i}, err}
+			}
+		}
+	}
+	workflow, err := u.workflow(ctx)
+	if err != nil {
+		return err
+	}
+	tasks := lo.Map(positions, func(i int, _ int) *entities.Task {
+		setInitialStatus(workflow, ops[i].Task)
+		setOwner(actor, ops[i].Task)
+		return ops[i].Task
+	})
+	if err := repo.CreateBatch(ctx, tasks); err != nil {
+		return err
+	}
+	events := make([]*entities.TaskEvent, 0, len(tasks))
+	for _, task := range tasks {
+		event, err := newEvent(actor, entities.TaskEventCreated, task.Id, createdChanges(task))
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+	if err := repo.CreateEvents(ctx, events); err != nil {
+		return err
+	}
+	for _, i := range positions {
+		results[i].Data = ops[i].Task
+	}
+	return nil
+}
+
+// applyOperation runs an update, status or delete operation in the transaction of repo.
+func (u *usecase) applyOperation(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, op *models.TaskOperation, result *models.TaskOperationResult) error {
+	switch op.Op {
+	case models.BatchOpUpdate:
+		if err := u.updateTask(ctx, repo, actor, op.Update); err != nil {
+			return err
+		}
+		result.Data = op.Update
+	case models.BatchOpStatus:
+		workflow, err := u.workflow(ctx)
+		if err != nil {
+			return err
+		}
+		if err := checkStatus(workflow, op.Update); err != nil {
+			return err
+		}
+		if err := u.updateTaskStatus(ctx, repo, actor, workflow, op.Update); err != nil {
+			return err
+		}
+		result.Data = op.Update
+	case models.BatchOpDelete:
+		return u.deleteTaskByID(ctx, repo, actor, op.Id)
+	default:
+		return domainerrors.Validation("unknown operation " + op.Op)
+	}
+	return nil
+}
+
+// abortBatch records the failure of an atomic batch: the failing operations get its error
+// and every other operation ErrBatchAborted. A failure of the transaction itself fails every operation.
+func abortBatch(results []models.TaskOperationResult, err error) {
+	var batchErr *batchError
+	if !errors.As(err, &batchErr) {
+		batchErr = &batchError{positions: lo.Range(len(results)), err: err}
+	}
+	for i := range results {
+		results[i].Data = nil
+		if lo.Contains(batchErr.positions, i) {
+			results[i].Err = batchErr.err
+		} else {
+			results[i].Err = ErrBatchAborted
+		}
+	}
+}
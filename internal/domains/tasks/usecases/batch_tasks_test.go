@@ -0,0 +1,166 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestExecuteBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	actor := entities.Actor{Name: "importer", RequestId: "request-1"}
+
+	// Run the transaction callback against the same mock repository, returning its error
+	inTransaction := func(times int) {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).Times(times).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+	newOps := func() []models.TaskOperation {
+		return []models.TaskOperation{
+			{Index: 0, Op: models.BatchOpCreate, Task: &entities.Task{Title: "First"}},
+			{Index: 1, Op: models.BatchOpCreate, Task: &entities.Task{Title: "Second"}},
+			{Index: 2, Op: models.BatchOpDelete, Id: 5},
+		}
+	}
+
+	t.Run("Atomic_Success", func(t *testing.T) {
+		ops := newOps()
+		inTransaction(1)
+		// Both creates go through one multi-row insert of tasks and one of events
+		mockRepo.EXPECT().CreateBatch(ctx, []*entities.Task{ops[0].Task, ops[1].Task}).DoAndReturn(func(_ context.Context, tasks []*entities.Task) error {
+			tasks[0].Id, tasks[1].Id = 1, 2
+			return nil
+		})
+		mockRepo.EXPECT().CreateEvents(ctx, gomock.Len(2)).DoAndReturn(func(_ context.Context, events []*entities.TaskEvent) error {
+			assert.Equal(t, uint(1), events[0].TaskId)
+			assert.Equal(t, entities.TaskEventCreated, events[1].Action)
+			assert.Equal(t, "importer", events[1].Actor)
+			return nil
+		})
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(5)).Return(&entities.Task{Id: 5}, nil)
+		mockRepo.EXPECT().DeleteByID(ctx, uint(5)).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+
+		results := usecase.ExecuteBatch(ctx, actor, ops, true)
+
+		for _, result := range results {
+			assert.NoError(t, result.Err)
+		}
+		assert.Equal(t, ops[0].Task, results[0].Data)
+	})
+
+	t.Run("Atomic_RolledBack", func(t *testing.T) {
+		ops := newOps()
+		notFound := domainerrors.NotFound("task not found")
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			// The failing operation is reported by the rolled back transaction
+			err := fn(mockRepo)
+			assert.Error(t, err)
+			return err
+		})
+		mockRepo.EXPECT().CreateBatch(ctx, gomock.Len(2)).Return(nil)
+		mockRepo.EXPECT().CreateEvents(ctx, gomock.Len(2)).Return(nil)
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(5)).Return(nil, notFound)
+
+		results := usecase.ExecuteBatch(ctx, actor, ops, true)
+
+		assert.Equal(t, usecases.ErrBatchAborted, results[0].Err)
+		assert.Equal(t, usecases.ErrBatchAborted, results[1].Err)
+		assert.Nil(t, results[0].Data)
+		assert.True(t, errors.Is(results[2].Err, notFound))
+	})
+
+	t.Run("Atomic_OneCreateFails", func(t *testing.T) {
+		ops := []models.TaskOperation{
+			{Index: 0, Op: models.BatchOpCreate, Task: &entities.Task{Title: "Top-level"}},
+			{Index: 1, Op: models.BatchOpCreate, Task: &entities.Task{Title: "Orphan", ParentId: lo.ToPtr(uint(9))}},
+		}
+		inTransaction(1)
+		mockRepo.EXPECT().GetByID(ctx, uint(9)).Return(nil, domainerrors.NotFound("task not found"))
+
+		results := usecase.ExecuteBatch(ctx, actor, ops, true)
+
+		// Only the create with the missing parent reports its error, the other one was not applied
+		assert.Equal(t, usecases.ErrBatchAborted, results[0].Err)
+		assert.True(t, domainerrors.IsKind(results[1].Err, domainerrors.KindValidation))
+	})
+
+	t.Run("Atomic_InsertFails", func(t *testing.T) {
+		ops := newOps()
+		inTransaction(1)
+		insertErr := domainerrors.Internal(errors.New("value too long"))
+		mockRepo.EXPECT().CreateBatch(ctx, gomock.Len(2)).Return(insertErr)
+
+		results := usecase.ExecuteBatch(ctx, actor, ops, true)
+
+		// The multi-row insert fails every create
+		assert.Equal(t, insertErr, results[0].Err)
+		assert.Equal(t, insertErr, results[1].Err)
+		assert.Equal(t, usecases.ErrBatchAborted, results[2].Err)
+	})
+
+	t.Run("BestEffort_FallsBackToSingleInserts", func(t *testing.T) {
+		ops := newOps()
+		inTransaction(4)
+		mockRepo.EXPECT().CreateBatch(ctx, gomock.Len(2)).Return(domainerrors.Internal(errors.New("value too long")))
+		mockRepo.EXPECT().CreateBatch(ctx, []*entities.Task{ops[0].Task}).Return(domainerrors.Internal(errors.New("value too long")))
+		mockRepo.EXPECT().CreateBatch(ctx, []*entities.Task{ops[1].Task}).Return(nil)
+		mockRepo.EXPECT().CreateEvents(ctx, gomock.Len(1)).Return(nil)
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(5)).Return(&entities.Task{Id: 5, Status: entities.TaskStatusDone}, nil)
+		mockRepo.EXPECT().DeleteByID(ctx, uint(5)).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+
+		results := usecase.ExecuteBatch(ctx, actor, ops, false)
+
+		assert.True(t, domainerrors.IsKind(results[0].Err, domainerrors.KindInternal))
+		assert.NoError(t, results[1].Err)
+		assert.Equal(t, ops[1].Task, results[1].Data)
+		assert.NoError(t, results[2].Err)
+	})
+
+	t.Run("BestEffort_MissingParent", func(t *testing.T) {
+		ops := []models.TaskOperation{
+			{Index: 0, Op: models.BatchOpCreate, Task: &entities.Task{Title: "Orphan", ParentId: lo.ToPtr(uint(9))}},
+			{Index: 1, Op: models.BatchOpCreate, Task: &entities.Task{Title: "Top-level"}},
+		}
+		inTransaction(3)
+		// The parent is checked for the batch insert, then again for the single insert of the subtask
+		mockRepo.EXPECT().GetByID(ctx, uint(9)).Times(2).Return(nil, domainerrors.NotFound("task not found"))
+		mockRepo.EXPECT().CreateBatch(ctx, []*entities.Task{ops[1].Task}).Return(nil)
+		mockRepo.EXPECT().CreateEvents(ctx, gomock.Len(1)).Return(nil)
+
+		results := usecase.ExecuteBatch(ctx, actor, ops, false)
+
+		assert.IsType(t, &domainerrors.Error{}, results[0].Err)
+		assert.True(t, domainerrors.IsKind(results[0].Err, domainerrors.KindValidation))
+		assert.NoError(t, results[1].Err)
+		assert.Equal(t, ops[1].Task, results[1].Data)
+	})
+
+	t.Run("BestEffort_InvalidStatus", func(t *testing.T) {
+		inTransaction(1)
+		ops := []models.TaskOperation{
+			{Index: 0, Op: models.BatchOpStatus, Update: &entities.TaskUpdate{Id: 1, Status: lo.ToPtr(entities.TaskStatus("UNKNOWN"))}},
+		}
+
+		results := usecase.ExecuteBatch(ctx, actor, ops, false)
+
+		assert.True(t, domainerrors.IsKind(results[0].Err, domainerrors.KindValidation))
+	})
+}
@@ -1,9 +1,50 @@
 package usecases
 
 import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
 	"github.com/supachai1998/task_services/internal/entities"
 )
 
-func (u *usecase) CreateTask(task *entities.Task) error {
-	return u.taskRepo.Create(task)
+func (u *usecase) CreateTask(ctx context.Context, actor entities.Actor, task *entities.Task) error {
+	return u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		return u.createTask(ctx, repo, actor, task)
+	})
+}
+
+func (u *usecase) createTask(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, task *entities.Task) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "create tasks"); err != nil {
+		return err
+	}
+	if task.ParentId != nil {
+		if err := u.checkParent(ctx, repo, actor, *task.ParentId); err != nil {
+			return err
+		}
+	}
+	workflow, err := u.workflow(ctx)
+	if err != nil {
+		return err
+	}
+	setInitialStatus(workflow, task)
+	setOwner(actor, task)
+	if err := repo.Create(ctx, task); err != nil {
+		return err
+	}
+	return recordEvent(ctx, repo, actor, entities.TaskEventCreated, task.Id, createdChanges(task))
+}
+
+// setOwner makes the user of actor the owner of a new task.
+func setOwner(actor entities.Actor, task *entities.Task) {
+	if actor.UserId != "" {
+		task.CreatedBy = lo.ToPtr(actor.UserId)
+	}
+}
+
+// setInitialStatus puts a new task in the initial status of workflow.
+func setInitialStatus(workflow *entities.Workflow, task *entities.Task) {
+	status := workflow.InitialStatus()
+	task.Status = status.Name
+	task.StatusCategory = status.Category
 }
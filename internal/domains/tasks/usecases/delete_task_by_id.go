@@ -1,5 +1,28 @@
 package usecases
 
-func (u *usecase) DeleteTaskByID(id uint) error {
-	return u.taskRepo.DeleteByID(id)
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) DeleteTaskByID(ctx context.Context, actor entities.Actor, id uint) error {
+	return u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		return u.deleteTaskByID(ctx, repo, actor, id)
+	})
+}
+
+func (u *usecase) deleteTaskByID(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, id uint) error {
+	currentTask, err := repo.GetByIDForUpdate(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := u.authorizeDelete(actor, currentTask); err != nil {
+		return err
+	}
+	if err := repo.DeleteByID(ctx, id); err != nil {
+		return err
+	}
+	return recordEvent(ctx, repo, actor, entities.TaskEventDeleted, id, deletedChanges(currentTask))
 }
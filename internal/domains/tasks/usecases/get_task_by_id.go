@@ -1,7 +1,24 @@
 package usecases
 
-import "github.com/supachai1998/task_services/internal/entities"
+import (
+	"context"
 
-func (u *usecase) GetTaskByID(id uint) (*entities.Task, error) {
-	return u.taskRepo.GetByID(id)
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) GetTaskByID(ctx context.Context, actor entities.Actor, id uint) (*entities.Task, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleViewer, "read tasks"); err != nil {
+		return nil, err
+	}
+	task, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.authorizeRead(actor, task); err != nil {
+		return nil, err
+	}
+	if err := annotate(ctx, u.taskRepo, task); err != nil {
+		return nil, err
+	}
+	return task, nil
 }
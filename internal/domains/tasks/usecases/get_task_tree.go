@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// GetTaskTree returns a task and its subtasks down to the depth of the query, each with its progress.
+// Subtasks the actor cannot read are left out with their own subtasks.
+func (u *usecase) GetTaskTree(ctx context.Context, actor entities.Actor, id uint, query *models.GetTaskTreeQuery) (*models.TaskTree, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleViewer, "read tasks"); err != nil {
+		return nil, err
+	}
+	if query.Depth <= 0 {
+		query.Depth = models.DefaultTreeDepth
+	}
+	if query.Depth > models.MaxTreeDepth {
+		query.Depth = models.MaxTreeDepth
+	}
+	root, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.authorizeRead(actor, root); err != nil {
+		return nil, err
+	}
+	descendants, err := u.taskRepo.ListDescendants(ctx, id, query.Depth, models.MaxTreeSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(descendants) > models.MaxTreeSize {
+		return nil, domainerrors.Validation(fmt.Sprintf("the tree has more than %d subtasks, request a lower depth", models.MaxTreeSize)).
+			WithDetail("max_tree_size", models.MaxTreeSize)
+	}
+
+	nodes := append([]*entities.Task{root}, taskPointers(descendants)...)
+	if err := annotate(ctx, u.taskRepo, nodes...); err != nil {
+		return nil, err
+	}
+	children := map[uint][]*entities.Task{}
+	for _, task := range nodes[1:] {
+		if u.authorizeRead(actor, task) == nil {
+			children[*task.ParentId] = append(children[*task.ParentId], task)
+		}
+	}
+	tree := buildTree(root, children)
+	return &tree, nil
+}
+
+// buildTree nests the children of task, by parent ID, under it.
+func buildTree(task *entities.Task, children map[uint][]*entities.Task) models.TaskTree {
+	tree := models.TaskTree{Task: *task, Children: []models.TaskTree{}}
+	for _, child := range children[task.Id] {
+		tree.Children = append(tree.Children, buildTree(child, children))
+	}
+	return tree
+}
@@ -1,23 +1,123 @@
 package usecases
 
 import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
 	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
 	"github.com/supachai1998/task_services/internal/entities"
 )
 
 type TaskUsecase interface {
-	CreateTask(task *entities.Task) error
-	UpdateTask(task *entities.TaskUpdate) error
-	UpdateTaskStatus(id uint, status entities.TaskStatus) error
-	GetTaskByID(id uint) (*entities.Task, error)
-	DeleteTaskByID(id uint) error
-	ListTasks() ([]entities.Task, error)
+	CreateTask(ctx context.Context, actor entities.Actor, task *entities.Task) error
+	UpdateTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate) error
+	UpdateTaskStatus(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate) error
+	// AssignTask assigns a task to assigneeID, "me" or nobody when it is nil.
+	AssignTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate, assigneeID *string) error
+	// MoveTask makes a task a subtask of parentID, or a top-level task when it is nil.
+	MoveTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate, parentID *uint) error
+	GetTaskByID(ctx context.Context, actor entities.Actor, id uint) (*entities.Task, error)
+	// GetTaskTree returns a task and its subtasks down to the depth of the query.
+	GetTaskTree(ctx context.Context, actor entities.Actor, id uint, query *models.GetTaskTreeQuery) (*models.TaskTree, error)
+	DeleteTaskByID(ctx context.Context, actor entities.Actor, id uint) error
+	RestoreTask(ctx context.Context, actor entities.Actor, id uint) (*entities.Task, error)
+	ListTasks(ctx context.Context, actor entities.Actor, query *models.ListTasksQuery) ([]entities.Task, string, error)
+	ListTaskChildren(ctx context.Context, actor entities.Actor, id uint, query *models.ListTaskChildrenQuery) ([]entities.Task, string, error)
+	SearchTasks(ctx context.Context, actor entities.Actor, query *models.SearchTasksQuery) ([]models.TaskSearchResult, string, error)
+	ExecuteBatch(ctx context.Context, actor entities.Actor, ops []models.TaskOperation, atomic bool) []models.TaskOperationResult
+	// AddTaskDependency records that the task blockerID blocks the task blockedID.
+	AddTaskDependency(ctx context.Context, actor entities.Actor, blockedID, blockerID uint) (*entities.TaskDependency, error)
+	RemoveTaskDependency(ctx context.Context, actor entities.Actor, blockedID, blockerID uint) error
+	// ListTaskDependencies lists the dependencies a task is the blocker or the blocked task of.
+	ListTaskDependencies(ctx context.Context, actor entities.Actor, id uint) ([]entities.TaskDependency, error)
+	// AddTaskLabel puts the label labelID on the task taskID.
+	AddTaskLabel(ctx context.Context, actor entities.Actor, taskID, labelID uint) (*entities.TaskLabel, error)
+	RemoveTaskLabel(ctx context.Context, actor entities.Actor, taskID, labelID uint) error
+	ListTaskHistory(ctx context.Context, actor entities.Actor, id uint, query *models.ListTaskHistoryQuery) ([]entities.TaskEvent, string, error)
+}
+
+// TaskStream streams the changes of the tasks to the subscribers of this instance. Every instance
+// is notified of the changes of every instance through Postgres, as HandleNotification.
+type TaskStream interface {
+	// Subscribe streams the changes of the tasks of the workspace of ctx that match query and that
+	// actor can read, replaying first the changes after query.LastEventId.
+	Subscribe(ctx context.Context, actor entities.Actor, query *models.StreamTasksQuery) (*Subscription, error)
+	// HandleNotification streams the task event of a notification of entities.TaskEventsChannel.
+	HandleNotification(ctx context.Context, payload string)
+	// Reset ends every subscription, so the subscribers resume after their last change; it is
+	// called when notifications may have been missed.
+	Reset()
+}
+
+// Subscription is the stream of the changes of one subscriber.
+type Subscription struct {
+	// Replay are the changes after the last event ID of the query, in ID order, or a single
+	// models.TaskChangeReset change when there are more than the replay limit.
+	Replay []models.TaskChange
+	// Changes receives the live changes, which start before the replay and may repeat it.
+	// It is closed when the subscriber falls behind by more than the buffer size or on Reset;
+	// the subscriber then resumes after its last change.
+	Changes <-chan models.TaskChange
+	// replayed holds the IDs of the events of the replay.
+	replayed map[uint]bool
+	close    func()
+}
+
+// Replayed reports whether the change id of Changes was already part of Replay.
+func (s *Subscription) Replayed(id uint) bool {
+	return s.replayed[id]
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	if s.close != nil {
+		s.close()
+	}
+}
+
+// StreamOptions tunes the task stream.
+type StreamOptions struct {
+	// BufferSize is the number of changes a subscriber can fall behind by, 64 by default.
+	BufferSize int
+	// ReplayLimit is the number of changes a resuming subscriber can have missed, 500 by default.
+	ReplayLimit int
+}
+
+// errAdminRequired is returned when a non-admin actor uses an admin-only feature.
+var errAdminRequired = domainerrors.Forbidden("admin privileges required")
+
+// Options tunes the task usecase.
+type Options struct {
+	// Visibility decides whether users see the tasks they neither own nor are assigned to.
+	// The default is VisibilityAll.
+	Visibility Visibility
+	// Now is the clock of the overdue filter, time.Now by default.
+	Now func() time.Time
+	// Workflows returns the workflow of the tasks; the default workflow applies when it is nil.
+	Workflows interfaces.WorkflowReader
 }
 
 type usecase struct {
 	taskRepo interfaces.TaskRepository
+	options  Options
+}
+
+func NewTaskUsecase(taskRepo interfaces.TaskRepository, options Options) TaskUsecase {
+	if options.Visibility == "" {
+		options.Visibility = VisibilityAll
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return &usecase{taskRepo, options}
 }
 
-func NewTaskUsecase(taskRepo interfaces.TaskRepository) TaskUsecase {
-	return &usecase{taskRepo}
+// workflow returns the workflow of the tasks of the workspace of ctx.
+func (u *usecase) workflow(ctx context.Context) (*entities.Workflow, error) {
+	if u.options.Workflows == nil {
+		return entities.DefaultWorkflow(), nil
+	}
+	return u.options.Workflows.GetActiveWorkflow(ctx)
 }
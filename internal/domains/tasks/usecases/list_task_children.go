@@ -0,0 +1,27 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// ListTaskChildren lists the direct subtasks of a task the actor can read, by ID.
+func (u *usecase) ListTaskChildren(ctx context.Context, actor entities.Actor, id uint, query *models.ListTaskChildrenQuery) ([]entities.Task, string, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleViewer, "read tasks"); err != nil {
+		return nil, "", err
+	}
+	parent, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := u.authorizeRead(actor, parent); err != nil {
+		return nil, "", err
+	}
+	return u.ListTasks(ctx, actor, &models.ListTasksQuery{
+		ParentId: &id,
+		Limit:    query.Limit,
+		Cursor:   query.Cursor,
+	})
+}
@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListTaskHistory(ctx context.Context, actor entities.Actor, id uint, query *models.ListTaskHistoryQuery) ([]entities.TaskEvent, string, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleViewer, "read tasks"); err != nil {
+		return nil, "", err
+	}
+	// The history of a deleted task stays readable.
+	task, err := u.taskRepo.GetByIDUnscoped(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := u.authorizeRead(actor, task); err != nil {
+		return nil, "", err
+	}
+	if query.Limit <= 0 {
+		query.Limit = models.DefaultListLimit
+	}
+	if query.Limit > models.MaxListLimit {
+		query.Limit = models.MaxListLimit
+	}
+	return u.taskRepo.ListEvents(ctx, id, query)
+}
@@ -1,7 +1,64 @@
 package usecases
 
-import "github.com/supachai1998/task_services/internal/entities"
+import (
+	"context"
+	"strings"
 
-func (u *usecase) ListTasks() ([]entities.Task, error) {
-	return u.taskRepo.List()
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListTasks(ctx context.Context, actor entities.Actor, query *models.ListTasksQuery) ([]entities.Task, string, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleViewer, "read tasks"); err != nil {
+		return nil, "", err
+	}
+	if query.IncludeDeleted && !isAdmin(actor) {
+		return nil, "", errAdminRequired
+	}
+	var err error
+	if query.Owner, err = resolveUser(actor, query.Owner); err != nil {
+		return nil, "", err
+	}
+	if query.Assignee, err = resolveUser(actor, query.Assignee); err != nil {
+		return nil, "", err
+	}
+	query.VisibleTo = u.visibleTo(actor)
+	query.Label = normalizeLabels(query.Label)
+	if query.Overdue {
+		now := u.options.Now()
+		query.DueBefore = &now
+	}
+	// Apply the defaults of the list query.
+	if query.Limit <= 0 {
+		query.Limit = models.DefaultListLimit
+	}
+	if query.Limit > models.MaxListLimit {
+		query.Limit = models.MaxListLimit
+	}
+	if query.Sort == "" {
+		query.Sort = "id"
+	}
+	if query.Order == "" {
+		query.Order = models.SortOrderAsc
+	}
+	tasks, nextCursor, err := u.taskRepo.List(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := annotate(ctx, u.taskRepo, taskPointers(tasks)...); err != nil {
+		return nil, "", err
+	}
+	return tasks, nextCursor, nil
+}
+
+// normalizeLabels returns the distinct names of the label filter in lower case, as label names
+// match ignoring case.
+func normalizeLabels(names []string) []string {
+	if len(names) == 0 {
+		return names
+	}
+	return lo.Uniq(lo.Map(names, func(name string, _ int) string {
+		return strings.ToLower(strings.TrimSpace(name))
+	}))
 }
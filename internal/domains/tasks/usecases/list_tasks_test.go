@@ -0,0 +1,73 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestListTasks_Overdue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{Now: func() time.Time { return now }})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+
+	t.Run("DueBeforeNow", func(t *testing.T) {
+		mockRepo.EXPECT().List(ctx, &models.ListTasksQuery{
+			Overdue: true, DueBefore: &now, Sort: "due_at", Order: models.SortOrderAsc, Limit: models.DefaultListLimit,
+		}).Return(nil, "", nil)
+
+		_, _, err := usecase.ListTasks(ctx, alice, &models.ListTasksQuery{Overdue: true, Sort: "due_at"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotOverdue", func(t *testing.T) {
+		mockRepo.EXPECT().List(ctx, &models.ListTasksQuery{
+			Sort: "priority", Order: models.SortOrderDesc, Limit: models.DefaultListLimit,
+		}).Return(nil, "", nil)
+
+		_, _, err := usecase.ListTasks(ctx, alice, &models.ListTasksQuery{Sort: "priority", Order: models.SortOrderDesc})
+		assert.NoError(t, err)
+	})
+}
+
+func TestListTasks_Labels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	bug := entities.Label{Id: 7, Name: "Bug", Color: "#d73a4a"}
+
+	// Label names are matched in lowercase, once each.
+	mockRepo.EXPECT().List(ctx, &models.ListTasksQuery{
+		Label: []string{"bug", "ui"}, LabelMatch: models.LabelMatchAll, Sort: "id", Order: models.SortOrderAsc, Limit: models.DefaultListLimit,
+	}).Return([]entities.Task{{Id: 1}, {Id: 2}}, "", nil)
+	mockRepo.EXPECT().ChildProgress(ctx, []uint{1, 2}).Return(map[uint]entities.TaskProgress{}, nil)
+	mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{1, 2}).Return(map[uint][]uint{}, nil)
+	mockRepo.EXPECT().ListLabels(ctx, []uint{1, 2}).Return(map[uint][]entities.Label{1: {bug}}, nil)
+	mockRepo.EXPECT().CountComments(ctx, []uint{1, 2}).Return(map[uint]int{}, nil)
+
+	tasks, _, err := usecase.ListTasks(ctx, alice, &models.ListTasksQuery{
+		Label: []string{"Bug", "ui", "BUG"}, LabelMatch: models.LabelMatchAll,
+	})
+
+	if assert.NoError(t, err) && assert.Len(t, tasks, 2) {
+		assert.Equal(t, []entities.Label{bug}, tasks[0].Labels)
+		// Tasks without labels have an empty list, not null.
+		assert.Equal(t, []entities.Label{}, tasks[1].Labels)
+	}
+}
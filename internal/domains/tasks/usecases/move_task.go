@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// MoveTask makes a task a subtask of parentID, or a top-level task when it is nil; only the owner,
+// the assignee or an admin can. The new parent cannot be the task or one of its subtasks.
+func (u *usecase) MoveTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate, parentID *uint) error {
+	return u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		currentTask, err := repo.GetByIDForUpdate(ctx, task.Id)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeChange(actor, currentTask); err != nil {
+			return err
+		}
+		if err := checkVersion(currentTask, task.ExpectedVersions); err != nil {
+			return err
+		}
+		if parentID != nil {
+			if err := u.checkParent(ctx, repo, actor, *parentID); err != nil {
+				return err
+			}
+			// Read the ancestors of the new parent under the lock so a concurrent move cannot close a cycle.
+			if err := repo.LockHierarchy(ctx); err != nil {
+				return err
+			}
+			ancestors, err := repo.ListAncestorIDs(ctx, *parentID)
+			if err != nil {
+				return err
+			}
+			if lo.Contains(ancestors, task.Id) {
+				return errParentCycle.WithDetail("parent_id", *parentID)
+			}
+		}
+		if err := repo.UpdateParent(ctx, task, parentID); err != nil {
+			return err
+		}
+		changes := map[string]entities.FieldChange{
+			"parent_id": {Old: currentTask.ParentId, New: parentID},
+		}
+		return recordEvent(ctx, repo, actor, entities.TaskEventMoved, task.Id, changes)
+	})
+}
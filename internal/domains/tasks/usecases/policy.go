@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"fmt"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// Visibility decides what users see of the tasks they neither own nor are assigned to.
+type Visibility string
+
+const (
+	// VisibilityAll lets every user read every task.
+	VisibilityAll Visibility = "all"
+	// VisibilityOwn hides the tasks a user neither owns nor is assigned to.
+	VisibilityOwn Visibility = "own"
+)
+
+// ParseVisibility parses the TASK_VISIBILITY setting.
+func ParseVisibility(s string) (Visibility, error) {
+	switch visibility := Visibility(s); visibility {
+	case VisibilityAll, VisibilityOwn:
+		return visibility, nil
+	default:
+		return "", fmt.Errorf("unknown task visibility %q", s)
+	}
+}
+
+var (
+	errTaskNotFound           = domainerrors.NotFound("task not found")
+	errAuthenticationRequired = domainerrors.Unauthorized("authentication required")
+	errNotOwnerOrAssignee     = domainerrors.Forbidden("only the owner or the assignee of the task can change it")
+	errNotOwner               = domainerrors.Forbidden("only the owner of the task can delete it")
+)
+
+// Authorization policy: admins, including the admins of the workspace, and anonymous actors,
+// which only exist when authentication is disabled, can do anything. Otherwise the workspace role
+// of the actor must allow the action: viewers read tasks, members also create and change them.
+// Then the owner and the assignee of a task can change it, only its owner can delete it, and other
+// users can read it unless the visibility is VisibilityOwn, in which case it is not found.
+// Tasks without an owner can only be changed by admins.
+
+// isAdmin reports whether actor has admin privileges or is an admin of the workspace.
+func isAdmin(actor entities.Actor) bool {
+	return actor.Admin || actor.Role == entities.WorkspaceRoleAdmin
+}
+
+// unrestricted reports whether the policy does not apply to actor.
+func unrestricted(actor entities.Actor) bool {
+	return isAdmin(actor) || actor.UserId == ""
+}
+
+// authorizeRole fails when the workspace role of actor does not include required; action
+// completes the reason of the error, as in "the member role is required to create tasks".
+func authorizeRole(actor entities.Actor, required entities.WorkspaceRole, action string) error {
+	if unrestricted(actor) || actor.Role.Includes(required) {
+		return nil
+	}
+	return domainerrors.Forbidden(fmt.Sprintf("the %s role is required to %s", required, action)).
+		WithDetail("role", actor.Role).
+		WithDetail("required_role", required)
+}
+
+func isOwner(actor entities.Actor, task *entities.Task) bool {
+	return task.CreatedBy != nil && *task.CreatedBy == actor.UserId
+}
+
+func isAssignee(actor entities.Actor, task *entities.Task) bool {
+	return task.AssigneeId != nil && *task.AssigneeId == actor.UserId
+}
+
+// visibleTo returns the user whose tasks are the only ones actor can read, or "" for every task.
+func (u *usecase) visibleTo(actor entities.Actor) string {
+	if unrestricted(actor) || u.options.Visibility != VisibilityOwn {
+		return ""
+	}
+	return actor.UserId
+}
+
+// authorizeRead fails with not found when actor cannot read task.
+func (u *usecase) authorizeRead(actor entities.Actor, task *entities.Task) error {
+	if u.visibleTo(actor) == "" || isOwner(actor, task) || isAssignee(actor, task) {
+		return nil
+	}
+	return errTaskNotFound
+}
+
+// authorizeChange fails when actor can neither update the task nor change its status or assignee.
+func (u *usecase) authorizeChange(actor entities.Actor, task *entities.Task) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "change tasks"); err != nil {
+		return err
+	}
+	if unrestricted(actor) || isOwner(actor, task) || isAssignee(actor, task) {
+		return nil
+	}
+	if err := u.authorizeRead(actor, task); err != nil {
+		return err
+	}
+	return errNotOwnerOrAssignee
+}
+
+// authorizeDelete fails when actor cannot delete task.
+func (u *usecase) authorizeDelete(actor entities.Actor, task *entities.Task) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleMember, "delete tasks"); err != nil {
+		return err
+	}
+	if unrestricted(actor) || isOwner(actor, task) {
+		return nil
+	}
+	if err := u.authorizeRead(actor, task); err != nil {
+		return err
+	}
+	return errNotOwner
+}
+
+// resolveUser replaces "me" with the user of actor.
+func resolveUser(actor entities.Actor, user string) (string, error) {
+	if user != models.Me {
+		return user, nil
+	}
+	if actor.UserId == "" {
+		return "", errAuthenticationRequired
+	}
+	return actor.UserId, nil
+}
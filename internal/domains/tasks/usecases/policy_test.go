@@ -0,0 +1,248 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestTaskPolicy(t *testing.T) {
+	task := &entities.Task{Id: 1, Title: "Task", Status: entities.TaskStatusToDo, CreatedBy: lo.ToPtr("alice"), AssigneeId: lo.ToPtr("bob")}
+	unowned := &entities.Task{Id: 1, Title: "Task", Status: entities.TaskStatusToDo}
+
+	var (
+		owner     = entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+		assignee  = entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleMember}
+		other     = entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleMember}
+		admin     = entities.Actor{Name: "dave", UserId: "dave", Admin: true}
+		anonymous = entities.Actor{Name: "anonymous"}
+	)
+
+	cases := []struct {
+		name         string
+		visibility   usecases.Visibility
+		actor        entities.Actor
+		task         *entities.Task
+		expectedRead domainerrors.Kind
+		// expectedUpdate and expectedDelete are the errors of an update and a delete, "" when allowed.
+		expectedUpdate domainerrors.Kind
+		expectedDelete domainerrors.Kind
+	}{
+		{"Owner", usecases.VisibilityOwn, owner, task, "", "", ""},
+		{"Assignee", usecases.VisibilityOwn, assignee, task, "", "", domainerrors.KindForbidden},
+		{"OtherReadOnly", usecases.VisibilityAll, other, task, "", domainerrors.KindForbidden, domainerrors.KindForbidden},
+		{"OtherHidden", usecases.VisibilityOwn, other, task, domainerrors.KindNotFound, domainerrors.KindNotFound, domainerrors.KindNotFound},
+		{"Admin", usecases.VisibilityOwn, admin, task, "", "", ""},
+		{"AuthenticationDisabled", usecases.VisibilityOwn, anonymous, task, "", "", ""},
+		{"UnownedTask", usecases.VisibilityAll, owner, unowned, "", domainerrors.KindForbidden, domainerrors.KindForbidden},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{Visibility: tc.visibility})
+			ctx := context.Background()
+			mockRepo.EXPECT().Transaction(ctx, gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+				return fn(mockRepo)
+			})
+			mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(tc.task, nil)
+			mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Times(2).Return(tc.task, nil)
+			if tc.expectedRead == "" {
+				mockRepo.EXPECT().ChildProgress(ctx, []uint{1}).Return(map[uint]entities.TaskProgress{}, nil)
+				mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{1}).Return(map[uint][]uint{}, nil)
+				mockRepo.EXPECT().ListLabels(ctx, []uint{1}).Return(map[uint][]entities.Label{}, nil)
+				mockRepo.EXPECT().CountComments(ctx, []uint{1}).Return(map[uint]int{}, nil)
+			}
+			if tc.expectedUpdate == "" {
+				mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+			}
+			if tc.expectedDelete == "" {
+				mockRepo.EXPECT().DeleteByID(ctx, uint(1)).Return(nil)
+				mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+			}
+
+			_, err := usecase.GetTaskByID(ctx, tc.actor, 1)
+			assertKind(t, tc.expectedRead, err)
+
+			err = usecase.UpdateTask(ctx, tc.actor, &entities.TaskUpdate{Id: 1, Title: lo.ToPtr("New title")})
+			assertKind(t, tc.expectedUpdate, err)
+
+			err = usecase.DeleteTaskByID(ctx, tc.actor, 1)
+			assertKind(t, tc.expectedDelete, err)
+		})
+	}
+}
+
+func TestListTasks_Ownership(t *testing.T) {
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+
+	cases := []struct {
+		name         string
+		visibility   usecases.Visibility
+		actor        entities.Actor
+		query        models.ListTasksQuery
+		expected     *models.ListTasksQuery
+		expectedKind domainerrors.Kind
+	}{
+		{
+			"OwnerAndAssigneeMe", usecases.VisibilityAll, alice,
+			models.ListTasksQuery{Owner: "me", Assignee: "me"},
+			&models.ListTasksQuery{Owner: "alice", Assignee: "alice", Sort: "id", Order: models.SortOrderAsc, Limit: models.DefaultListLimit},
+			"",
+		},
+		{
+			"OtherUser", usecases.VisibilityAll, alice,
+			models.ListTasksQuery{Assignee: "bob"},
+			&models.ListTasksQuery{Assignee: "bob", Sort: "id", Order: models.SortOrderAsc, Limit: models.DefaultListLimit},
+			"",
+		},
+		{
+			"VisibleToOwnTasks", usecases.VisibilityOwn, alice,
+			models.ListTasksQuery{VisibleTo: "bob"},
+			&models.ListTasksQuery{VisibleTo: "alice", Sort: "id", Order: models.SortOrderAsc, Limit: models.DefaultListLimit},
+			"",
+		},
+		{
+			"AdminSeesEverything", usecases.VisibilityOwn, entities.Actor{Name: "dave", UserId: "dave", Admin: true},
+			models.ListTasksQuery{},
+			&models.ListTasksQuery{Sort: "id", Order: models.SortOrderAsc, Limit: models.DefaultListLimit},
+			"",
+		},
+		{
+			"MeWithoutAuthentication", usecases.VisibilityAll, entities.Actor{Name: "anonymous"},
+			models.ListTasksQuery{Owner: "me"},
+			nil,
+			domainerrors.KindUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{Visibility: tc.visibility})
+			ctx := context.Background()
+			if tc.expected != nil {
+				mockRepo.EXPECT().List(ctx, tc.expected).Return(nil, "", nil)
+			}
+
+			_, _, err := usecase.ListTasks(ctx, tc.actor, &tc.query)
+			assertKind(t, tc.expectedKind, err)
+		})
+	}
+}
+
+// assertKind asserts that err is nil when kind is empty, or a domain error of that kind.
+func assertKind(t *testing.T, kind domainerrors.Kind, err error) {
+	t.Helper()
+	if kind == "" {
+		assert.NoError(t, err)
+		return
+	}
+	assert.True(t, domainerrors.IsKind(err, kind), "expected a %s error, got %v", kind, err)
+}
+
+func TestWorkspaceRoles(t *testing.T) {
+	ctx := context.Background()
+	actorWith := func(role entities.WorkspaceRole) entities.Actor {
+		return entities.Actor{Name: "alice", UserId: "alice", Role: role}
+	}
+	newUsecase := func(t *testing.T) (usecases.TaskUsecase, *mocks.MockTaskRepository) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).AnyTimes().DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+		return usecases.NewTaskUsecase(mockRepo, usecases.Options{}), mockRepo
+	}
+
+	t.Run("ViewerCannotCreate", func(t *testing.T) {
+		usecase, _ := newUsecase(t)
+
+		err := usecase.CreateTask(ctx, actorWith(entities.WorkspaceRoleViewer), &entities.Task{Title: "Task"})
+
+		var domainErr *domainerrors.Error
+		if assert.ErrorAs(t, err, &domainErr) {
+			assert.Equal(t, domainerrors.KindForbidden, domainErr.Kind)
+			assert.Equal(t, "the member role is required to create tasks", domainErr.Message)
+			assert.Equal(t, entities.WorkspaceRoleViewer, domainErr.Details["role"])
+			assert.Equal(t, entities.WorkspaceRoleMember, domainErr.Details["required_role"])
+		}
+	})
+
+	t.Run("ViewerCannotChangeOwnTask", func(t *testing.T) {
+		usecase, mockRepo := newUsecase(t)
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(&entities.Task{Id: 1, CreatedBy: lo.ToPtr("alice")}, nil)
+
+		err := usecase.UpdateTask(ctx, actorWith(entities.WorkspaceRoleViewer), &entities.TaskUpdate{Id: 1, Title: lo.ToPtr("New title")})
+
+		assertKind(t, domainerrors.KindForbidden, err)
+	})
+
+	t.Run("ViewerReads", func(t *testing.T) {
+		usecase, mockRepo := newUsecase(t)
+		mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(&entities.Task{Id: 1}, nil)
+		mockRepo.EXPECT().ChildProgress(ctx, []uint{1}).Return(map[uint]entities.TaskProgress{}, nil)
+		mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{1}).Return(map[uint][]uint{}, nil)
+		mockRepo.EXPECT().ListLabels(ctx, []uint{1}).Return(map[uint][]entities.Label{}, nil)
+		mockRepo.EXPECT().CountComments(ctx, []uint{1}).Return(map[uint]int{}, nil)
+
+		_, err := usecase.GetTaskByID(ctx, actorWith(entities.WorkspaceRoleViewer), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("NoRoleCannotRead", func(t *testing.T) {
+		usecase, _ := newUsecase(t)
+
+		_, _, err := usecase.ListTasks(ctx, actorWith(""), &models.ListTasksQuery{})
+
+		assertKind(t, domainerrors.KindForbidden, err)
+	})
+
+	transitions := []struct {
+		name         string
+		role         entities.WorkspaceRole
+		to           entities.TaskStatus
+		expectedKind domainerrors.Kind
+	}{
+		{"MemberStartsTask", entities.WorkspaceRoleMember, entities.TaskStatusInProgress, ""},
+		{"MemberCannotSkipToDone", entities.WorkspaceRoleMember, entities.TaskStatusDone, domainerrors.KindForbidden},
+		{"AdminSkipsToDone", entities.WorkspaceRoleAdmin, entities.TaskStatusDone, ""},
+		{"ViewerCannotStartTask", entities.WorkspaceRoleViewer, entities.TaskStatusInProgress, domainerrors.KindForbidden},
+	}
+	for _, tc := range transitions {
+		t.Run(tc.name, func(t *testing.T) {
+			usecase, mockRepo := newUsecase(t)
+			mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(&entities.Task{Id: 1, Status: entities.TaskStatusToDo, CreatedBy: lo.ToPtr("alice")}, nil)
+			if tc.expectedKind == "" {
+				mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{1}).Return(map[uint][]uint{}, nil)
+				if tc.to == entities.TaskStatusDone {
+					mockRepo.EXPECT().ChildProgress(ctx, []uint{1}).Return(map[uint]entities.TaskProgress{}, nil)
+				}
+				mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+			}
+
+			err := usecase.UpdateTaskStatus(ctx, actorWith(tc.role), &entities.TaskUpdate{Id: 1, Status: lo.ToPtr(tc.to)})
+
+			assertKind(t, tc.expectedKind, err)
+		})
+	}
+}
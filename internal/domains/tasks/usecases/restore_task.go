@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// RestoreTask undoes the soft delete of a task; admin only.
+func (u *usecase) RestoreTask(ctx context.Context, actor entities.Actor, id uint) (*entities.Task, error) {
+	if !isAdmin(actor) {
+		return nil, errAdminRequired
+	}
+
+	var task *entities.Task
+	err := u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		currentTask, err := repo.GetByIDUnscopedForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !currentTask.DeletedAt.Valid {
+			return domainerrors.Conflict("task is not deleted")
+		}
+		if err := repo.Restore(ctx, currentTask); err != nil {
+			return err
+		}
+		task = currentTask
+		return recordEvent(ctx, repo, actor, entities.TaskEventRestored, id, createdChanges(currentTask))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
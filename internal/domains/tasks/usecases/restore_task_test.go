@@ -0,0 +1,83 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+	"gorm.io/gorm"
+)
+
+func TestRestoreTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	admin := entities.Actor{Name: "alice", RequestId: "request-1", Admin: true}
+
+	// Run the transaction callback against the same mock repository
+	inTransaction := func() {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		deleted := &entities.Task{
+			Id:        1,
+			Title:     "Deleted task",
+			Status:    entities.TaskStatusToDo,
+			Version:   2,
+			DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true},
+		}
+		inTransaction()
+		mockRepo.EXPECT().GetByIDUnscopedForUpdate(ctx, uint(1)).Return(deleted, nil)
+		mockRepo.EXPECT().Restore(ctx, deleted).DoAndReturn(func(_ context.Context, task *entities.Task) error {
+			task.DeletedAt = gorm.DeletedAt{}
+			task.Version = 3
+			return nil
+		})
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.Equal(t, uint(1), event.TaskId)
+			assert.Equal(t, entities.TaskEventRestored, event.Action)
+			assert.Equal(t, "alice", event.Actor)
+			return nil
+		})
+
+		task, err := usecase.RestoreTask(ctx, admin, 1)
+		if assert.NoError(t, err) {
+			assert.Equal(t, uint(3), task.Version)
+			assert.False(t, task.DeletedAt.Valid)
+		}
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		_, err := usecase.RestoreTask(ctx, entities.Actor{Name: "bob"}, 1)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+
+	t.Run("NotDeleted", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDUnscopedForUpdate(ctx, uint(2)).Return(&entities.Task{Id: 2}, nil)
+
+		_, err := usecase.RestoreTask(ctx, admin, 2)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDUnscopedForUpdate(ctx, uint(3)).Return(nil, domainerrors.NotFound("task not found"))
+
+		_, err := usecase.RestoreTask(ctx, admin, 3)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+	})
+}
@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) SearchTasks(ctx context.Context, actor entities.Actor, query *models.SearchTasksQuery) ([]models.TaskSearchResult, string, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleViewer, "read tasks"); err != nil {
+		return nil, "", err
+	}
+	query.VisibleTo = u.visibleTo(actor)
+	if query.Limit <= 0 {
+		query.Limit = models.DefaultListLimit
+	}
+	if query.Limit > models.MaxListLimit {
+		query.Limit = models.MaxListLimit
+	}
+	return u.taskRepo.Search(ctx, query)
+}
@@ -0,0 +1,259 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+const (
+	defaultStreamBufferSize  = 64
+	defaultStreamReplayLimit = 500
+)
+
+type subscriber struct {
+	actor   entities.Actor
+	query   *models.StreamTasksQuery
+	changes chan models.TaskChange
+}
+
+type stream struct {
+	*usecase
+	options StreamOptions
+
+	mu sync.Mutex
+	// subscribers holds the subscribers of this instance by workspace.
+	subscribers map[uint]map[*subscriber]bool
+}
+
+// NewTaskStream returns the task stream of this instance; options are the options of the task
+// usecase, whose visibility applies to the streamed tasks.
+func NewTaskStream(taskRepo interfaces.TaskRepository, options Options, streamOptions StreamOptions) TaskStream {
+	if streamOptions.BufferSize <= 0 {
+		streamOptions.BufferSize = defaultStreamBufferSize
+	}
+	if streamOptions.ReplayLimit <= 0 {
+		streamOptions.ReplayLimit = defaultStreamReplayLimit
+	}
+	return &stream{
+		usecase:     NewTaskUsecase(taskRepo, options).(*usecase),
+		options:     streamOptions,
+		subscribers: map[uint]map[*subscriber]bool{},
+	}
+}
+
+func (s *stream) Subscribe(ctx context.Context, actor entities.Actor, query *models.StreamTasksQuery) (*Subscription, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleViewer, "read tasks"); err != nil {
+		return nil, err
+	}
+	query.Label = normalizeLabels(query.Label)
+	workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+	sub := &subscriber{
+		actor:   actor,
+		query:   query,
+		changes: make(chan models.TaskChange, s.options.BufferSize),
+	}
+	// Subscribe before reading the replay, so no change falls between the two.
+	s.add(workspaceID, sub)
+	subscription := &Subscription{
+		Changes:  sub.changes,
+		replayed: map[uint]bool{},
+		close: func() {
+			s.remove(workspaceID, sub)
+		},
+	}
+	if query.LastEventId == 0 {
+		return subscription, nil
+	}
+
+	events, err := s.taskRepo.ListEventsAfter(ctx, query.LastEventId, s.options.ReplayLimit+1)
+	if err != nil {
+		subscription.Close()
+		return nil, err
+	}
+	if len(events) > s.options.ReplayLimit {
+		subscription.Replay = []models.TaskChange{{Type: models.TaskChangeReset}}
+		return subscription, nil
+	}
+	changes, err := s.load(ctx, events)
+	if err != nil {
+		subscription.Close()
+		return nil, err
+	}
+	subscription.Replay = []models.TaskChange{}
+	for _, change := range changes {
+		subscription.replayed[change.Id] = true
+		if change, ok := s.changeFor(sub, change); ok {
+			subscription.Replay = append(subscription.Replay, change)
+		}
+	}
+	return subscription, nil
+}
+
+// HandleNotification reads the task event once for every subscriber of the workspace, and not at
+// all when this instance has none.
+func (s *stream) HandleNotification(ctx context.Context, payload string) {
+	var notification entities.TaskEventNotification
+	if err := json.Unmarshal([]byte(payload), &notification); err != nil {
+		log.Printf("task stream: invalid notification %q: %v", payload, err)
+		return
+	}
+	if s.count(notification.WorkspaceId) == 0 {
+		return
+	}
+	ctx = helpers.ContextWithWorkspaceID(ctx, notification.WorkspaceId)
+	event, err := s.taskRepo.GetEvent(ctx, notification.Id)
+	if err != nil {
+		log.Printf("task stream: cannot read task event %d: %v", notification.Id, err)
+		return
+	}
+	changes, err := s.load(ctx, []entities.TaskEvent{*event})
+	if err != nil {
+		log.Printf("task stream: cannot read task %d: %v", notification.TaskId, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscribers[notification.WorkspaceId] {
+		change, ok := s.changeFor(sub, changes[0])
+		if !ok {
+			continue
+		}
+		select {
+		case sub.changes <- change:
+		default:
+			// The subscriber fell behind; it resumes from the replay rather than slowing the others.
+			s.drop(notification.WorkspaceId, sub)
+		}
+	}
+}
+
+func (s *stream) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for workspaceID, subscribers := range s.subscribers {
+		for sub := range subscribers {
+			s.drop(workspaceID, sub)
+		}
+	}
+}
+
+// load returns the changes of events, with their tasks, soft-deleted or not, as they are now.
+func (s *stream) load(ctx context.Context, events []entities.TaskEvent) ([]models.TaskChange, error) {
+	tasks := map[uint]*entities.Task{}
+	for _, event := range events {
+		if tasks[event.TaskId] != nil {
+			continue
+		}
+		task, err := s.taskRepo.GetByIDUnscoped(ctx, event.TaskId)
+		if err != nil {
+			return nil, err
+		}
+		tasks[event.TaskId] = task
+	}
+	if err := annotate(ctx, s.taskRepo, lo.Values(tasks)...); err != nil {
+		return nil, err
+	}
+	changes := make([]models.TaskChange, len(events))
+	for i := range events {
+		event := &events[i]
+		changes[i] = models.TaskChange{
+			Id:    event.Id,
+			Type:  entities.DomainEventTypeOf(event.Action),
+			Event: event,
+			Task:  tasks[event.TaskId],
+		}
+	}
+	return changes, nil
+}
+
+// changeFor returns change as streamed to sub, and false when sub does not get it: when the actor
+// of sub cannot read the task, or the task matches the filters of sub neither now nor before the change.
+func (s *stream) changeFor(sub *subscriber, change models.TaskChange) (models.TaskChange, bool) {
+	if s.authorizeRead(sub.actor, change.Task) != nil {
+		return change, false
+	}
+	change.Matches = matchesStream(change.Task, sub.query)
+	if !change.Matches && !leftStream(change.Event, sub.query) {
+		return change, false
+	}
+	return change, true
+}
+
+// matchesStream reports whether task matches the status and label filters of query.
+func matchesStream(task *entities.Task, query *models.StreamTasksQuery) bool {
+	if len(query.Status) > 0 && !lo.Contains(query.Status, task.Status) {
+		return false
+	}
+	if len(query.Label) == 0 {
+		return true
+	}
+	names := lo.Map(task.Labels, func(label entities.Label, _ int) string {
+		return strings.ToLower(label.Name)
+	})
+	if query.LabelMatch == models.LabelMatchAll {
+		return lo.Every(names, query.Label)
+	}
+	return lo.Some(names, query.Label)
+}
+
+// leftStream reports whether event may have taken its task out of the filters of query: a status
+// change from a status of the filter, or the removal of a label when the stream filters by label.
+func leftStream(event *entities.TaskEvent, query *models.StreamTasksQuery) bool {
+	switch event.Action {
+	case entities.TaskEventStatusChanged:
+		var changes map[string]entities.FieldChange
+		if err := json.Unmarshal(event.Changes, &changes); err != nil {
+			return false
+		}
+		old, _ := changes["status"].Old.(string)
+		return lo.Contains(query.Status, entities.TaskStatus(old))
+	case entities.TaskEventLabelRemoved:
+		return len(query.Label) > 0
+	default:
+		return false
+	}
+}
+
+func (s *stream) add(workspaceID uint, sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subscribers[workspaceID] == nil {
+		s.subscribers[workspaceID] = map[*subscriber]bool{}
+	}
+	s.subscribers[workspaceID][sub] = true
+}
+
+func (s *stream) remove(workspaceID uint, sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.drop(workspaceID, sub)
+}
+
+// drop ends the subscription of sub unless it already ended; s.mu must be held.
+func (s *stream) drop(workspaceID uint, sub *subscriber) {
+	if !s.subscribers[workspaceID][sub] {
+		return
+	}
+	delete(s.subscribers[workspaceID], sub)
+	if len(s.subscribers[workspaceID]) == 0 {
+		delete(s.subscribers, workspaceID)
+	}
+	close(sub.changes)
+}
+
+// count returns the number of subscribers of a workspace.
+func (s *stream) count(workspaceID uint) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subscribers[workspaceID])
+}
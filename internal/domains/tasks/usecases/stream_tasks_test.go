@@ -0,0 +1,208 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+// expectTask expects the stream to read task and its labels.
+func expectTask(mockRepo *mocks.MockTaskRepository, task entities.Task, labels ...entities.Label) {
+	mockRepo.EXPECT().GetByIDUnscoped(gomock.Any(), task.Id).Return(&task, nil)
+	mockRepo.EXPECT().ChildProgress(gomock.Any(), []uint{task.Id}).Return(map[uint]entities.TaskProgress{}, nil)
+	mockRepo.EXPECT().ListOpenBlockers(gomock.Any(), []uint{task.Id}).Return(map[uint][]uint{}, nil)
+	mockRepo.EXPECT().ListLabels(gomock.Any(), []uint{task.Id}).Return(map[uint][]entities.Label{task.Id: labels}, nil)
+	mockRepo.EXPECT().CountComments(gomock.Any(), []uint{task.Id}).Return(map[uint]int{}, nil)
+}
+
+func TestTaskStream_HandleNotification(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 1)
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleViewer}
+	owner := "bob"
+	notification := `{"id":41,"workspace_id":1,"task_id":7}`
+
+	t.Run("FiltersByStatusAndLabel", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		stream := usecases.NewTaskStream(mockRepo, usecases.Options{}, usecases.StreamOptions{})
+		inProgress, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{Status: []entities.TaskStatus{"IN_PROGRESS"}})
+		require.NoError(t, err)
+		bugs, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{Label: []string{"Bug"}})
+		require.NoError(t, err)
+
+		mockRepo.EXPECT().GetEvent(gomock.Any(), uint(41)).
+			Return(&entities.TaskEvent{Id: 41, TaskId: 7, Action: entities.TaskEventUpdated}, nil)
+		expectTask(mockRepo, entities.Task{Id: 7, Status: "IN_PROGRESS", CreatedBy: &owner}, entities.Label{Id: 3, Name: "UI"})
+		stream.HandleNotification(ctx, notification)
+
+		if assert.Len(t, inProgress.Changes, 1) {
+			change := <-inProgress.Changes
+			assert.Equal(t, uint(41), change.Id)
+			assert.Equal(t, entities.DomainEventTaskUpdated, change.Type)
+			assert.True(t, change.Matches)
+			assert.Equal(t, uint(7), change.Task.Id)
+		}
+		assert.Empty(t, bugs.Changes)
+	})
+
+	t.Run("StatusChangeOutOfTheFilter", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		stream := usecases.NewTaskStream(mockRepo, usecases.Options{}, usecases.StreamOptions{})
+		toDo, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{Status: []entities.TaskStatus{"TO_DO"}})
+		require.NoError(t, err)
+
+		mockRepo.EXPECT().GetEvent(gomock.Any(), uint(41)).Return(&entities.TaskEvent{
+			Id: 41, TaskId: 7, Action: entities.TaskEventStatusChanged,
+			Changes: entities.JSON(`{"status":{"old":"TO_DO","new":"DONE"}}`),
+		}, nil)
+		expectTask(mockRepo, entities.Task{Id: 7, Status: "DONE", CreatedBy: &owner})
+		stream.HandleNotification(ctx, notification)
+
+		// The subscriber learns that the task left its filter.
+		if assert.Len(t, toDo.Changes, 1) {
+			change := <-toDo.Changes
+			assert.Equal(t, entities.DomainEventTaskStatusChanged, change.Type)
+			assert.False(t, change.Matches)
+		}
+	})
+
+	t.Run("HiddenTask", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		stream := usecases.NewTaskStream(mockRepo, usecases.Options{Visibility: usecases.VisibilityOwn}, usecases.StreamOptions{})
+		subscription, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{})
+		require.NoError(t, err)
+
+		mockRepo.EXPECT().GetEvent(gomock.Any(), uint(41)).
+			Return(&entities.TaskEvent{Id: 41, TaskId: 7, Action: entities.TaskEventCreated}, nil)
+		expectTask(mockRepo, entities.Task{Id: 7, Status: "TO_DO", CreatedBy: &owner})
+		stream.HandleNotification(ctx, notification)
+
+		assert.Empty(t, subscription.Changes)
+	})
+
+	t.Run("OtherWorkspaceReadsNothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		stream := usecases.NewTaskStream(mockRepo, usecases.Options{}, usecases.StreamOptions{})
+		subscription, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{})
+		require.NoError(t, err)
+
+		stream.HandleNotification(ctx, `{"id":41,"workspace_id":2,"task_id":7}`)
+
+		assert.Empty(t, subscription.Changes)
+	})
+
+	t.Run("SlowSubscriberIsDropped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		stream := usecases.NewTaskStream(mockRepo, usecases.Options{}, usecases.StreamOptions{BufferSize: 1})
+		subscription, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{})
+		require.NoError(t, err)
+
+		for _, id := range []uint{41, 42} {
+			mockRepo.EXPECT().GetEvent(gomock.Any(), id).
+				Return(&entities.TaskEvent{Id: id, TaskId: 7, Action: entities.TaskEventUpdated}, nil)
+			expectTask(mockRepo, entities.Task{Id: 7, Status: "TO_DO", CreatedBy: &owner})
+		}
+		stream.HandleNotification(ctx, `{"id":41,"workspace_id":1,"task_id":7}`)
+		stream.HandleNotification(ctx, `{"id":42,"workspace_id":1,"task_id":7}`)
+
+		// The buffered change is still delivered, then the subscription ends.
+		change, ok := <-subscription.Changes
+		assert.True(t, ok)
+		assert.Equal(t, uint(41), change.Id)
+		_, ok = <-subscription.Changes
+		assert.False(t, ok)
+		// Nobody is left to read the next event for.
+		stream.HandleNotification(ctx, `{"id":43,"workspace_id":1,"task_id":7}`)
+	})
+}
+
+func TestTaskStream_Subscribe(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 1)
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleViewer}
+
+	t.Run("Replay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		stream := usecases.NewTaskStream(mockRepo, usecases.Options{}, usecases.StreamOptions{ReplayLimit: 10})
+
+		mockRepo.EXPECT().ListEventsAfter(ctx, uint(40), 11).Return([]entities.TaskEvent{
+			{Id: 41, TaskId: 7, Action: entities.TaskEventCreated},
+			{Id: 42, TaskId: 8, Action: entities.TaskEventCreated},
+		}, nil)
+		mockRepo.EXPECT().GetByIDUnscoped(ctx, uint(7)).Return(&entities.Task{Id: 7, Status: "TO_DO"}, nil)
+		mockRepo.EXPECT().GetByIDUnscoped(ctx, uint(8)).Return(&entities.Task{Id: 8, Status: "DONE"}, nil)
+		mockRepo.EXPECT().ChildProgress(ctx, gomock.Any()).Return(map[uint]entities.TaskProgress{}, nil)
+		mockRepo.EXPECT().ListOpenBlockers(ctx, gomock.Any()).Return(map[uint][]uint{}, nil)
+		mockRepo.EXPECT().ListLabels(ctx, gomock.Any()).Return(map[uint][]entities.Label{}, nil)
+		mockRepo.EXPECT().CountComments(ctx, gomock.Any()).Return(map[uint]int{}, nil)
+
+		subscription, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{
+			Status: []entities.TaskStatus{"TO_DO"}, LastEventId: 40,
+		})
+
+		require.NoError(t, err)
+		defer subscription.Close()
+		if assert.Len(t, subscription.Replay, 1) {
+			assert.Equal(t, uint(41), subscription.Replay[0].Id)
+			assert.True(t, subscription.Replay[0].Matches)
+		}
+		// Filtered out or not, replayed changes are not streamed again.
+		assert.True(t, subscription.Replayed(41))
+		assert.True(t, subscription.Replayed(42))
+		assert.False(t, subscription.Replayed(43))
+	})
+
+	t.Run("TooManyToReplay", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		stream := usecases.NewTaskStream(mockRepo, usecases.Options{}, usecases.StreamOptions{ReplayLimit: 1})
+
+		mockRepo.EXPECT().ListEventsAfter(ctx, uint(40), 2).Return([]entities.TaskEvent{{Id: 41}, {Id: 42}}, nil)
+
+		subscription, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{LastEventId: 40})
+
+		require.NoError(t, err)
+		defer subscription.Close()
+		assert.Equal(t, []models.TaskChange{{Type: models.TaskChangeReset}}, subscription.Replay)
+	})
+
+	t.Run("RoleRequired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		stream := usecases.NewTaskStream(mocks.NewMockTaskRepository(ctrl), usecases.Options{}, usecases.StreamOptions{})
+
+		_, err := stream.Subscribe(ctx, entities.Actor{Name: "mallory", UserId: "mallory"}, &models.StreamTasksQuery{})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+
+	t.Run("ResetEndsEverySubscription", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		stream := usecases.NewTaskStream(mocks.NewMockTaskRepository(ctrl), usecases.Options{}, usecases.StreamOptions{})
+		first, err := stream.Subscribe(ctx, alice, &models.StreamTasksQuery{})
+		require.NoError(t, err)
+		second, err := stream.Subscribe(helpers.ContextWithWorkspaceID(context.Background(), 2), alice, &models.StreamTasksQuery{})
+		require.NoError(t, err)
+
+		stream.Reset()
+
+		_, ok := <-first.Changes
+		assert.False(t, ok)
+		_, ok = <-second.Changes
+		assert.False(t, ok)
+		// Closing an ended subscription is a no-op.
+		first.Close()
+	})
+}
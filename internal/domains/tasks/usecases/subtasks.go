@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+var (
+	errParentNotFound = domainerrors.Validation("parent task not found")
+	errParentCycle    = domainerrors.Conflict("a task cannot be moved under itself or one of its subtasks")
+)
+
+// checkParent fails when actor cannot make a task a subtask of parentID, because the parent
+// does not exist in the workspace or actor cannot read it.
+func (u *usecase) checkParent(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, parentID uint) error {
+	return u.checkLinkedTask(ctx, repo, actor, parentID, errParentNotFound.WithDetail("parent_id", parentID))
+}
+
+// checkLinkedTask fails with notFound when the task id, which a request links another task to,
+// does not exist in the workspace or actor cannot read it.
+func (u *usecase) checkLinkedTask(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, id uint, notFound error) error {
+	task, err := repo.GetByID(ctx, id)
+	if err == nil {
+		err = u.authorizeRead(actor, task)
+	}
+	if domainerrors.IsKind(err, domainerrors.KindNotFound) {
+		return notFound
+	}
+	return err
+}
+
+// checkSubtasksDone fails when a task has subtasks that are not DONE.
+func checkSubtasksDone(ctx context.Context, repo interfaces.TaskRepository, id uint) error {
+	progress, err := repo.ChildProgress(ctx, []uint{id})
+	if err != nil {
+		return err
+	}
+	if open := progress[id].Open(); open > 0 {
+		return domainerrors.Conflict(fmt.Sprintf("cannot complete the task while %d of its subtasks are open", open)).
+			WithDetail("open_subtasks", open)
+	}
+	return nil
+}
+
+// annotate sets the computed fields of tasks: the progress of the tasks that have subtasks,
+// whether each task is blocked, its labels and its number of comments.
+func annotate(ctx context.Context, repo interfaces.TaskRepository, tasks ...*entities.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	ids := lo.Map(tasks, func(task *entities.Task, _ int) uint {
+		return task.Id
+	})
+	progress, err := repo.ChildProgress(ctx, ids)
+	if err != nil {
+		return err
+	}
+	blockers, err := repo.ListOpenBlockers(ctx, ids)
+	if err != nil {
+		return err
+	}
+	labels, err := repo.ListLabels(ctx, ids)
+	if err != nil {
+		return err
+	}
+	comments, err := repo.CountComments(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		if p, ok := progress[task.Id]; ok {
+			task.Progress = &p
+		}
+		task.Blocked = len(blockers[task.Id]) > 0
+		task.CommentCount = comments[task.Id]
+		task.Labels = labels[task.Id]
+		if task.Labels == nil {
+			task.Labels = []entities.Label{}
+		}
+	}
+	return nil
+}
+
+// taskPointers returns pointers to the elements of tasks.
+func taskPointers(tasks []entities.Task) []*entities.Task {
+	pointers := make([]*entities.Task, len(tasks))
+	for i := range tasks {
+		pointers[i] = &tasks[i]
+	}
+	return pointers
+}
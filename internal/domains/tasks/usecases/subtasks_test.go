@@ -0,0 +1,286 @@
+package usecases_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/models"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestMoveTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	task := &entities.Task{Id: 2, CreatedBy: lo.ToPtr("alice"), Version: 1}
+
+	inTransaction := func() {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(task, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(&entities.Task{Id: 1}, nil)
+		mockRepo.EXPECT().LockHierarchy(ctx).Return(nil)
+		mockRepo.EXPECT().ListAncestorIDs(ctx, uint(1)).Return([]uint{1, 7}, nil)
+		mockRepo.EXPECT().UpdateParent(ctx, &entities.TaskUpdate{Id: 2}, lo.ToPtr(uint(1))).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.Equal(t, entities.TaskEventMoved, event.Action)
+			assert.JSONEq(t, `{"parent_id":{"old":null,"new":1}}`, string(event.Changes))
+			return nil
+		})
+
+		err := usecase.MoveTask(ctx, alice, &entities.TaskUpdate{Id: 2}, lo.ToPtr(uint(1)))
+		assert.NoError(t, err)
+	})
+
+	t.Run("UnderItself", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(task, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(task, nil)
+		mockRepo.EXPECT().LockHierarchy(ctx).Return(nil)
+		mockRepo.EXPECT().ListAncestorIDs(ctx, uint(2)).Return([]uint{2}, nil)
+
+		err := usecase.MoveTask(ctx, alice, &entities.TaskUpdate{Id: 2}, lo.ToPtr(uint(2)))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+	})
+
+	t.Run("UnderASubtask", func(t *testing.T) {
+		// Task 5 is a subtask of task 3, itself a subtask of task 2.
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(task, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(5)).Return(&entities.Task{Id: 5}, nil)
+		mockRepo.EXPECT().LockHierarchy(ctx).Return(nil)
+		mockRepo.EXPECT().ListAncestorIDs(ctx, uint(5)).Return([]uint{5, 3, 2}, nil)
+
+		err := usecase.MoveTask(ctx, alice, &entities.TaskUpdate{Id: 2}, lo.ToPtr(uint(5)))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+	})
+
+	t.Run("ParentNotFound", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(task, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(9)).Return(nil, domainerrors.NotFound("task not found"))
+
+		err := usecase.MoveTask(ctx, alice, &entities.TaskUpdate{Id: 2}, lo.ToPtr(uint(9)))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+
+	t.Run("ToTopLevel", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(&entities.Task{Id: 2, CreatedBy: lo.ToPtr("alice"), ParentId: lo.ToPtr(uint(1))}, nil)
+		mockRepo.EXPECT().UpdateParent(ctx, &entities.TaskUpdate{Id: 2}, (*uint)(nil)).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+
+		err := usecase.MoveTask(ctx, alice, &entities.TaskUpdate{Id: 2}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(task, nil)
+
+		err := usecase.MoveTask(ctx, entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleMember}, &entities.TaskUpdate{Id: 2}, nil)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
+
+func TestCreateTask_Parent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{Visibility: usecases.VisibilityOwn})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	mockRepo.EXPECT().Transaction(ctx, gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+		return fn(mockRepo)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(&entities.Task{Id: 1, CreatedBy: lo.ToPtr("alice")}, nil)
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			var changes map[string]entities.FieldChange
+			assert.NoError(t, json.Unmarshal(event.Changes, &changes))
+			assert.EqualValues(t, 1, changes["parent_id"].New)
+			return nil
+		})
+
+		err := usecase.CreateTask(ctx, alice, &entities.Task{Title: "Subtask", ParentId: lo.ToPtr(uint(1))})
+		assert.NoError(t, err)
+	})
+
+	t.Run("HiddenParent", func(t *testing.T) {
+		// A parent the actor cannot see is reported like a missing one.
+		mockRepo.EXPECT().GetByID(ctx, uint(3)).Return(&entities.Task{Id: 3, CreatedBy: lo.ToPtr("bob")}, nil)
+
+		err := usecase.CreateTask(ctx, alice, &entities.Task{Title: "Subtask", ParentId: lo.ToPtr(uint(3))})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+}
+
+func TestUpdateTaskStatus_Subtasks(t *testing.T) {
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	inProgress := &entities.Task{Id: 1, Status: entities.TaskStatusInProgress, CreatedBy: lo.ToPtr("alice")}
+
+	cases := []struct {
+		name         string
+		progress     map[uint]entities.TaskProgress
+		force        bool
+		expectedKind domainerrors.Kind
+	}{
+		{"NoSubtasks", map[uint]entities.TaskProgress{}, false, ""},
+		{"SubtasksDone", map[uint]entities.TaskProgress{1: entities.NewTaskProgress(2, 2)}, false, ""},
+		{"OpenSubtasks", map[uint]entities.TaskProgress{1: entities.NewTaskProgress(3, 1)}, false, domainerrors.KindConflict},
+		{"Forced", nil, true, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+			mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+				return fn(mockRepo)
+			})
+			mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(inProgress, nil)
+			// Force skips the subtasks but not the blockers.
+			mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{1}).Return(map[uint][]uint{}, nil)
+			if !tc.force {
+				mockRepo.EXPECT().ChildProgress(ctx, []uint{1}).Return(tc.progress, nil)
+			}
+			if tc.expectedKind == "" {
+				mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+				mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+			}
+
+			done := entities.TaskStatusDone
+			err := usecase.UpdateTaskStatus(ctx, alice, &entities.TaskUpdate{Id: 1, Status: &done, Force: tc.force})
+
+			assertKind(t, tc.expectedKind, err)
+			var domainErr *domainerrors.Error
+			if tc.expectedKind != "" && assert.ErrorAs(t, err, &domainErr) {
+				assert.Equal(t, 2, domainErr.Details["open_subtasks"])
+			}
+		})
+	}
+}
+
+func TestGetTaskTree(t *testing.T) {
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleViewer}
+	owned := func(id, parentID uint, owner string) entities.Task {
+		task := entities.Task{Id: id, CreatedBy: lo.ToPtr(owner)}
+		if parentID != 0 {
+			task.ParentId = lo.ToPtr(parentID)
+		}
+		return task
+	}
+
+	t.Run("Nested", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{Visibility: usecases.VisibilityOwn})
+		root := owned(1, 0, "alice")
+		mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(&root, nil)
+		// Task 3 belongs to bob: it is hidden from alice with its subtask 5.
+		mockRepo.EXPECT().ListDescendants(ctx, uint(1), models.DefaultTreeDepth, models.MaxTreeSize).Return([]entities.Task{
+			owned(2, 1, "alice"), owned(3, 1, "bob"), owned(4, 2, "alice"), owned(5, 3, "alice"),
+		}, nil)
+		mockRepo.EXPECT().ChildProgress(ctx, []uint{1, 2, 3, 4, 5}).Return(map[uint]entities.TaskProgress{
+			1: entities.NewTaskProgress(2, 0),
+			2: entities.NewTaskProgress(1, 1),
+			3: entities.NewTaskProgress(1, 0),
+		}, nil)
+		mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{1, 2, 3, 4, 5}).Return(map[uint][]uint{4: {9}}, nil)
+		mockRepo.EXPECT().ListLabels(ctx, []uint{1, 2, 3, 4, 5}).Return(map[uint][]entities.Label{
+			2: {{Id: 7, Name: "bug", Color: "#d73a4a"}},
+		}, nil)
+		mockRepo.EXPECT().CountComments(ctx, []uint{1, 2, 3, 4, 5}).Return(map[uint]int{}, nil)
+
+		tree, err := usecase.GetTaskTree(ctx, alice, 1, &models.GetTaskTreeQuery{})
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, 0, tree.Progress.Percent)
+			if assert.Len(t, tree.Children, 1) {
+				child := tree.Children[0]
+				assert.Equal(t, uint(2), child.Id)
+				assert.Equal(t, 100, child.Progress.Percent)
+				assert.Equal(t, []entities.Label{{Id: 7, Name: "bug", Color: "#d73a4a"}}, child.Labels)
+				if assert.Len(t, child.Children, 1) {
+					assert.Equal(t, uint(4), child.Children[0].Id)
+					assert.Nil(t, child.Children[0].Progress)
+					assert.True(t, child.Children[0].Blocked)
+					assert.False(t, child.Blocked)
+					assert.NotNil(t, child.Children[0].Children)
+				}
+			}
+		}
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockTaskRepository(ctrl)
+		usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+		root := owned(1, 0, "alice")
+		mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(&root, nil)
+		mockRepo.EXPECT().ListDescendants(ctx, uint(1), models.MaxTreeDepth, models.MaxTreeSize).Return(make([]entities.Task, models.MaxTreeSize+1), nil)
+
+		_, err := usecase.GetTaskTree(ctx, alice, 1, &models.GetTaskTreeQuery{Depth: 50})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+}
+
+func TestListTaskChildren(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{Visibility: usecases.VisibilityOwn})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleViewer}
+
+	mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(&entities.Task{Id: 1, AssigneeId: lo.ToPtr("alice")}, nil)
+	mockRepo.EXPECT().List(ctx, &models.ListTasksQuery{
+		ParentId:  lo.ToPtr(uint(1)),
+		VisibleTo: "alice",
+		Sort:      "id",
+		Order:     models.SortOrderAsc,
+		Limit:     models.DefaultListLimit,
+	}).Return([]entities.Task{{Id: 2}}, "", nil)
+	mockRepo.EXPECT().ChildProgress(ctx, []uint{2}).Return(map[uint]entities.TaskProgress{2: entities.NewTaskProgress(4, 1)}, nil)
+	mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{2}).Return(map[uint][]uint{}, nil)
+	mockRepo.EXPECT().ListLabels(ctx, []uint{2}).Return(map[uint][]entities.Label{}, nil)
+	mockRepo.EXPECT().CountComments(ctx, []uint{2}).Return(map[uint]int{2: 3}, nil)
+
+	children, _, err := usecase.ListTaskChildren(ctx, alice, 1, &models.ListTaskChildrenQuery{})
+
+	if assert.NoError(t, err) && assert.Len(t, children, 1) {
+		assert.Equal(t, 25, children[0].Progress.Percent)
+		assert.Equal(t, 3, children[0].CommentCount)
+		assert.Equal(t, []entities.Label{}, children[0].Labels)
+	}
+}
@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+var (
+	errBlockerNotFound = domainerrors.Validation("blocker task not found")
+	errDependencyCycle = domainerrors.Conflict("the dependency would create a cycle")
+)
+
+// AddTaskDependency records that blockerID blocks blockedID; the actor must be able to change the
+// blocked task and read the blocker. A dependency that closes a cycle of blockers is refused.
+func (u *usecase) AddTaskDependency(ctx context.Context, actor entities.Actor, blockedID, blockerID uint) (*entities.TaskDependency, error) {
+	if blockedID == blockerID {
+		return nil, errDependencyCycle.WithDetail("blocker_id", blockerID)
+	}
+
+	dependency := &entities.TaskDependency{BlockerId: blockerID, BlockedId: blockedID}
+	err := u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		blocked, err := repo.GetByIDForUpdate(ctx, blockedID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeChange(actor, blocked); err != nil {
+			return err
+		}
+		if err := u.checkLinkedTask(ctx, repo, actor, blockerID, errBlockerNotFound.WithDetail("blocker_id", blockerID)); err != nil {
+			return err
+		}
+		// Read the blockers of the blocker under the lock so a concurrent insert cannot close a cycle.
+		if err := repo.LockDependencies(ctx); err != nil {
+			return err
+		}
+		upstream, err := repo.ListUpstreamBlockerIDs(ctx, blockerID)
+		if err != nil {
+			return err
+		}
+		if lo.Contains(upstream, blockedID) {
+			return errDependencyCycle.WithDetail("blocker_id", blockerID)
+		}
+		if actor.UserId != "" {
+			dependency.CreatedBy = lo.ToPtr(actor.UserId)
+		}
+		if err := repo.CreateDependency(ctx, dependency); err != nil {
+			return err
+		}
+		changes := map[string]entities.FieldChange{
+			"blocked_by": {New: blockerID},
+		}
+		return recordEvent(ctx, repo, actor, entities.TaskEventDependencyAdded, blockedID, changes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dependency, nil
+}
+
+// RemoveTaskDependency deletes the dependency of blockedID on blockerID; the actor must be able to
+// change the blocked task.
+func (u *usecase) RemoveTaskDependency(ctx context.Context, actor entities.Actor, blockedID, blockerID uint) error {
+	return u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		blocked, err := repo.GetByIDForUpdate(ctx, blockedID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeChange(actor, blocked); err != nil {
+			return err
+		}
+		if err := repo.DeleteDependency(ctx, blockerID, blockedID); err != nil {
+			return err
+		}
+		changes := map[string]entities.FieldChange{
+			"blocked_by": {Old: blockerID},
+		}
+		return recordEvent(ctx, repo, actor, entities.TaskEventDependencyRemoved, blockedID, changes)
+	})
+}
+
+func (u *usecase) ListTaskDependencies(ctx context.Context, actor entities.Actor, id uint) ([]entities.TaskDependency, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleViewer, "read tasks"); err != nil {
+		return nil, err
+	}
+	task, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.authorizeRead(actor, task); err != nil {
+		return nil, err
+	}
+	return u.taskRepo.ListDependencies(ctx, id)
+}
+
+// checkBlockers fails when a task is blocked by tasks that are not DONE, listing them in blocked_by.
+func checkBlockers(ctx context.Context, repo interfaces.TaskRepository, id uint) error {
+	blockers, err := repo.ListOpenBlockers(ctx, []uint{id})
+	if err != nil {
+		return err
+	}
+	if open := blockers[id]; len(open) > 0 {
+		return domainerrors.Conflict(fmt.Sprintf("the task is blocked by %d open tasks", len(open))).
+			WithDetail("blocked_by", open)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestAddTaskDependency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	blocked := &entities.Task{Id: 15, CreatedBy: lo.ToPtr("alice")}
+
+	inTransaction := func() {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Return(blocked, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(12)).Return(&entities.Task{Id: 12}, nil)
+		mockRepo.EXPECT().LockDependencies(ctx).Return(nil)
+		mockRepo.EXPECT().ListUpstreamBlockerIDs(ctx, uint(12)).Return([]uint{12, 3}, nil)
+		mockRepo.EXPECT().CreateDependency(ctx, &entities.TaskDependency{BlockerId: 12, BlockedId: 15, CreatedBy: lo.ToPtr("alice")}).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.Equal(t, uint(15), event.TaskId)
+			assert.Equal(t, entities.TaskEventDependencyAdded, event.Action)
+			assert.JSONEq(t, `{"blocked_by":{"old":null,"new":12}}`, string(event.Changes))
+			return nil
+		})
+
+		dependency, err := usecase.AddTaskDependency(ctx, alice, 15, 12)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, uint(12), dependency.BlockerId)
+			assert.Equal(t, uint(15), dependency.BlockedId)
+		}
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		_, err := usecase.AddTaskDependency(ctx, alice, 15, 15)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		// Task 12 is blocked by task 3, itself blocked by task 15.
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Return(blocked, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(12)).Return(&entities.Task{Id: 12}, nil)
+		mockRepo.EXPECT().LockDependencies(ctx).Return(nil)
+		mockRepo.EXPECT().ListUpstreamBlockerIDs(ctx, uint(12)).Return([]uint{12, 3, 15}, nil)
+
+		_, err := usecase.AddTaskDependency(ctx, alice, 15, 12)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+	})
+
+	t.Run("BlockerNotFound", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Return(blocked, nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(9)).Return(nil, domainerrors.NotFound("task not found"))
+
+		_, err := usecase.AddTaskDependency(ctx, alice, 15, 9)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Return(blocked, nil)
+
+		_, err := usecase.AddTaskDependency(ctx, entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleMember}, 15, 12)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
+
+func TestRemoveTaskDependency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	mockRepo.EXPECT().Transaction(ctx, gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+		return fn(mockRepo)
+	})
+	mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Times(2).Return(&entities.Task{Id: 15, CreatedBy: lo.ToPtr("alice")}, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteDependency(ctx, uint(12), uint(15)).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.Equal(t, entities.TaskEventDependencyRemoved, event.Action)
+			return nil
+		})
+
+		assert.NoError(t, usecase.RemoveTaskDependency(ctx, alice, 15, 12))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		notFound := domainerrors.NotFound("dependency not found")
+		mockRepo.EXPECT().DeleteDependency(ctx, uint(13), uint(15)).Return(notFound)
+
+		assert.True(t, errors.Is(usecase.RemoveTaskDependency(ctx, alice, 15, 13), notFound))
+	})
+}
+
+func TestUpdateTaskStatus_Blockers(t *testing.T) {
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+
+	cases := []struct {
+		name  string
+		from  entities.TaskStatus
+		to    entities.TaskStatus
+		force bool
+	}{
+		{"Start", entities.TaskStatusToDo, entities.TaskStatusInProgress, false},
+		{"Complete", entities.TaskStatusInProgress, entities.TaskStatusDone, false},
+		// Force skips the open subtasks, not the blockers.
+		{"Forced", entities.TaskStatusInProgress, entities.TaskStatusDone, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+			mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+				return fn(mockRepo)
+			})
+			mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Return(&entities.Task{Id: 15, Status: tc.from, CreatedBy: lo.ToPtr("alice")}, nil)
+			mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{15}).Return(map[uint][]uint{15: {3, 12}}, nil)
+
+			err := usecase.UpdateTaskStatus(ctx, alice, &entities.TaskUpdate{Id: 15, Status: lo.ToPtr(tc.to), Force: tc.force})
+
+			var domainErr *domainerrors.Error
+			if assert.True(t, errors.As(err, &domainErr)) {
+				assert.Equal(t, domainerrors.KindConflict, domainErr.Kind)
+				assert.Equal(t, []uint{3, 12}, domainErr.Details["blocked_by"])
+			}
+		})
+	}
+}
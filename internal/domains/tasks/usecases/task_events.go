@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// recordEvent appends an entry to the audit trail of a task, in the transaction of repo.
+func recordEvent(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, action entities.TaskEventAction, taskID uint, changes map[string]entities.FieldChange) error {
+	event, err := newEvent(actor, action, taskID, changes)
+	if err != nil {
+		return err
+	}
+	return repo.CreateEvent(ctx, event)
+}
+
+// newEvent builds an audit trail entry of a task.
+func newEvent(actor entities.Actor, action entities.TaskEventAction, taskID uint, changes map[string]entities.FieldChange) (*entities.TaskEvent, error) {
+	body, err := json.Marshal(changes)
+	if err != nil {
+		return nil, domainerrors.Internal(err)
+	}
+	return &entities.TaskEvent{
+		TaskId:    taskID,
+		Action:    action,
+		Changes:   body,
+		Actor:     actor.Name,
+		RequestId: actor.RequestId,
+	}, nil
+}
+
+// auditedFields returns the task fields recorded in the audit trail.
+func auditedFields(task *entities.Task) map[string]any {
+	return map[string]any{
+		"title":       task.Title,
+		"description": task.Description,
+		"status":      task.Status,
+	}
+}
+
+// createdChanges records every field of a new or restored task, and its parent, recurrence and schedule when they are set.
+func createdChanges(task *entities.Task) map[string]entities.FieldChange {
+	changes := map[string]entities.FieldChange{}
+	for field, value := range auditedFields(task) {
+		changes[field] = entities.FieldChange{New: value}
+	}
+	if task.ParentId != nil {
+		changes["parent_id"] = entities.FieldChange{New: *task.ParentId}
+	}
+	if task.RecurrenceId != nil {
+		changes["recurrence_id"] = entities.FieldChange{New: *task.RecurrenceId}
+	}
+	if task.DueAt != nil {
+		changes["due_at"] = entities.FieldChange{New: *task.DueAt}
+	}
+	if task.Priority != "" {
+		changes["priority"] = entities.FieldChange{New: task.Priority}
+	}
+	if task.EstimateMinutes != nil {
+		changes["estimate_minutes"] = entities.FieldChange{New: *task.EstimateMinutes}
+	}
+	return changes
+}
+
+// deletedChanges records every field of a deleted task.
+func deletedChanges(task *entities.Task) map[string]entities.FieldChange {
+	changes := map[string]entities.FieldChange{}
+	for field, value := range auditedFields(task) {
+		changes[field] = entities.FieldChange{Old: value}
+	}
+	return changes
+}
+
+// updatedChanges records the fields an update actually changes.
+func updatedChanges(current *entities.Task, update *entities.TaskUpdate) map[string]entities.FieldChange {
+	changes := map[string]entities.FieldChange{}
+	if update.Title != nil && *update.Title != current.Title {
+		changes["title"] = entities.FieldChange{Old: current.Title, New: *update.Title}
+	}
+	if update.Description != nil && *update.Description != current.Description {
+		changes["description"] = entities.FieldChange{Old: current.Description, New: *update.Description}
+	}
+	if update.Status != nil && *update.Status != current.Status {
+		changes["status"] = entities.FieldChange{Old: current.Status, New: *update.Status}
+	}
+	if update.TaskSchedule != nil {
+		if !sameTime(current.DueAt, update.DueAt) {
+			changes["due_at"] = entities.FieldChange{Old: current.DueAt, New: update.DueAt}
+		}
+		if update.Priority != current.Priority {
+			changes["priority"] = entities.FieldChange{Old: current.Priority, New: update.Priority}
+		}
+		if !sameValue(current.EstimateMinutes, update.EstimateMinutes) {
+			changes["estimate_minutes"] = entities.FieldChange{Old: current.EstimateMinutes, New: update.EstimateMinutes}
+		}
+	}
+	return changes
+}
+
+// sameTime reports whether a and b are both nil or the same instant.
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// sameValue reports whether a and b are both nil or point to equal values.
+func sameValue[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// AddTaskLabel puts the label labelID on a task; the actor must be able to change the task.
+func (u *usecase) AddTaskLabel(ctx context.Context, actor entities.Actor, taskID, labelID uint) (*entities.TaskLabel, error) {
+	taskLabel := &entities.TaskLabel{TaskId: taskID, LabelId: labelID}
+	err := u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		task, err := repo.GetByIDForUpdate(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeChange(actor, task); err != nil {
+			return err
+		}
+		if err := repo.CreateTaskLabel(ctx, taskLabel); err != nil {
+			return err
+		}
+		changes := map[string]entities.FieldChange{
+			"label_id": {New: labelID},
+		}
+		return recordEvent(ctx, repo, actor, entities.TaskEventLabelAdded, taskID, changes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskLabel, nil
+}
+
+// RemoveTaskLabel takes the label labelID off a task; the actor must be able to change the task.
+func (u *usecase) RemoveTaskLabel(ctx context.Context, actor entities.Actor, taskID, labelID uint) error {
+	return u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		task, err := repo.GetByIDForUpdate(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		if err := u.authorizeChange(actor, task); err != nil {
+			return err
+		}
+		if err := repo.DeleteTaskLabel(ctx, taskID, labelID); err != nil {
+			return err
+		}
+		changes := map[string]entities.FieldChange{
+			"label_id": {Old: labelID},
+		}
+		return recordEvent(ctx, repo, actor, entities.TaskEventLabelRemoved, taskID, changes)
+	})
+}
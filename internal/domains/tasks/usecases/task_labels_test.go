@@ -0,0 +1,100 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestAddTaskLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	task := &entities.Task{Id: 15, CreatedBy: lo.ToPtr("alice")}
+
+	inTransaction := func() {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Return(task, nil)
+		mockRepo.EXPECT().CreateTaskLabel(ctx, &entities.TaskLabel{TaskId: 15, LabelId: 7}).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.Equal(t, uint(15), event.TaskId)
+			assert.Equal(t, entities.TaskEventLabelAdded, event.Action)
+			assert.JSONEq(t, `{"label_id":{"old":null,"new":7}}`, string(event.Changes))
+			return nil
+		})
+
+		taskLabel, err := usecase.AddTaskLabel(ctx, alice, 15, 7)
+
+		if assert.NoError(t, err) {
+			assert.Equal(t, uint(7), taskLabel.LabelId)
+		}
+	})
+
+	t.Run("LabelNotFound", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Return(task, nil)
+		mockRepo.EXPECT().CreateTaskLabel(ctx, gomock.Any()).Return(domainerrors.Validation("label not found"))
+
+		_, err := usecase.AddTaskLabel(ctx, alice, 15, 9)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Return(task, nil)
+
+		_, err := usecase.AddTaskLabel(ctx, entities.Actor{Name: "carol", UserId: "carol", Role: entities.WorkspaceRoleMember}, 15, 7)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
+
+func TestRemoveTaskLabel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	mockRepo.EXPECT().Transaction(ctx, gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+		return fn(mockRepo)
+	})
+	mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(15)).Times(2).Return(&entities.Task{Id: 15, CreatedBy: lo.ToPtr("alice")}, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().DeleteTaskLabel(ctx, uint(15), uint(7)).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.Equal(t, entities.TaskEventLabelRemoved, event.Action)
+			assert.JSONEq(t, `{"label_id":{"old":7,"new":null}}`, string(event.Changes))
+			return nil
+		})
+
+		assert.NoError(t, usecase.RemoveTaskLabel(ctx, alice, 15, 7))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		notFound := domainerrors.NotFound("the task does not have this label")
+		mockRepo.EXPECT().DeleteTaskLabel(ctx, uint(15), uint(8)).Return(notFound)
+
+		assert.True(t, errors.Is(usecase.RemoveTaskLabel(ctx, alice, 15, 8), notFound))
+	})
+}
@@ -1,26 +1,53 @@
 package usecases
 
 import (
-	"errors"
+	"context"
+	"fmt"
 
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
 	"github.com/supachai1998/task_services/internal/entities"
 )
 
-// actionTaskStatus is a map not allowed update task information.
-var actionNotAllowed = map[entities.TaskStatus]bool{
-	entities.TaskStatusDone: true,
+func (u *usecase) UpdateTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate) error {
+	return u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		return u.updateTask(ctx, repo, actor, task)
+	})
 }
 
-func (u *usecase) UpdateTask(task *entities.TaskUpdate) error {
-	currentTask, err := u.taskRepo.GetByID(task.Id)
+func (u *usecase) updateTask(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, task *entities.TaskUpdate) error {
+	currentTask, err := repo.GetByIDForUpdate(ctx, task.Id)
 	if err != nil {
 		return err
 	}
-	if currentTask != nil {
-		if _, ok := actionNotAllowed[currentTask.Status]; ok {
-			return errors.New("this task status is done, cannot update")
-		}
+	if err := u.authorizeChange(actor, currentTask); err != nil {
+		return err
+	}
+	if err := checkVersion(currentTask, task.ExpectedVersions); err != nil {
+		return err
+	}
+	workflow, err := u.workflow(ctx)
+	if err != nil {
+		return err
+	}
+	if status, _ := workflow.Status(currentTask.Status); status.ReadOnly {
+		return domainerrors.Conflict(fmt.Sprintf("the tasks in status %s cannot be updated", currentTask.Status))
 	}
+	if task.TaskSchedule != nil && task.Priority == "" {
+		task.Priority = entities.TaskPriorityMedium
+	}
+
+	if err := repo.Update(ctx, task); err != nil {
+		return err
+	}
+	return recordEvent(ctx, repo, actor, entities.TaskEventUpdated, task.Id, updatedChanges(currentTask, task))
+}
 
-	return u.taskRepo.Update(task)
+// checkVersion fails when the task version is not one of the expected versions.
+func checkVersion(task *entities.Task, expectedVersions []uint) error {
+	if expectedVersions != nil && !lo.Contains(expectedVersions, task.Version) {
+		return domainerrors.PreconditionFailed("task has been modified since it was read")
+	}
+	return nil
 }
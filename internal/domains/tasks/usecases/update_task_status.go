@@ -1,31 +1,86 @@
 package usecases
 
 import (
-	"errors"
+	"context"
+	"fmt"
 
 	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
 	"github.com/supachai1998/task_services/internal/entities"
 )
 
-// actionTransitions is a map of allowed transitions between task statuses.
-var actionTransitions = map[entities.TaskStatus][]entities.TaskStatus{
-	entities.TaskStatusToDo: {
-		entities.TaskStatusInProgress,
-		entities.TaskStatusDone,
-	},
-	entities.TaskStatusInProgress: {
-		entities.TaskStatusDone,
-	},
-	entities.TaskStatusDone: {},
-}
+func (u *usecase) UpdateTaskStatus(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate) error {
+	workflow, err := u.workflow(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatus(workflow, task); err != nil {
+		return err
+	}
 
-func (u *usecase) UpdateTaskStatus(id uint, status entities.TaskStatus) error {
-	// Check if the status transition is allowed.
-	if _, ok := actionTransitions[status]; !ok {
-		return errors.New("invalid status")
-	}
-	return u.taskRepo.Update(&entities.TaskUpdate{
-		Id:     id,
-		Status: lo.ToPtr(status),
+	// Lock the task row so concurrent updates cannot race past the transition check.
+	return u.taskRepo.Transaction(ctx, func(repo interfaces.TaskRepository) error {
+		return u.updateTaskStatus(ctx, repo, actor, workflow, task)
 	})
 }
+
+// checkStatus fails when the target status of task is missing or not a status of workflow.
+func checkStatus(workflow *entities.Workflow, task *entities.TaskUpdate) error {
+	if task.Status == nil {
+		return domainerrors.Validation("invalid status")
+	}
+	if _, ok := workflow.Status(*task.Status); !ok {
+		return domainerrors.Validation("invalid status").
+			WithDetail("allowed_statuses", lo.Map(workflow.Statuses, func(status entities.WorkflowStatus, _ int) entities.TaskStatus {
+				return status.Name
+			}))
+	}
+	return nil
+}
+
+// updateTaskStatus changes the status of a task whose target status passed checkStatus.
+func (u *usecase) updateTaskStatus(ctx context.Context, repo interfaces.TaskRepository, actor entities.Actor, workflow *entities.Workflow, task *entities.TaskUpdate) error {
+	status, _ := workflow.Status(*task.Status)
+	currentTask, err := repo.GetByIDForUpdate(ctx, task.Id)
+	if err != nil {
+		return err
+	}
+	if err := u.authorizeChange(actor, currentTask); err != nil {
+		return err
+	}
+	if err := checkVersion(currentTask, task.ExpectedVersions); err != nil {
+		return err
+	}
+	// Check if the workflow allows the status transition.
+	transition, ok := workflow.Transition(currentTask.Status, status.Name)
+	if !ok {
+		return domainerrors.Conflict(fmt.Sprintf("cannot change task status from %s to %s", currentTask.Status, status.Name)).
+			WithDetail("current_status", currentTask.Status).
+			WithDetail("allowed_statuses", workflow.NextStatuses(currentTask.Status))
+	}
+	role := transition.Role
+	if role == "" {
+		role = entities.WorkspaceRoleMember
+	}
+	action := fmt.Sprintf("change task status from %s to %s", currentTask.Status, status.Name)
+	if err := authorizeRole(actor, role, action); err != nil {
+		return err
+	}
+	// Moving a task out of the todo statuses starts or completes it, which its open blockers prevent.
+	if status.Category != entities.StatusCategoryTodo {
+		if err := checkBlockers(ctx, repo, task.Id); err != nil {
+			return err
+		}
+	}
+	if status.Category == entities.StatusCategoryDone && !task.Force {
+		if err := checkSubtasksDone(ctx, repo, task.Id); err != nil {
+			return err
+		}
+	}
+	task.StatusCategory = status.Category
+	if err := repo.Update(ctx, task); err != nil {
+		return err
+	}
+	return recordEvent(ctx, repo, actor, entities.TaskEventStatusChanged, task.Id, updatedChanges(currentTask, task))
+}
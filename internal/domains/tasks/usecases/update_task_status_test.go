@@ -0,0 +1,178 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestUpdateTaskStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	actor := entities.Actor{Name: "alice", RequestId: "request-1"}
+
+	// Run the transaction callback against the same mock repository
+	inTransaction := func() {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(&entities.Task{Id: 1, Status: entities.TaskStatusToDo}, nil)
+		mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{1}).Return(map[uint][]uint{}, nil)
+		mockRepo.EXPECT().Update(ctx, &entities.TaskUpdate{Id: 1, Status: lo.ToPtr(entities.TaskStatusInProgress), StatusCategory: entities.StatusCategoryActive}).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			// The status change is recorded in the same transaction
+			assert.Equal(t, uint(1), event.TaskId)
+			assert.Equal(t, entities.TaskEventStatusChanged, event.Action)
+			assert.JSONEq(t, `{"status":{"old":"TO_DO","new":"IN_PROGRESS"}}`, string(event.Changes))
+			assert.Equal(t, "alice", event.Actor)
+			assert.Equal(t, "request-1", event.RequestId)
+			return nil
+		})
+
+		assert.NoError(t, usecase.UpdateTaskStatus(ctx, actor, &entities.TaskUpdate{Id: 1, Status: lo.ToPtr(entities.TaskStatusInProgress)}))
+	})
+
+	t.Run("InvalidTransition_DoneToInProgress", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(&entities.Task{Id: 2, Status: entities.TaskStatusDone}, nil)
+
+		err := usecase.UpdateTaskStatus(ctx, actor, &entities.TaskUpdate{Id: 2, Status: lo.ToPtr(entities.TaskStatusInProgress)})
+
+		var domainErr *domainerrors.Error
+		if assert.True(t, errors.As(err, &domainErr)) {
+			assert.Equal(t, domainerrors.KindConflict, domainErr.Kind)
+			assert.Equal(t, entities.TaskStatusDone, domainErr.Details["current_status"])
+			assert.Empty(t, domainErr.Details["allowed_statuses"])
+		}
+	})
+
+	t.Run("InvalidTransition_SameStatus", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(3)).Return(&entities.Task{Id: 3, Status: entities.TaskStatusInProgress}, nil)
+
+		err := usecase.UpdateTaskStatus(ctx, actor, &entities.TaskUpdate{Id: 3, Status: lo.ToPtr(entities.TaskStatusInProgress)})
+
+		var domainErr *domainerrors.Error
+		if assert.True(t, errors.As(err, &domainErr)) {
+			assert.Equal(t, domainerrors.KindConflict, domainErr.Kind)
+			assert.Equal(t, []entities.TaskStatus{entities.TaskStatusDone}, domainErr.Details["allowed_statuses"])
+		}
+	})
+
+	t.Run("PreconditionFailed_VersionMismatch", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(6)).Return(&entities.Task{Id: 6, Status: entities.TaskStatusToDo, Version: 3}, nil)
+
+		err := usecase.UpdateTaskStatus(ctx, actor, &entities.TaskUpdate{Id: 6, Status: lo.ToPtr(entities.TaskStatusDone), ExpectedVersions: []uint{2}})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindPreconditionFailed))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(4)).Return(nil, domainerrors.NotFound("task not found"))
+
+		assert.True(t, domainerrors.IsKind(usecase.UpdateTaskStatus(ctx, actor, &entities.TaskUpdate{Id: 4, Status: lo.ToPtr(entities.TaskStatusDone)}), domainerrors.KindNotFound))
+	})
+
+	t.Run("UnknownStatus", func(t *testing.T) {
+		assert.True(t, domainerrors.IsKind(usecase.UpdateTaskStatus(ctx, actor, &entities.TaskUpdate{Id: 5, Status: lo.ToPtr(entities.TaskStatus("ARCHIVED"))}), domainerrors.KindValidation))
+	})
+}
+
+func TestUpdateTaskStatus_Workflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockWorkflows := mocks.NewMockWorkflowReader(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{Workflows: mockWorkflows})
+	ctx := context.Background()
+	member := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	workflow := &entities.Workflow{
+		Name: "review",
+		Statuses: entities.WorkflowStatuses{
+			{Name: "BACKLOG", Category: entities.StatusCategoryTodo},
+			{Name: "IN_REVIEW", Category: entities.StatusCategoryActive},
+			{Name: "SHIPPED", Category: entities.StatusCategoryDone, ReadOnly: true},
+		},
+		Transitions: entities.WorkflowTransitions{
+			{From: "BACKLOG", To: "IN_REVIEW"},
+			{From: "IN_REVIEW", To: "SHIPPED", Role: entities.WorkspaceRoleAdmin},
+		},
+	}
+	mockWorkflows.EXPECT().GetActiveWorkflow(ctx).Return(workflow, nil).AnyTimes()
+
+	inTransaction := func() {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(&entities.Task{Id: 1, Status: "BACKLOG", CreatedBy: lo.ToPtr("alice")}, nil)
+		mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{1}).Return(map[uint][]uint{}, nil)
+		mockRepo.EXPECT().Update(ctx, &entities.TaskUpdate{Id: 1, Status: lo.ToPtr(entities.TaskStatus("IN_REVIEW")), StatusCategory: entities.StatusCategoryActive}).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+
+		assert.NoError(t, usecase.UpdateTaskStatus(ctx, member, &entities.TaskUpdate{Id: 1, Status: lo.ToPtr(entities.TaskStatus("IN_REVIEW"))}))
+	})
+
+	t.Run("StatusNotInWorkflow", func(t *testing.T) {
+		err := usecase.UpdateTaskStatus(ctx, member, &entities.TaskUpdate{Id: 1, Status: lo.ToPtr(entities.TaskStatusInProgress)})
+
+		var domainErr *domainerrors.Error
+		if assert.True(t, errors.As(err, &domainErr)) {
+			assert.Equal(t, domainerrors.KindValidation, domainErr.Kind)
+			assert.Equal(t, []entities.TaskStatus{"BACKLOG", "IN_REVIEW", "SHIPPED"}, domainErr.Details["allowed_statuses"])
+		}
+	})
+
+	t.Run("Forbidden_TransitionRole", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(2)).Return(&entities.Task{Id: 2, Status: "IN_REVIEW", CreatedBy: lo.ToPtr("alice")}, nil)
+
+		err := usecase.UpdateTaskStatus(ctx, member, &entities.TaskUpdate{Id: 2, Status: lo.ToPtr(entities.TaskStatus("SHIPPED"))})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+
+	t.Run("DoneCategory_ChecksSubtasks", func(t *testing.T) {
+		admin := entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleAdmin}
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(3)).Return(&entities.Task{Id: 3, Status: "IN_REVIEW"}, nil)
+		mockRepo.EXPECT().ListOpenBlockers(ctx, []uint{3}).Return(map[uint][]uint{}, nil)
+		mockRepo.EXPECT().ChildProgress(ctx, []uint{3}).Return(map[uint]entities.TaskProgress{}, nil)
+		mockRepo.EXPECT().Update(ctx, &entities.TaskUpdate{Id: 3, Status: lo.ToPtr(entities.TaskStatus("SHIPPED")), StatusCategory: entities.StatusCategoryDone}).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.Any()).Return(nil)
+
+		assert.NoError(t, usecase.UpdateTaskStatus(ctx, admin, &entities.TaskUpdate{Id: 3, Status: lo.ToPtr(entities.TaskStatus("SHIPPED"))}))
+	})
+
+	t.Run("ReadOnlyStatus", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(4)).Return(&entities.Task{Id: 4, Status: "SHIPPED", CreatedBy: lo.ToPtr("alice")}, nil)
+
+		err := usecase.UpdateTask(ctx, member, &entities.TaskUpdate{Id: 4, Title: lo.ToPtr("Renamed")})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+	})
+}
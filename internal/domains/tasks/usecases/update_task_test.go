@@ -0,0 +1,75 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/tasks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/tasks/interfaces"
+)
+
+func TestUpdateTask_Schedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	usecase := usecases.NewTaskUsecase(mockRepo, usecases.Options{})
+	ctx := context.Background()
+	alice := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleMember}
+	dueAt := time.Date(2026, 11, 30, 17, 0, 0, 0, time.UTC)
+	current := &entities.Task{
+		Id: 1, Title: "Plan the sprint", Description: "Pick the stories", CreatedBy: lo.ToPtr("alice"),
+		TaskSchedule: entities.TaskSchedule{DueAt: &dueAt, Priority: entities.TaskPriorityHigh, EstimateMinutes: lo.ToPtr(90)},
+	}
+
+	inTransaction := func() {
+		mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.TaskRepository) error) error {
+			return fn(mockRepo)
+		})
+	}
+
+	t.Run("ClearedWithDefaultPriority", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(current, nil)
+		mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, task *entities.TaskUpdate) error {
+			assert.Equal(t, entities.TaskSchedule{Priority: entities.TaskPriorityMedium}, *task.TaskSchedule)
+			return nil
+		})
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.JSONEq(t, `{
+				"due_at": {"old": "2026-11-30T17:00:00Z", "new": null},
+				"priority": {"old": "HIGH", "new": "MEDIUM"},
+				"estimate_minutes": {"old": 90, "new": null}
+			}`, string(event.Changes))
+			return nil
+		})
+
+		err := usecase.UpdateTask(ctx, alice, &entities.TaskUpdate{
+			Id: 1, Title: lo.ToPtr("Plan the sprint"), Description: lo.ToPtr("Pick the stories"), TaskSchedule: &entities.TaskSchedule{},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("SameInstantIsUnchanged", func(t *testing.T) {
+		inTransaction()
+		mockRepo.EXPECT().GetByIDForUpdate(ctx, uint(1)).Return(current, nil)
+		mockRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().CreateEvent(ctx, gomock.AssignableToTypeOf(&entities.TaskEvent{})).DoAndReturn(func(_ context.Context, event *entities.TaskEvent) error {
+			assert.JSONEq(t, `{}`, string(event.Changes))
+			return nil
+		})
+
+		bangkok := dueAt.In(time.FixedZone("ICT", 7*60*60))
+		err := usecase.UpdateTask(ctx, alice, &entities.TaskUpdate{
+			Id:           1,
+			TaskSchedule: &entities.TaskSchedule{DueAt: &bangkok, Priority: entities.TaskPriorityHigh, EstimateMinutes: lo.ToPtr(90)},
+		})
+		assert.NoError(t, err)
+	})
+}
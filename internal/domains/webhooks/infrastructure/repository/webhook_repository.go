@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	errWebhookNotFound  = domainerrors.NotFound("webhook not found")
+	errDeliveryNotFound = domainerrors.NotFound("webhook delivery not found")
+	errNoWorkspace      = domainerrors.Internal(errors.New("repository: the context has no workspace"))
+)
+
+// Options tunes the webhook repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type repository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewWebhookRepository(db *gorm.DB, options Options) interfaces.WebhookRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) Create(ctx context.Context, webhook *entities.Webhook) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	webhook.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(webhook).Error)
+}
+
+func (r *repository) GetByID(ctx context.Context, id uint) (*entities.Webhook, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var webhook entities.Webhook
+	if err := db.Take(&webhook, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &webhook, nil
+}
+
+func (r *repository) List(ctx context.Context) ([]entities.Webhook, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	webhooks := []entities.Webhook{}
+	if err := db.Order("id").Find(&webhooks).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return webhooks, nil
+}
+
+func (r *repository) Update(ctx context.Context, webhook *entities.Webhook) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	webhook.UpdatedAt = time.Now()
+	result := db.Model(&entities.Webhook{}).
+		Where("id = ?", webhook.Id).
+		Updates(map[string]any{
+			"url":        webhook.Url,
+			"secret":     webhook.Secret,
+			"events":     webhook.Events,
+			"updated_at": webhook.UpdatedAt,
+		})
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errWebhookNotFound
+	}
+	return nil
+}
+
+// Delete relies on the foreign key of the deliveries to delete them.
+func (r *repository) Delete(ctx context.Context, id uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Delete(&entities.Webhook{}, id)
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errWebhookNotFound
+	}
+	return nil
+}
+
+func (r *repository) CreateDeliveries(ctx context.Context, deliveries []*entities.WebhookDelivery) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	if len(deliveries) == 0 {
+		return nil
+	}
+	workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+	for _, delivery := range deliveries {
+		delivery.WorkspaceId = workspaceID
+	}
+	return wrapError(db.Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error)
+}
+
+func (r *repository) GetDelivery(ctx context.Context, webhookID, id uint) (*entities.WebhookDelivery, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var delivery entities.WebhookDelivery
+	if err := db.Where("webhook_id = ?", webhookID).Take(&delivery, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errDeliveryNotFound
+		}
+		return nil, wrapError(err)
+	}
+	return &delivery, nil
+}
+
+func (r *repository) ListDeliveries(ctx context.Context, webhookID uint, query *models.ListDeliveriesQuery) ([]entities.WebhookDelivery, string, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	tx := db.Where("webhook_id = ?", webhookID)
+	if query.Cursor != "" {
+		_, id, err := helpers.DecodeCursor(query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		tx = tx.Where("id < ?", id)
+	}
+
+	// Fetch one extra row to know whether there is a next page.
+	deliveries := []entities.WebhookDelivery{}
+	if err := tx.Order("id DESC").Limit(query.Limit + 1).Find(&deliveries).Error; err != nil {
+		return nil, "", wrapError(err)
+	}
+	if len(deliveries) <= query.Limit {
+		return deliveries, "", nil
+	}
+	deliveries = deliveries[:query.Limit]
+	return deliveries, helpers.EncodeCursor("", deliveries[len(deliveries)-1].Id), nil
+}
+
+func (r *repository) ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entities.WebhookDelivery, error) {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	deliveries := []entities.WebhookDelivery{}
+	err := db.Raw(`UPDATE webhook_deliveries SET attempts = attempts + 1, next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, id LIMIT ? FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), entities.WebhookDeliveryPending, now, limit).
+		Scan(&deliveries).Error
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return deliveries, nil
+}
+
+func (r *repository) GetByIDsAnyWorkspace(ctx context.Context, ids []uint) (map[uint]entities.Webhook, error) {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	webhooks := map[uint]entities.Webhook{}
+	if len(ids) == 0 {
+		return webhooks, nil
+	}
+	var rows []entities.Webhook
+	if err := db.Where("id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	for _, webhook := range rows {
+		webhooks[webhook.Id] = webhook
+	}
+	return webhooks, nil
+}
+
+func (r *repository) FinishDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	db, cancel := r.withTimeout(ctx)
+	defer cancel()
+	return wrapError(db.Model(&entities.WebhookDelivery{}).
+		Where("id = ?", delivery.Id).
+		Updates(map[string]any{
+			"status":          delivery.Status,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"response_status": delivery.ResponseStatus,
+			"last_error":      delivery.LastError,
+			"delivered_at":    delivery.DeliveredAt,
+		}).Error)
+}
+
+// withTimeout binds the queries to ctx, bounded by the query timeout.
+func (r *repository) withTimeout(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	return r.db.WithContext(ctx).Session(&gorm.Session{}), cancel
+}
+
+// withContext binds the queries to ctx and to the workspace of ctx, bounded by the query timeout.
+func (r *repository) withContext(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	db, cancel := r.withTimeout(ctx)
+	workspaceID, ok := helpers.WorkspaceIDFromContext(ctx)
+	if !ok {
+		_ = db.AddError(errNoWorkspace)
+		return db, cancel
+	}
+	return db.Where("workspace_id = ?", workspaceID).Session(&gorm.Session{}), cancel
+}
+
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errWebhookNotFound
+	default:
+		return domainerrors.Internal(err)
+	}
+}
@@ -0,0 +1,168 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newRepository(t *testing.T) (interfaces.WebhookRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewWebhookRepository(db, repository.Options{}), mock
+}
+
+func TestWebhookRepository(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 2)
+
+	t.Run("CreateStoresEventsSpaceSeparated", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhooks" ("workspace_id","url","secret","events","created_by","created_at","updated_at")`)).
+			WithArgs(2, "https://ci.example.com/hooks", "0123456789abcdef", "task.created task.deleted", "alice",
+				sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+
+		webhook := &entities.Webhook{
+			Url:       "https://ci.example.com/hooks",
+			Secret:    "0123456789abcdef",
+			Events:    entities.WebhookEvents{entities.WebhookEventTaskCreated, entities.WebhookEventTaskDeleted},
+			CreatedBy: "alice",
+		}
+		if assert.NoError(t, repo.Create(ctx, webhook)) {
+			assert.Equal(t, uint(2), webhook.WorkspaceId)
+			assert.Equal(t, uint(3), webhook.Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListScansEvents", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhooks" WHERE workspace_id = $1 ORDER BY id`)).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "events"}).AddRow(3, "task.created task.updated"))
+
+		webhooks, err := repo.List(ctx)
+
+		if assert.NoError(t, err) && assert.Len(t, webhooks, 1) {
+			assert.Equal(t, entities.WebhookEvents{entities.WebhookEventTaskCreated, entities.WebhookEventTaskUpdated}, webhooks[0].Events)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhooks" SET "events"=$1,"secret"=$2,"updated_at"=$3,"url"=$4 WHERE workspace_id = $5 AND id = $6`)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Update(ctx, &entities.Webhook{Id: 9, Url: "https://ci.example.com/hooks"})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateDeliveriesInTheWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		// A delivery of an event the webhook already has a delivery of is skipped.
+		mock.ExpectQuery(`INSERT INTO "webhook_deliveries" .* ON CONFLICT DO NOTHING RETURNING "id"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(21))
+
+		delivery := &entities.WebhookDelivery{WebhookId: 3, Payload: entities.JSON(`{}`)}
+		if assert.NoError(t, repo.CreateDeliveries(ctx, []*entities.WebhookDelivery{delivery})) {
+			assert.Equal(t, uint(2), delivery.WorkspaceId)
+			assert.Equal(t, uint(21), delivery.Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetDeliveryOfAnotherWebhook", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE workspace_id = $1 AND webhook_id = $2 AND "webhook_deliveries"."id" = $3 LIMIT 1`)).
+			WithArgs(2, 3, 21).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetDelivery(ctx, 3, 21)
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListDeliveriesNewestFirst", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_deliveries" WHERE workspace_id = $1 AND webhook_id = $2 AND id < $3 ORDER BY id DESC LIMIT 3`)).
+			WithArgs(2, 3, 30).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(29).AddRow(28).AddRow(27))
+
+		deliveries, nextCursor, err := repo.ListDeliveries(ctx, 3, &models.ListDeliveriesQuery{
+			Limit:  2,
+			Cursor: helpers.EncodeCursor("", 30),
+		})
+
+		if assert.NoError(t, err) && assert.Len(t, deliveries, 2) {
+			assert.Equal(t, helpers.EncodeCursor("", 28), nextCursor)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ClaimDueDeliveriesOfEveryWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(`UPDATE webhook_deliveries SET attempts = attempts \+ 1, next_attempt_at = \$1\s+WHERE id IN \(\s+SELECT id FROM webhook_deliveries WHERE status = \$2 AND next_attempt_at <= \$3\s+ORDER BY next_attempt_at, id LIMIT \$4 FOR UPDATE SKIP LOCKED`).
+			WithArgs(now.Add(time.Minute), "pending", now, 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "workspace_id", "webhook_id", "attempts"}).AddRow(21, 5, 3, 1))
+
+		deliveries, err := repo.ClaimDueDeliveries(context.Background(), now, time.Minute, 20)
+
+		if assert.NoError(t, err) && assert.Len(t, deliveries, 1) {
+			assert.Equal(t, uint(5), deliveries[0].WorkspaceId)
+			assert.Equal(t, 1, deliveries[0].Attempts)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("FinishDelivery", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		status := 204
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "delivered_at"=$1,"last_error"=$2,"next_attempt_at"=$3,"response_status"=$4,"status"=$5 WHERE id = $6`)).
+			WithArgs(sqlmock.AnyArg(), "", sqlmock.AnyArg(), 204, "succeeded", 21).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		now := time.Now()
+		err := repo.FinishDelivery(context.Background(), &entities.WebhookDelivery{
+			Id:             21,
+			Status:         entities.WebhookDeliverySucceeded,
+			ResponseStatus: &status,
+			DeliveredAt:    &now,
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoWorkspace", func(t *testing.T) {
+		repo, _ := newRepository(t)
+
+		_, err := repo.List(context.Background())
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+	})
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// CreateWebhook creates a webhook
+// @Summary Create a webhook
+// @Description Subscribe a URL to events of the tasks of the workspace. The secret is never returned. Only admins of the workspace can
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body models.WebhookRequest true "Webhook"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=entities.Webhook} "Webhook created"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/webhooks [post]
+func (h *Handler) CreateWebhook(c echo.Context) error {
+	req := new(models.WebhookRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	webhook := req.Webhook()
+	if err := h.WebhookUsecase.CreateWebhook(c.Request().Context(), interfaces.ActorFrom(c), webhook); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Webhook created", webhook))
+}
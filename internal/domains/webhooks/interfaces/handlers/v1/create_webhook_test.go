@@ -0,0 +1,81 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/webhooks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/usecases"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWebhookUsecase(ctrl)
+	handler := &handlers.Handler{WebhookUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/webhooks", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/webhooks")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expected := &entities.Webhook{
+			Url:    "https://ci.example.com/hooks",
+			Secret: "0123456789abcdef",
+			Events: entities.WebhookEvents{entities.WebhookEventTaskCreated, entities.WebhookEventTaskDeleted},
+		}
+		mockUsecase.EXPECT().CreateWebhook(gomock.Any(), anonymous, expected).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, webhook *entities.Webhook) error {
+				webhook.Id = 3
+				return nil
+			},
+		)
+
+		c, rec := newContext(`{
+			"url": "https://ci.example.com/hooks",
+			"secret": "0123456789abcdef",
+			"events": ["task.created", "task.deleted", "task.created"]
+		}`)
+		if assert.NoError(t, handler.CreateWebhook(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			assert.NotContains(t, rec.Body.String(), "0123456789abcdef")
+
+			var response struct {
+				Data entities.Webhook `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, uint(3), response.Data.Id)
+		}
+	})
+
+	t.Run("BadRequest_UnknownEvent", func(t *testing.T) {
+		c, rec := newContext(`{"url": "https://ci.example.com/hooks", "secret": "0123456789abcdef", "events": ["task.moved"]}`)
+		if assert.Error(t, invoke(handler.CreateWebhook, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_ShortSecret", func(t *testing.T) {
+		c, rec := newContext(`{"url": "https://ci.example.com/hooks", "secret": "short", "events": ["task.created"]}`)
+		if assert.Error(t, invoke(handler.CreateWebhook, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
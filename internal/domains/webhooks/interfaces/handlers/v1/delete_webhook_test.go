@@ -0,0 +1,51 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/webhooks/interfaces/handlers/v1"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/usecases"
+)
+
+func TestDeleteWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWebhookUsecase(ctrl)
+	handler := &handlers.Handler{WebhookUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodDelete, "/v1/webhooks/"+id, nil), rec)
+		c.SetPath("/v1/webhooks/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteWebhook(gomock.Any(), anonymous, uint(6)).Return(nil)
+
+		c, rec := newContext("6")
+		if assert.NoError(t, handler.DeleteWebhook(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteWebhook(gomock.Any(), anonymous, uint(5)).
+			Return(domainerrors.Forbidden("the admin role is required to manage webhooks"))
+
+		c, rec := newContext("5")
+		if assert.Error(t, invoke(handler.DeleteWebhook, c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+	})
+}
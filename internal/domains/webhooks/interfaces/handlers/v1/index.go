@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+type Handler struct {
+	WebhookUsecase usecases.WebhookUsecase
+}
+
+func NewWebhookHandler(e *echo.Echo, webhookUsecase usecases.WebhookUsecase) {
+	handler := &Handler{
+		WebhookUsecase: webhookUsecase,
+	}
+	// Webhooks receive the changes of the tasks of the workspace, so they take the task scopes of
+	// API keys; they are reserved to admins, reads included, since they expose where the tasks go.
+	read := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleAdmin),
+		interfaces.RequireScope(entities.ScopeTasksRead),
+	}
+	write := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleAdmin),
+		interfaces.RequireScope(entities.ScopeTasksWrite),
+	}
+	remove := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleAdmin),
+		interfaces.RequireScope(entities.ScopeTasksDelete),
+	}
+
+	e.POST("/v1/webhooks", handler.CreateWebhook, write...)
+	e.GET("/v1/webhooks", handler.ListWebhooks, read...)
+	e.GET("/v1/webhooks/:id", handler.GetWebhook, read...)
+	e.PUT("/v1/webhooks/:id", handler.UpdateWebhook, write...)
+	e.DELETE("/v1/webhooks/:id", handler.DeleteWebhook, remove...)
+	e.GET("/v1/webhooks/:id/deliveries", handler.ListDeliveries, read...)
+	e.POST("/v1/webhooks/:id/deliveries/:delivery_id/redeliver", handler.Redeliver, write...)
+}
+
+// parseIDParam reads an ID path parameter.
+func parseIDParam(c echo.Context, name string) (uint, error) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 0)
+	if err != nil {
+		return 0, domainerrors.Validation("Invalid ID format")
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// ListDeliveries lists the deliveries of a webhook
+// @Summary List the deliveries of a webhook
+// @Description List the deliveries of a webhook, newest first, with their status, attempts and last response. Only admins of the workspace can
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param limit query int false "Page size" minimum(1) maximum(100) default(20)
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponsePaginated{data=[]entities.WebhookDelivery} "Deliveries listed"
+// @Failure 400 {object} models.ProblemDetails "Invalid query"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Webhook not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/webhooks/{id}/deliveries [get]
+func (h *Handler) ListDeliveries(c echo.Context) error {
+	webhookID, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+	query := new(models.ListDeliveriesQuery)
+	if err := c.Bind(query); err != nil {
+		return err
+	}
+	if err := c.Validate(query); err != nil {
+		return err
+	}
+
+	deliveries, nextCursor, err := h.WebhookUsecase.ListDeliveries(c.Request().Context(), webhookID, query)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponsePaginated("Deliveries listed", deliveries, nextCursor))
+}
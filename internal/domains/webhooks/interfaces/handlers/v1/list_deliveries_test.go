@@ -0,0 +1,53 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/webhooks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/usecases"
+)
+
+func TestListDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWebhookUsecase(ctrl)
+	handler := &handlers.Handler{WebhookUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(target string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, target, nil), rec)
+		c.SetPath("/v1/webhooks/:id/deliveries")
+		c.SetParamNames("id")
+		c.SetParamValues("3")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().ListDeliveries(gomock.Any(), uint(3), &models.ListDeliveriesQuery{Limit: 2}).
+			Return([]entities.WebhookDelivery{{Id: 12}, {Id: 11}}, "next", nil)
+
+		c, rec := newContext("/v1/webhooks/3/deliveries?limit=2")
+		if assert.NoError(t, handler.ListDeliveries(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"next_cursor":"next"`)
+		}
+	})
+
+	t.Run("BadRequest_Limit", func(t *testing.T) {
+		c, rec := newContext("/v1/webhooks/3/deliveries?limit=500")
+		if assert.Error(t, invoke(handler.ListDeliveries, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// ListWebhooks lists the webhooks
+// @Summary List the webhooks
+// @Description List the webhooks of the workspace, oldest first. Only admins of the workspace can
+// @Tags webhooks
+// @Produce json
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=[]entities.Webhook} "Webhooks listed"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/webhooks [get]
+func (h *Handler) ListWebhooks(c echo.Context) error {
+	webhooks, err := h.WebhookUsecase.ListWebhooks(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Webhooks listed", webhooks))
+}
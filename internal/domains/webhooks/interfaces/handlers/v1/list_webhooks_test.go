@@ -0,0 +1,52 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/webhooks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/usecases"
+)
+
+func TestListWebhooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWebhookUsecase(ctrl)
+	handler := &handlers.Handler{WebhookUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/webhooks", nil), rec)
+		c.SetPath("/v1/webhooks")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().ListWebhooks(gomock.Any()).
+			Return([]entities.Webhook{{Id: 1, Url: "https://ci.example.com/hooks", Secret: "0123456789abcdef"}}, nil)
+
+		c, rec := newContext()
+		if assert.NoError(t, handler.ListWebhooks(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), "https://ci.example.com/hooks")
+			assert.NotContains(t, rec.Body.String(), "0123456789abcdef")
+		}
+	})
+
+	t.Run("InternalServerError", func(t *testing.T) {
+		mockUsecase.EXPECT().ListWebhooks(gomock.Any()).Return(nil, errors.New("database error"))
+
+		c, rec := newContext()
+		if assert.Error(t, invoke(handler.ListWebhooks, c)) {
+			assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		}
+	})
+}
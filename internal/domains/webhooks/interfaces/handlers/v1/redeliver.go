@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// Redeliver queues a delivery again
+// @Summary Redeliver a delivery
+// @Description Queue a new delivery of the payload of a delivery, whatever its status; the dispatcher sends it shortly. Only admins of the workspace can
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param delivery_id path int true "Delivery ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 202 {object} models.ResponseSuccess{data=entities.WebhookDelivery} "Delivery queued"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Webhook or delivery not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (h *Handler) Redeliver(c echo.Context) error {
+	webhookID, err := parseIDParam(c, "id")
+	if err != nil {
+		return err
+	}
+	deliveryID, err := parseIDParam(c, "delivery_id")
+	if err != nil {
+		return err
+	}
+	delivery, err := h.WebhookUsecase.Redeliver(c.Request().Context(), interfaces.ActorFrom(c), webhookID, deliveryID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusAccepted, helpers.NewResponseSuccess("Delivery queued", delivery))
+}
@@ -0,0 +1,62 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/webhooks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/usecases"
+)
+
+func TestRedeliver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWebhookUsecase(ctrl)
+	handler := &handlers.Handler{WebhookUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(webhookID, deliveryID string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		target := "/v1/webhooks/" + webhookID + "/deliveries/" + deliveryID + "/redeliver"
+		c := e.NewContext(httptest.NewRequest(http.MethodPost, target, nil), rec)
+		c.SetPath("/v1/webhooks/:id/deliveries/:delivery_id/redeliver")
+		c.SetParamNames("id", "delivery_id")
+		c.SetParamValues(webhookID, deliveryID)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().Redeliver(gomock.Any(), anonymous, uint(3), uint(11)).
+			Return(&entities.WebhookDelivery{Id: 20, WebhookId: 3, Status: entities.WebhookDeliveryPending}, nil)
+
+		c, rec := newContext("3", "11")
+		if assert.NoError(t, handler.Redeliver(c)) {
+			assert.Equal(t, http.StatusAccepted, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"status":"pending"`)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().Redeliver(gomock.Any(), anonymous, uint(3), uint(99)).
+			Return(nil, domainerrors.NotFound("webhook delivery not found"))
+
+		c, rec := newContext("3", "99")
+		if assert.Error(t, invoke(handler.Redeliver, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidDeliveryID", func(t *testing.T) {
+		c, rec := newContext("3", "x")
+		if assert.Error(t, invoke(handler.Redeliver, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/webhooks/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/usecases"
+)
+
+func TestUpdateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWebhookUsecase(ctrl)
+	handler := &handlers.Handler{WebhookUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/v1/webhooks/"+id, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/webhooks/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+	body := `{"url": "https://ci.example.com/v2/hooks", "secret": "fedcba9876543210", "events": ["task.status_changed"]}`
+
+	t.Run("Success", func(t *testing.T) {
+		expected := &entities.Webhook{
+			Id:     7,
+			Url:    "https://ci.example.com/v2/hooks",
+			Secret: "fedcba9876543210",
+			Events: entities.WebhookEvents{entities.WebhookEventTaskStatusChanged},
+		}
+		mockUsecase.EXPECT().UpdateWebhook(gomock.Any(), anonymous, expected).Return(nil)
+
+		c, rec := newContext("7", body)
+		if assert.NoError(t, handler.UpdateWebhook(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateWebhook(gomock.Any(), anonymous, gomock.Any()).Return(domainerrors.NotFound("webhook not found"))
+
+		c, rec := newContext("8", body)
+		if assert.Error(t, invoke(handler.UpdateWebhook, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("BadRequest_InvalidURL", func(t *testing.T) {
+		c, rec := newContext("7", `{"url": "not a url", "secret": "fedcba9876543210", "events": ["task.updated"]}`)
+		if assert.Error(t, invoke(handler.UpdateWebhook, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// WebhookRepository stores the webhooks of the workspace of ctx and their deliveries. The methods
+// of the dispatcher work on the deliveries of every workspace.
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *entities.Webhook) error
+	GetByID(ctx context.Context, id uint) (*entities.Webhook, error)
+	// List returns every webhook, by ID.
+	List(ctx context.Context) ([]entities.Webhook, error)
+	// Update saves the URL, the secret and the events of a webhook.
+	Update(ctx context.Context, webhook *entities.Webhook) error
+	// Delete deletes a webhook and its deliveries.
+	Delete(ctx context.Context, id uint) error
+	// CreateDeliveries queues deliveries with a single multi-row insert, skipping those of an event
+	// the webhook already has a delivery of.
+	CreateDeliveries(ctx context.Context, deliveries []*entities.WebhookDelivery) error
+	GetDelivery(ctx context.Context, webhookID, id uint) (*entities.WebhookDelivery, error)
+	// ListDeliveries returns one page of the deliveries of a webhook, newest first.
+	ListDeliveries(ctx context.Context, webhookID uint, query *models.ListDeliveriesQuery) ([]entities.WebhookDelivery, string, error)
+	// ClaimDueDeliveries counts an attempt on up to limit pending deliveries of every workspace that are
+	// due at now and postpones them until now+lease, so other dispatchers skip them while they are sent
+	// and retry them if the dispatcher stops before finishing them.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entities.WebhookDelivery, error)
+	// GetByIDsAnyWorkspace returns the webhooks of ids of every workspace, by ID.
+	GetByIDsAnyWorkspace(ctx context.Context, ids []uint) (map[uint]entities.Webhook, error)
+	// FinishDelivery saves the outcome of the attempt of a claimed delivery of any workspace.
+	FinishDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error
+}
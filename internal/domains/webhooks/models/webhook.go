@@ -0,0 +1,34 @@
+package models
+
+import "github.com/supachai1998/task_services/internal/entities"
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// WebhookRequest is the body of the requests creating or replacing a webhook.
+type WebhookRequest struct {
+	// Url receives the events as POST requests; it must be an http or https URL.
+	Url string `json:"url" validate:"required,url,max=2048" example:"https://ci.example.com/hooks/tasks"`
+	// Secret keys the HMAC-SHA256 signature of the requests, sent in X-Webhook-Signature.
+	Secret string   `json:"secret" validate:"required,min=16,max=255" example:"8f14e45fceea167a5a36dedd4bea2543"`
+	Events []string `json:"events" validate:"required,min=1,max=4,dive,oneof=task.created task.updated task.status_changed task.deleted" example:"task.created,task.status_changed"`
+}
+
+// Webhook returns the webhook of the request.
+func (r WebhookRequest) Webhook() *entities.Webhook {
+	events := make(entities.WebhookEvents, 0, len(r.Events))
+	for _, event := range r.Events {
+		if !events.Contains(entities.WebhookEvent(event)) {
+			events = append(events, entities.WebhookEvent(event))
+		}
+	}
+	return &entities.Webhook{Url: r.Url, Secret: r.Secret, Events: events}
+}
+
+// ListDeliveriesQuery is the pagination query of GET /v1/webhooks/{id}/deliveries.
+type ListDeliveriesQuery struct {
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `query:"cursor" validate:"omitempty,max=512"`
+}
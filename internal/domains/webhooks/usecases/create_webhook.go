@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// CreateWebhook is reserved to admins, since webhooks receive every change of the tasks they subscribe to.
+func (u *usecase) CreateWebhook(ctx context.Context, actor entities.Actor, webhook *entities.Webhook) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleAdmin, "manage webhooks"); err != nil {
+		return err
+	}
+	if err := checkURL(webhook.Url); err != nil {
+		return err
+	}
+	webhook.CreatedBy = actor.Name
+	return u.webhookRepo.Create(ctx, webhook)
+}
@@ -0,0 +1,50 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/interfaces"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	usecase := usecases.NewWebhookUsecase(mockRepo, usecases.Options{})
+	admin := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleAdmin}
+	newWebhook := func(url string) *entities.Webhook {
+		return &entities.Webhook{
+			Url:    url,
+			Secret: "0123456789abcdef",
+			Events: entities.WebhookEvents{entities.WebhookEventTaskCreated},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		webhook := newWebhook("https://ci.example.com/hooks")
+		assert.NoError(t, usecase.CreateWebhook(ctx, admin, webhook))
+		assert.Equal(t, "alice", webhook.CreatedBy)
+	})
+
+	t.Run("Validation_Scheme", func(t *testing.T) {
+		err := usecase.CreateWebhook(ctx, admin, newWebhook("ftp://ci.example.com/hooks"))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		member := entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleMember}
+
+		err := usecase.CreateWebhook(ctx, member, newWebhook("https://ci.example.com/hooks"))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+}
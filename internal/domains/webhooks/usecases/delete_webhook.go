@@ -0,0 +1,14 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) DeleteWebhook(ctx context.Context, actor entities.Actor, id uint) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleAdmin, "manage webhooks"); err != nil {
+		return err
+	}
+	return u.webhookRepo.Delete(ctx, id)
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+func (u *usecase) ListDeliveries(ctx context.Context, webhookID uint, query *models.ListDeliveriesQuery) ([]entities.WebhookDelivery, string, error) {
+	// Fail with 404 rather than an empty page for a webhook of another workspace.
+	if _, err := u.webhookRepo.GetByID(ctx, webhookID); err != nil {
+		return nil, "", err
+	}
+	if query.Limit <= 0 {
+		query.Limit = models.DefaultListLimit
+	}
+	if query.Limit > models.MaxListLimit {
+		query.Limit = models.MaxListLimit
+	}
+	return u.webhookRepo.ListDeliveries(ctx, webhookID, query)
+}
+
+// Redeliver leaves the original delivery as it is, so the log keeps every attempt.
+func (u *usecase) Redeliver(ctx context.Context, actor entities.Actor, webhookID, deliveryID uint) (*entities.WebhookDelivery, error) {
+	if err := authorizeRole(actor, entities.WorkspaceRoleAdmin, "manage webhooks"); err != nil {
+		return nil, err
+	}
+	original, err := u.webhookRepo.GetDelivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	delivery := &entities.WebhookDelivery{
+		WebhookId:     original.WebhookId,
+		Event:         original.Event,
+		TaskEventId:   original.TaskEventId,
+		Payload:       original.Payload,
+		Status:        entities.WebhookDeliveryPending,
+		NextAttemptAt: u.options.Now(),
+	}
+	if err := u.webhookRepo.CreateDeliveries(ctx, []*entities.WebhookDelivery{delivery}); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// HandleDomainEvent subscribes the webhooks to the domain events relayed from the outbox. The
+// relay calls it again when it fails, and may call it again after it succeeded: the deliveries
+// carry the event ID, so the webhooks that already have a delivery of the event get no other.
+func (u *usecase) HandleDomainEvent(ctx context.Context, event entities.DomainEvent) error {
+	ctx = helpers.ContextWithWorkspaceID(ctx, event.WorkspaceId)
+	webhooks, err := u.webhookRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+	payload := entities.NewWebhookPayload(&event.TaskEvent)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return domainerrors.Internal(err)
+	}
+	var deliveries []*entities.WebhookDelivery
+	for _, webhook := range webhooks {
+		if !webhook.Events.Contains(payload.Event) {
+			continue
+		}
+		deliveries = append(deliveries, &entities.WebhookDelivery{
+			WebhookId:     webhook.Id,
+			Event:         payload.Event,
+			TaskEventId:   event.TaskEvent.Id,
+			EventId:       &event.Id,
+			Payload:       body,
+			Status:        entities.WebhookDeliveryPending,
+			NextAttemptAt: u.options.Now(),
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return u.webhookRepo.CreateDeliveries(ctx, deliveries)
+}
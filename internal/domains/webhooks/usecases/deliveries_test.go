@@ -0,0 +1,216 @@
+package usecases_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/outbox/infrastructure/sinks"
+	outboxInterfaces "github.com/supachai1998/task_services/internal/domains/outbox/interfaces"
+	outboxUsecases "github.com/supachai1998/task_services/internal/domains/outbox/usecases"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	outboxMocks "github.com/supachai1998/task_services/internal/mocks/outbox/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/interfaces"
+)
+
+func TestHandleDomainEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The relay has no workspace; the event brings its own.
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 2)
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	usecase := usecases.NewWebhookUsecase(mockRepo, usecases.Options{Now: func() time.Time { return now }})
+	newEvent := func(action entities.TaskEventAction) entities.DomainEvent {
+		return entities.DomainEvent{
+			Id:          "0b9a1c9e-5d4f-4f0e-9a51-63d1f6b0c2aa",
+			Type:        entities.DomainEventTypeOf(action),
+			WorkspaceId: 2,
+			TaskId:      7,
+			TaskEvent:   entities.TaskEvent{Id: 11, TaskId: 7, WorkspaceId: 2, Action: action, Changes: entities.JSON(`{}`)},
+		}
+	}
+
+	t.Run("FiltersByEvent", func(t *testing.T) {
+		mockRepo.EXPECT().List(ctx).Return([]entities.Webhook{
+			{Id: 1, Events: entities.WebhookEvents{entities.WebhookEventTaskStatusChanged}},
+			{Id: 2, Events: entities.WebhookEvents{entities.WebhookEventTaskCreated, entities.WebhookEventTaskUpdated}},
+		}, nil)
+		mockRepo.EXPECT().CreateDeliveries(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, deliveries []*entities.WebhookDelivery) error {
+				if assert.Len(t, deliveries, 1) {
+					assert.Equal(t, uint(2), deliveries[0].WebhookId)
+					assert.Equal(t, entities.WebhookEventTaskUpdated, deliveries[0].Event)
+					assert.Equal(t, uint(11), deliveries[0].TaskEventId)
+					assert.Equal(t, "0b9a1c9e-5d4f-4f0e-9a51-63d1f6b0c2aa", *deliveries[0].EventId)
+					assert.Equal(t, entities.WebhookDeliveryPending, deliveries[0].Status)
+					assert.Equal(t, now, deliveries[0].NextAttemptAt)
+
+					var payload entities.WebhookPayload
+					assert.NoError(t, json.Unmarshal(deliveries[0].Payload, &payload))
+					assert.Equal(t, entities.TaskEventAssigned, payload.Action)
+					assert.Equal(t, uint(7), payload.TaskId)
+				}
+				return nil
+			},
+		)
+
+		assert.NoError(t, usecase.HandleDomainEvent(context.Background(), newEvent(entities.TaskEventAssigned)))
+	})
+
+	t.Run("NoSubscriber", func(t *testing.T) {
+		mockRepo.EXPECT().List(ctx).Return([]entities.Webhook{
+			{Id: 1, Events: entities.WebhookEvents{entities.WebhookEventTaskDeleted}},
+		}, nil)
+
+		assert.NoError(t, usecase.HandleDomainEvent(context.Background(), newEvent(entities.TaskEventCreated)))
+	})
+
+	t.Run("FailureIsRetried", func(t *testing.T) {
+		mockRepo.EXPECT().List(ctx).Return([]entities.Webhook{
+			{Id: 1, Events: entities.WebhookEvents{entities.WebhookEventTaskDeleted}},
+		}, nil)
+		mockRepo.EXPECT().CreateDeliveries(ctx, gomock.Any()).Return(domainerrors.Unavailable("the query was canceled or timed out"))
+
+		err := usecase.HandleDomainEvent(context.Background(), newEvent(entities.TaskEventDeleted))
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindUnavailable))
+	})
+}
+
+func TestRelayedEventDeliveredOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	usecase := usecases.NewWebhookUsecase(mockRepo, usecases.Options{Now: func() time.Time { return now }})
+	subscribers := sinks.NewSubscribers()
+	subscribers.Subscribe(usecase.HandleDomainEvent)
+	nats := outboxMocks.NewMockSink(ctrl)
+	nats.EXPECT().Name().Return("nats").AnyTimes()
+	outboxRepo := outboxMocks.NewMockOutboxRepository(ctrl)
+	relay := outboxUsecases.NewRelay(outboxRepo, []outboxInterfaces.Sink{subscribers, nats}, outboxUsecases.Options{
+		Now: func() time.Time { return now },
+	})
+
+	message, err := entities.NewOutboxMessage(&entities.TaskEvent{Id: 11, TaskId: 7, WorkspaceId: 2, Action: entities.TaskEventDeleted, Changes: entities.JSON(`{}`)})
+	require.NoError(t, err)
+	message.Id = 1
+	mockRepo.EXPECT().List(gomock.Any()).Return([]entities.Webhook{
+		{Id: 1, Events: entities.WebhookEvents{entities.WebhookEventTaskDeleted}},
+		{Id: 2, Events: entities.WebhookEvents{entities.WebhookEventTaskDeleted}},
+	}, nil)
+	deliveries := map[uint]int{}
+	mockRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, created []*entities.WebhookDelivery) error {
+			for _, delivery := range created {
+				deliveries[delivery.WebhookId]++
+			}
+			return nil
+		},
+	)
+
+	// NATS is down: the webhooks get the event, and the retry only publishes it to NATS.
+	outboxRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 100).Return([]entities.OutboxMessage{*message}, nil)
+	nats.EXPECT().Publish(ctx, gomock.Any()).Return(errors.New("connection refused"))
+	outboxRepo.EXPECT().Reschedule(ctx, uint(1), now.Add(5*time.Second), "nats: connection refused", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uint, _ time.Time, _ string, publishedSinks entities.SinkNames) error {
+			message.PublishedSinks = publishedSinks
+			return nil
+		},
+	)
+	_, err = relay.RelayDue(ctx)
+	require.NoError(t, err)
+
+	outboxRepo.EXPECT().ClaimDue(ctx, now, time.Minute, 100).Return([]entities.OutboxMessage{*message}, nil)
+	nats.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
+	outboxRepo.EXPECT().MarkPublished(ctx, uint(1), now).Return(nil)
+	_, err = relay.RelayDue(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[uint]int{1: 1, 2: 1}, deliveries)
+}
+
+func TestListDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	usecase := usecases.NewWebhookUsecase(mockRepo, usecases.Options{})
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(ctx, uint(3)).Return(&entities.Webhook{Id: 3}, nil)
+		mockRepo.EXPECT().ListDeliveries(ctx, uint(3), &models.ListDeliveriesQuery{Limit: models.DefaultListLimit}).
+			Return([]entities.WebhookDelivery{{Id: 5}}, "", nil)
+
+		deliveries, _, err := usecase.ListDeliveries(ctx, 3, &models.ListDeliveriesQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, deliveries, 1)
+	})
+
+	t.Run("WebhookNotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetByID(ctx, uint(9)).Return(nil, domainerrors.NotFound("webhook not found"))
+
+		_, _, err := usecase.ListDeliveries(ctx, 9, &models.ListDeliveriesQuery{})
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+	})
+}
+
+func TestRedeliver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	usecase := usecases.NewWebhookUsecase(mockRepo, usecases.Options{Now: func() time.Time { return now }})
+	admin := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleAdmin}
+
+	t.Run("Success", func(t *testing.T) {
+		eventID := "0b9a1c9e-5d4f-4f0e-9a51-63d1f6b0c2aa"
+		mockRepo.EXPECT().GetDelivery(ctx, uint(3), uint(11)).Return(&entities.WebhookDelivery{
+			Id:          11,
+			WebhookId:   3,
+			Event:       entities.WebhookEventTaskDeleted,
+			TaskEventId: 40,
+			EventId:     &eventID,
+			Payload:     entities.JSON(`{"event":"task.deleted"}`),
+			Status:      entities.WebhookDeliveryFailed,
+			Attempts:    8,
+			LastError:   "the webhook answered 500 Internal Server Error",
+		}, nil)
+		// The redelivery has no event, so it is not skipped as a duplicate of the original.
+		mockRepo.EXPECT().CreateDeliveries(ctx, []*entities.WebhookDelivery{{
+			WebhookId:     3,
+			Event:         entities.WebhookEventTaskDeleted,
+			TaskEventId:   40,
+			Payload:       entities.JSON(`{"event":"task.deleted"}`),
+			Status:        entities.WebhookDeliveryPending,
+			NextAttemptAt: now,
+		}}).Return(nil)
+
+		delivery, err := usecase.Redeliver(ctx, admin, 3, 11)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 0, delivery.Attempts)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo.EXPECT().GetDelivery(ctx, uint(3), uint(99)).Return(nil, domainerrors.NotFound("webhook delivery not found"))
+
+		_, err := usecase.Redeliver(ctx, admin, 3, 99)
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+	})
+}
@@ -0,0 +1,157 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+const (
+	// HeaderSignature is the HMAC-SHA256 of the body keyed by the secret of the webhook, as "sha256=<hex>".
+	HeaderSignature = "X-Webhook-Signature"
+	HeaderEvent     = "X-Webhook-Event"
+	// HeaderDelivery is the ID of the delivery, which stays the same across its attempts.
+	HeaderDelivery = "X-Webhook-Delivery"
+	// maxErrorLength bounds the error recorded on a failed attempt.
+	maxErrorLength = 500
+)
+
+func (u *usecase) RunDispatcher(ctx context.Context) {
+	ticker := time.NewTicker(u.options.PollInterval)
+	defer ticker.Stop()
+	for {
+		// Keep sending while full batches are due, so a backlog drains without waiting for the ticker.
+		for {
+			sent, err := u.DeliverDue(ctx)
+			if err != nil {
+				log.Printf("webhooks: cannot deliver the due deliveries: %v", err)
+			}
+			if err != nil || sent < u.options.BatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (u *usecase) DeliverDue(ctx context.Context) (int, error) {
+	// A claim lasts until every attempt of the batch timed out, with a margin.
+	lease := 2*u.options.Client.Timeout + time.Minute
+	deliveries, err := u.webhookRepo.ClaimDueDeliveries(ctx, u.options.Now(), lease, u.options.BatchSize)
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+	webhooks, err := u.webhookRepo.GetByIDsAnyWorkspace(ctx, lo.Uniq(lo.Map(deliveries, func(delivery entities.WebhookDelivery, _ int) uint {
+		return delivery.WebhookId
+	})))
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func(delivery *entities.WebhookDelivery) {
+			defer wg.Done()
+			webhook, ok := webhooks[delivery.WebhookId]
+			if !ok {
+				// The webhook was deleted with its deliveries since the claim.
+				return
+			}
+			u.attempt(ctx, &webhook, delivery)
+			if err := u.webhookRepo.FinishDelivery(ctx, delivery); err != nil {
+				log.Printf("webhooks: cannot save the attempt of delivery %d: %v", delivery.Id, err)
+			}
+		}(&deliveries[i])
+	}
+	wg.Wait()
+	return len(deliveries), nil
+}
+
+// attempt sends a claimed delivery and records the outcome in it: success, a retry after a
+// backoff, or a failure once it ran out of attempts.
+func (u *usecase) attempt(ctx context.Context, webhook *entities.Webhook, delivery *entities.WebhookDelivery) {
+	status, err := u.send(ctx, webhook, delivery)
+	now := u.options.Now()
+	delivery.ResponseStatus = status
+	if err == nil {
+		delivery.Status = entities.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+		return
+	}
+	delivery.LastError = truncate(err.Error(), maxErrorLength)
+	if delivery.Attempts >= u.options.MaxAttempts {
+		delivery.Status = entities.WebhookDeliveryFailed
+		return
+	}
+	delivery.NextAttemptAt = now.Add(u.retryDelay(delivery.Attempts))
+}
+
+// send posts the payload of a delivery to the URL of its webhook and returns the status of the
+// response, if any; a status other than 2xx is an error.
+func (u *usecase) send(ctx context.Context, webhook *entities.Webhook, delivery *entities.WebhookDelivery) (*int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "task-services-webhooks")
+	req.Header.Set(HeaderEvent, string(delivery.Event))
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(uint64(delivery.Id), 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, delivery.Payload))
+
+	resp, err := u.options.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// Read a little of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &resp.StatusCode, fmt.Errorf("the webhook answered %s", resp.Status)
+	}
+	return &resp.StatusCode, nil
+}
+
+// retryDelay returns the delay after the attempt-th attempt of a delivery.
+func (u *usecase) retryDelay(attempt int) time.Duration {
+	delay := u.options.RetryDelay
+	for i := 1; i < attempt && delay < u.options.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > u.options.MaxRetryDelay {
+		delay = u.options.MaxRetryDelay
+	}
+	return delay
+}
+
+// Sign returns the value of the X-Webhook-Signature header of body for the secret of a webhook.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
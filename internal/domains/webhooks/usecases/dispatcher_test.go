@@ -0,0 +1,121 @@
+package usecases_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/interfaces"
+)
+
+func TestDeliverDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	usecase := usecases.NewWebhookUsecase(mockRepo, usecases.Options{
+		MaxAttempts:   3,
+		RetryDelay:    time.Minute,
+		MaxRetryDelay: 90 * time.Second,
+		Now:           func() time.Time { return now },
+	})
+	payload := entities.JSON(`{"event":"task.created","task_id":7}`)
+
+	// serve answers every request with status and records the last one.
+	var received *http.Request
+	var body []byte
+	serve := func(status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(status)
+		}))
+	}
+	expect := func(server *httptest.Server, attempts int) *entities.WebhookDelivery {
+		delivery := entities.WebhookDelivery{
+			Id:        21,
+			WebhookId: 3,
+			Event:     entities.WebhookEventTaskCreated,
+			Payload:   payload,
+			Status:    entities.WebhookDeliveryPending,
+			Attempts:  attempts,
+		}
+		mockRepo.EXPECT().ClaimDueDeliveries(ctx, now, gomock.Any(), 20).Return([]entities.WebhookDelivery{delivery}, nil)
+		mockRepo.EXPECT().GetByIDsAnyWorkspace(ctx, []uint{3}).Return(map[uint]entities.Webhook{
+			3: {Id: 3, Url: server.URL, Secret: "0123456789abcdef"},
+		}, nil)
+		finished := new(entities.WebhookDelivery)
+		mockRepo.EXPECT().FinishDelivery(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, delivery *entities.WebhookDelivery) error {
+				*finished = *delivery
+				return nil
+			},
+		)
+		return finished
+	}
+
+	t.Run("SignedSuccess", func(t *testing.T) {
+		server := serve(http.StatusNoContent)
+		defer server.Close()
+		finished := expect(server, 1)
+
+		sent, err := usecase.DeliverDue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		assert.Equal(t, entities.WebhookDeliverySucceeded, finished.Status)
+		assert.Equal(t, http.StatusNoContent, *finished.ResponseStatus)
+		assert.Equal(t, now, *finished.DeliveredAt)
+
+		mac := hmac.New(sha256.New, []byte("0123456789abcdef"))
+		mac.Write(payload)
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), received.Header.Get("X-Webhook-Signature"))
+		assert.Equal(t, "task.created", received.Header.Get("X-Webhook-Event"))
+		assert.Equal(t, "21", received.Header.Get("X-Webhook-Delivery"))
+		assert.JSONEq(t, string(payload), string(body))
+	})
+
+	t.Run("RetryWithBackoff", func(t *testing.T) {
+		server := serve(http.StatusInternalServerError)
+		defer server.Close()
+		finished := expect(server, 2)
+
+		_, err := usecase.DeliverDue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.WebhookDeliveryPending, finished.Status)
+		assert.Equal(t, http.StatusInternalServerError, *finished.ResponseStatus)
+		assert.Contains(t, finished.LastError, "500")
+		// The second delay doubles the first one, capped at 90 seconds.
+		assert.Equal(t, now.Add(90*time.Second), finished.NextAttemptAt)
+	})
+
+	t.Run("FailedAfterMaxAttempts", func(t *testing.T) {
+		server := serve(http.StatusBadGateway)
+		defer server.Close()
+		finished := expect(server, 3)
+
+		_, err := usecase.DeliverDue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.WebhookDeliveryFailed, finished.Status)
+		assert.Nil(t, finished.DeliveredAt)
+	})
+
+	t.Run("NothingDue", func(t *testing.T) {
+		mockRepo.EXPECT().ClaimDueDeliveries(ctx, now, gomock.Any(), 20).Return(nil, nil)
+
+		sent, err := usecase.DeliverDue(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, sent)
+	})
+}
@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type WebhookUsecase interface {
+	// CreateWebhook subscribes a URL to events of the tasks of the workspace of ctx.
+	CreateWebhook(ctx context.Context, actor entities.Actor, webhook *entities.Webhook) error
+	// ListWebhooks returns the webhooks of the workspace of ctx.
+	ListWebhooks(ctx context.Context) ([]entities.Webhook, error)
+	GetWebhook(ctx context.Context, id uint) (*entities.Webhook, error)
+	// UpdateWebhook replaces the URL, the secret and the events of a webhook.
+	UpdateWebhook(ctx context.Context, actor entities.Actor, webhook *entities.Webhook) error
+	// DeleteWebhook deletes a webhook and its deliveries, including the pending ones.
+	DeleteWebhook(ctx context.Context, actor entities.Actor, id uint) error
+	// ListDeliveries returns one page of the deliveries of a webhook, newest first.
+	ListDeliveries(ctx context.Context, webhookID uint, query *models.ListDeliveriesQuery) ([]entities.WebhookDelivery, string, error)
+	// Redeliver queues a new delivery of the payload of a delivery of a webhook.
+	Redeliver(ctx context.Context, actor entities.Actor, webhookID, deliveryID uint) (*entities.WebhookDelivery, error)
+	// HandleDomainEvent queues a delivery of a domain event to the webhooks of its workspace subscribed to it.
+	HandleDomainEvent(ctx context.Context, event entities.DomainEvent) error
+	// DeliverDue sends the due deliveries of every workspace and returns how many it sent.
+	DeliverDue(ctx context.Context) (int, error)
+	// RunDispatcher calls DeliverDue every poll interval until ctx is done.
+	RunDispatcher(ctx context.Context)
+}
+
+// Options tunes the delivery of webhooks.
+type Options struct {
+	// Client sends the requests; its timeout bounds each attempt. The default times out after 10 seconds.
+	Client *http.Client
+	// MaxAttempts is the number of attempts of a delivery before it fails, 8 by default.
+	MaxAttempts int
+	// RetryDelay is the delay before the second attempt, doubled after each further attempt up to
+	// MaxRetryDelay; 30 seconds and 1 hour by default.
+	RetryDelay    time.Duration
+	MaxRetryDelay time.Duration
+	// PollInterval is how often the dispatcher looks for due deliveries, 5 seconds by default.
+	PollInterval time.Duration
+	// BatchSize is the number of deliveries the dispatcher sends at once, 20 by default.
+	BatchSize int
+	// Now is the clock of the dispatcher, time.Now by default.
+	Now func() time.Time
+}
+
+type usecase struct {
+	webhookRepo interfaces.WebhookRepository
+	options     Options
+}
+
+func NewWebhookUsecase(webhookRepo interfaces.WebhookRepository, options Options) WebhookUsecase {
+	if options.Client == nil {
+		options.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = 8
+	}
+	if options.RetryDelay <= 0 {
+		options.RetryDelay = 30 * time.Second
+	}
+	if options.MaxRetryDelay <= 0 {
+		options.MaxRetryDelay = time.Hour
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = 5 * time.Second
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = 20
+	}
+	if options.Now == nil {
+		options.Now = time.Now
+	}
+	return &usecase{webhookRepo, options}
+}
+
+// authorizeRole fails when the workspace role of actor does not include required. Admins and
+// anonymous actors, which only exist when authentication is disabled, are not restricted.
+func authorizeRole(actor entities.Actor, required entities.WorkspaceRole, action string) error {
+	if actor.Admin || actor.UserId == "" || actor.Role.Includes(required) {
+		return nil
+	}
+	return domainerrors.Forbidden(fmt.Sprintf("the %s role is required to %s", required, action)).
+		WithDetail("role", actor.Role).
+		WithDetail("required_role", required)
+}
+
+// checkURL fails unless rawURL is an absolute http or https URL.
+func checkURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return domainerrors.Validation("url must be an absolute http or https URL")
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	return u.webhookRepo.List(ctx)
+}
+
+func (u *usecase) GetWebhook(ctx context.Context, id uint) (*entities.Webhook, error) {
+	return u.webhookRepo.GetByID(ctx, id)
+}
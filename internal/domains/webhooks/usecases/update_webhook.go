@@ -0,0 +1,25 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) UpdateWebhook(ctx context.Context, actor entities.Actor, webhook *entities.Webhook) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleAdmin, "manage webhooks"); err != nil {
+		return err
+	}
+	if err := checkURL(webhook.Url); err != nil {
+		return err
+	}
+	if err := u.webhookRepo.Update(ctx, webhook); err != nil {
+		return err
+	}
+	updated, err := u.webhookRepo.GetByID(ctx, webhook.Id)
+	if err != nil {
+		return err
+	}
+	*webhook = *updated
+	return nil
+}
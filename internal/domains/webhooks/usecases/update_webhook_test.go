@@ -0,0 +1,40 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/webhooks/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/webhooks/interfaces"
+)
+
+func TestUpdateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockWebhookRepository(ctrl)
+	usecase := usecases.NewWebhookUsecase(mockRepo, usecases.Options{})
+	admin := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleAdmin}
+
+	t.Run("Success", func(t *testing.T) {
+		webhook := &entities.Webhook{Id: 3, Url: "https://ci.example.com/v2", Secret: "fedcba9876543210"}
+		mockRepo.EXPECT().Update(ctx, webhook).Return(nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(3)).
+			Return(&entities.Webhook{Id: 3, Url: "https://ci.example.com/v2", CreatedBy: "carol"}, nil)
+
+		assert.NoError(t, usecase.UpdateWebhook(ctx, admin, webhook))
+		assert.Equal(t, "carol", webhook.CreatedBy)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		webhook := &entities.Webhook{Id: 9, Url: "https://ci.example.com/v2"}
+		mockRepo.EXPECT().Update(ctx, webhook).Return(domainerrors.NotFound("webhook not found"))
+
+		assert.True(t, domainerrors.IsKind(usecase.UpdateWebhook(ctx, admin, webhook), domainerrors.KindNotFound))
+	})
+}
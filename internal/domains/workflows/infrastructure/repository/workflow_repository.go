@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workflows/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// sqlStateUniqueViolation is the SQLSTATE of a unique_violation.
+	sqlStateUniqueViolation = "23505"
+	// workflowNameIndex keeps the names of the workflows of a workspace unique, ignoring case.
+	workflowNameIndex = "idx_workflows_workspace_name"
+	// workflowActiveIndex keeps at most one workflow of a workspace active.
+	workflowActiveIndex = "idx_workflows_active"
+)
+
+var (
+	errWorkflowNotFound  = domainerrors.NotFound("workflow not found")
+	errWorkflowNameTaken = domainerrors.Conflict("a workflow with this name already exists")
+	errWorkflowActive    = domainerrors.Conflict("another workflow was activated at the same time")
+	errNoWorkspace       = domainerrors.Internal(errors.New("repository: the context has no workspace"))
+)
+
+// Options tunes the workflow repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type repository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewWorkflowRepository(db *gorm.DB, options Options) interfaces.WorkflowRepository {
+	return &repository{db, options}
+}
+
+func (r *repository) Create(ctx context.Context, workflow *entities.Workflow) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	workflow.WorkspaceId, _ = helpers.WorkspaceIDFromContext(ctx)
+	return wrapError(db.Create(workflow).Error)
+}
+
+func (r *repository) GetByID(ctx context.Context, id uint) (*entities.Workflow, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var workflow entities.Workflow
+	if err := db.Take(&workflow, id).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return &workflow, nil
+}
+
+func (r *repository) GetActive(ctx context.Context) (*entities.Workflow, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	var workflow entities.Workflow
+	err := db.Where("active").Take(&workflow).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &workflow, nil
+}
+
+func (r *repository) List(ctx context.Context) ([]entities.Workflow, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	workflows := []entities.Workflow{}
+	if err := db.Order("name, id").Find(&workflows).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return workflows, nil
+}
+
+func (r *repository) Update(ctx context.Context, workflow *entities.Workflow) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	workflow.UpdatedAt = time.Now()
+	result := db.Model(&entities.Workflow{}).
+		Where("id = ?", workflow.Id).
+		Updates(map[string]any{
+			"name":        workflow.Name,
+			"active":      workflow.Active,
+			"statuses":    workflow.Statuses,
+			"transitions": workflow.Transitions,
+			"updated_at":  workflow.UpdatedAt,
+		})
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errWorkflowNotFound
+	}
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	result := db.Delete(&entities.Workflow{}, id)
+	if result.Error != nil {
+		return wrapError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errWorkflowNotFound
+	}
+	return nil
+}
+
+func (r *repository) Deactivate(ctx context.Context, exceptID uint) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	return wrapError(db.Model(&entities.Workflow{}).
+		Where("active AND id <> ?", exceptID).
+		Updates(map[string]any{"active": false, "updated_at": time.Now()}).Error)
+}
+
+func (r *repository) ListStatusesInUse(ctx context.Context) ([]entities.TaskStatus, error) {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	statuses := []entities.TaskStatus{}
+	if err := db.Unscoped().Model(&entities.Task{}).Distinct("status").Order("status").Pluck("status", &statuses).Error; err != nil {
+		return nil, wrapError(err)
+	}
+	return statuses, nil
+}
+
+func (r *repository) UpdateTaskCategories(ctx context.Context, statuses entities.WorkflowStatuses) error {
+	db, cancel := r.withContext(ctx)
+	defer cancel()
+	now := time.Now()
+	for _, category := range entities.StatusCategories {
+		names := lo.FilterMap(statuses, func(status entities.WorkflowStatus, _ int) (entities.TaskStatus, bool) {
+			return status.Name, status.Category == category
+		})
+		if len(names) == 0 {
+			continue
+		}
+		values := map[string]any{"status_category": category, "completed_at": nil}
+		if category == entities.StatusCategoryDone {
+			values["completed_at"] = now
+		}
+		// Only the tasks changing category are touched, so done tasks keep their completion time.
+		err := db.Unscoped().Model(&entities.Task{}).
+			Where("status IN ? AND status_category <> ?", names, category).
+			UpdateColumns(values).Error
+		if err != nil {
+			return wrapError(err)
+		}
+	}
+	return nil
+}
+
+func (r *repository) Transaction(ctx context.Context, fn func(repo interfaces.WorkflowRepository) error) error {
+	return wrapError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&repository{tx, r.options})
+	}))
+}
+
+// withContext binds the queries to ctx and to the workspace of ctx, bounded by the query timeout.
+func (r *repository) withContext(ctx context.Context) (*gorm.DB, context.CancelFunc) {
+	cancel := context.CancelFunc(func() {})
+	if r.options.QueryTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+	}
+	db := r.db.WithContext(ctx).Session(&gorm.Session{})
+	workspaceID, ok := helpers.WorkspaceIDFromContext(ctx)
+	if !ok {
+		_ = db.AddError(errNoWorkspace)
+		return db, cancel
+	}
+	return db.Where("workspace_id = ?", workspaceID).Session(&gorm.Session{}), cancel
+}
+
+func wrapError(err error) error {
+	var domainErr *domainerrors.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &domainErr):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errWorkflowNotFound
+	case isUniqueViolation(err, workflowNameIndex):
+		return errWorkflowNameTaken
+	case isUniqueViolation(err, workflowActiveIndex):
+		return errWorkflowActive
+	default:
+		return domainerrors.Internal(err)
+	}
+}
+
+// isUniqueViolation reports whether a row duplicated the unique key of constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == sqlStateUniqueViolation && pgErr.ConstraintName == constraint
+}
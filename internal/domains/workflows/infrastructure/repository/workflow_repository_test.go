@@ -0,0 +1,160 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workflows/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/domains/workflows/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newRepository(t *testing.T) (interfaces.WorkflowRepository, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Discard,
+	})
+	require.NoError(t, err)
+	return repository.NewWorkflowRepository(db, repository.Options{}), mock
+}
+
+func TestWorkflowRepository(t *testing.T) {
+	ctx := helpers.ContextWithWorkspaceID(context.Background(), 2)
+
+	t.Run("CreateStoresStatusesAsJSON", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workflows" ("workspace_id","name","active","statuses","transitions","created_at","updated_at")`)).
+			WithArgs(2, "review", true,
+				`[{"name":"TO_DO","category":"todo","read_only":false}]`,
+				`[]`,
+				sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+
+		workflow := &entities.Workflow{
+			Name:        "review",
+			Active:      true,
+			Statuses:    entities.WorkflowStatuses{{Name: entities.TaskStatusToDo, Category: entities.StatusCategoryTodo}},
+			Transitions: entities.WorkflowTransitions{},
+		}
+		if assert.NoError(t, repo.Create(ctx, workflow)) {
+			assert.Equal(t, uint(2), workflow.WorkspaceId)
+			assert.Equal(t, uint(5), workflow.Id)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CreateDuplicateName", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "workflows"`)).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_workflows_workspace_name"})
+
+		err := repo.Create(ctx, &entities.Workflow{Name: "Review"})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindConflict))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetActiveScansStatuses", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "workflows" WHERE workspace_id = $1 AND active LIMIT 1`)).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "active", "statuses", "transitions"}).
+				AddRow(5, "review", true, []byte(`[{"name":"BACKLOG","category":"todo","read_only":false}]`), []byte(`[]`)))
+
+		workflow, err := repo.GetActive(ctx)
+
+		if assert.NoError(t, err) && assert.NotNil(t, workflow) {
+			assert.Equal(t, entities.WorkflowStatuses{{Name: "BACKLOG", Category: entities.StatusCategoryTodo}}, workflow.Statuses)
+			assert.Empty(t, workflow.Transitions)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("GetActiveNone", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "workflows" WHERE workspace_id = $1 AND active LIMIT 1`)).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		workflow, err := repo.GetActive(ctx)
+
+		assert.NoError(t, err)
+		assert.Nil(t, workflow)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DeactivateOthers", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "workflows" SET "active"=$1,"updated_at"=$2 WHERE workspace_id = $3 AND (active AND id <> $4)`)).
+			WithArgs(false, sqlmock.AnyArg(), 2, 5).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Deactivate(ctx, 5))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateOfAnotherWorkspace", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "workflows" SET "active"=$1,"name"=$2,"statuses"=$3,"transitions"=$4,"updated_at"=$5 WHERE workspace_id = $6 AND id = $7`)).
+			WithArgs(false, "review", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 2, 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Update(ctx, &entities.Workflow{Id: 9, Name: "review"})
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ListStatusesInUseIncludesDeletedTasks", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT "status" FROM "tasks" WHERE workspace_id = $1 ORDER BY status`)).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("DONE").AddRow("TO_DO"))
+
+		statuses, err := repo.ListStatusesInUse(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entities.TaskStatus{entities.TaskStatusDone, entities.TaskStatusToDo}, statuses)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdateTaskCategories", func(t *testing.T) {
+		repo, mock := newRepository(t)
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tasks" SET "completed_at"=$1,"status_category"=$2 WHERE workspace_id = $3 AND (status IN ($4,$5) AND status_category <> $6)`)).
+			WithArgs(nil, entities.StatusCategoryTodo, 2, "BACKLOG", "TO_DO", entities.StatusCategoryTodo).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tasks" SET "completed_at"=$1,"status_category"=$2 WHERE workspace_id = $3 AND (status IN ($4) AND status_category <> $5)`)).
+			WithArgs(sqlmock.AnyArg(), entities.StatusCategoryDone, 2, "SHIPPED", entities.StatusCategoryDone).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.UpdateTaskCategories(ctx, entities.WorkflowStatuses{
+			{Name: "BACKLOG", Category: entities.StatusCategoryTodo},
+			{Name: entities.TaskStatusToDo, Category: entities.StatusCategoryTodo},
+			{Name: "SHIPPED", Category: entities.StatusCategoryDone},
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoWorkspace", func(t *testing.T) {
+		repo, _ := newRepository(t)
+
+		_, err := repo.List(context.Background())
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+	})
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/workflows/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// CreateWorkflow creates a workflow
+// @Summary Create a workflow
+// @Description Create a workflow of the workspace; an active workflow replaces the active one and every task must be in one of its statuses. Only admins of the workspace can
+// @Tags workflows
+// @Accept json
+// @Produce json
+// @Param workflow body models.WorkflowRequest true "Workflow"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 201 {object} models.ResponseSuccess{data=entities.Workflow} "Workflow created"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 409 {object} models.ProblemDetails "A workflow with this name exists, or tasks are in statuses the workflow does not have"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/workflows [post]
+func (h *Handler) CreateWorkflow(c echo.Context) error {
+	req := new(models.WorkflowRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	workflow := req.Workflow()
+	if err := h.WorkflowUsecase.CreateWorkflow(c.Request().Context(), interfaces.ActorFrom(c), workflow); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, helpers.NewResponseSuccess("Workflow created", workflow))
+}
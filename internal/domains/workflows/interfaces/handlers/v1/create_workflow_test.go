@@ -0,0 +1,110 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/workflows/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/usecases"
+)
+
+func TestCreateWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWorkflowUsecase(ctrl)
+	handler := &handlers.Handler{WorkflowUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/workflows", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/workflows")
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expected := &entities.Workflow{
+			Name:   "Code review",
+			Active: true,
+			Statuses: entities.WorkflowStatuses{
+				{Name: entities.TaskStatusToDo, Category: entities.StatusCategoryTodo},
+				{Name: "IN_REVIEW", Category: entities.StatusCategoryActive},
+				{Name: entities.TaskStatusDone, Category: entities.StatusCategoryDone, ReadOnly: true},
+			},
+			Transitions: entities.WorkflowTransitions{
+				{From: entities.TaskStatusToDo, To: "IN_REVIEW"},
+				{From: "IN_REVIEW", To: entities.TaskStatusDone, Role: entities.WorkspaceRoleAdmin},
+			},
+		}
+		mockUsecase.EXPECT().CreateWorkflow(gomock.Any(), anonymous, expected).DoAndReturn(
+			func(_ context.Context, _ entities.Actor, workflow *entities.Workflow) error {
+				workflow.Id = 5
+				return nil
+			},
+		)
+
+		c, rec := newContext(`{
+			"name": "Code review",
+			"active": true,
+			"statuses": [
+				{"name": "TO_DO", "category": "todo"},
+				{"name": "IN_REVIEW", "category": "active"},
+				{"name": "DONE", "category": "done", "read_only": true}
+			],
+			"transitions": [
+				{"from": "TO_DO", "to": "IN_REVIEW"},
+				{"from": "IN_REVIEW", "to": "DONE", "role": "admin"}
+			]
+		}`)
+		if assert.NoError(t, handler.CreateWorkflow(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+
+			var response struct {
+				Data entities.Workflow `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, uint(5), response.Data.Id)
+			assert.Len(t, response.Data.Statuses, 3)
+		}
+	})
+
+	t.Run("BadRequest", func(t *testing.T) {
+		for _, body := range []string{
+			`{"name": "review", "statuses": []}`,
+			`{"name": "review", "statuses": [{"name": "in review", "category": "todo"}]}`,
+			`{"name": "review", "statuses": [{"name": "TO_DO", "category": "waiting"}]}`,
+			`{"name": "review", "statuses": [{"name": "TO_DO", "category": "todo"}], "transitions": [{"from": "TO_DO", "to": "DONE", "role": "viewer"}]}`,
+		} {
+			c, rec := newContext(body)
+			if assert.Error(t, invoke(handler.CreateWorkflow, c), body) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+			}
+		}
+	})
+
+	t.Run("StatusesInUse", func(t *testing.T) {
+		mockUsecase.EXPECT().CreateWorkflow(gomock.Any(), anonymous, gomock.Any()).
+			Return(domainerrors.Conflict("tasks are in statuses the workflow does not have").
+				WithDetail("missing_statuses", []entities.TaskStatus{entities.TaskStatusInProgress}))
+
+		c, rec := newContext(`{"name": "review", "active": true, "statuses": [{"name": "TO_DO", "category": "todo"}]}`)
+		if assert.Error(t, invoke(handler.CreateWorkflow, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"missing_statuses":["IN_PROGRESS"]`)
+		}
+	})
+}
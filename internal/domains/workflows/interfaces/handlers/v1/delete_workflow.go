@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// DeleteWorkflow deletes a workflow
+// @Summary Delete a workflow
+// @Description Delete a workflow; the default workflow applies when it was active, which requires every task to be in a default status. Only admins of the workspace can
+// @Tags workflows
+// @Produce json
+// @Param id path int true "Workflow ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 204 "Workflow deleted"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Workflow not found"
+// @Failure 409 {object} models.ProblemDetails "Tasks are in statuses the default workflow does not have"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/workflows/{id} [delete]
+func (h *Handler) DeleteWorkflow(c echo.Context) error {
+	id, err := parseWorkflowID(c)
+	if err != nil {
+		return err
+	}
+	if err := h.WorkflowUsecase.DeleteWorkflow(c.Request().Context(), interfaces.ActorFrom(c), id); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
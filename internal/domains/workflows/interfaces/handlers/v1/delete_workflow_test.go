@@ -0,0 +1,51 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/workflows/interfaces/handlers/v1"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/usecases"
+)
+
+func TestDeleteWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWorkflowUsecase(ctrl)
+	handler := &handlers.Handler{WorkflowUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodDelete, "/v1/workflows/"+id, nil), rec)
+		c.SetPath("/v1/workflows/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteWorkflow(gomock.Any(), anonymous, uint(6)).Return(nil)
+
+		c, rec := newContext("6")
+		if assert.NoError(t, handler.DeleteWorkflow(c)) {
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+		}
+	})
+
+	t.Run("StatusesInUse", func(t *testing.T) {
+		mockUsecase.EXPECT().DeleteWorkflow(gomock.Any(), anonymous, uint(5)).
+			Return(domainerrors.Conflict("tasks are in statuses the workflow does not have"))
+
+		c, rec := newContext("5")
+		if assert.Error(t, invoke(handler.DeleteWorkflow, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// GetWorkflow gets a workflow
+// @Summary Get a workflow
+// @Description Get a workflow of the workspace
+// @Tags workflows
+// @Produce json
+// @Param id path int true "Workflow ID"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=entities.Workflow} "Workflow found"
+// @Failure 400 {object} models.ProblemDetails "Invalid ID format"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Workflow not found"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/workflows/{id} [get]
+func (h *Handler) GetWorkflow(c echo.Context) error {
+	id, err := parseWorkflowID(c)
+	if err != nil {
+		return err
+	}
+	workflow, err := h.WorkflowUsecase.GetWorkflow(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Workflow found", workflow))
+}
+
+// GetActiveWorkflow gets the workflow of the tasks
+// @Summary Get the active workflow
+// @Description Get the workflow the tasks of the workspace follow: the active workflow, or the default workflow (id 0) when none is active
+// @Tags workflows
+// @Produce json
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=entities.Workflow} "Workflow found"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/workflows/active [get]
+func (h *Handler) GetActiveWorkflow(c echo.Context) error {
+	workflow, err := h.WorkflowUsecase.GetActiveWorkflow(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Workflow found", workflow))
+}
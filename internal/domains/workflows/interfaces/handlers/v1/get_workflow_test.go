@@ -0,0 +1,84 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/workflows/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/usecases"
+)
+
+func TestGetWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWorkflowUsecase(ctrl)
+	handler := &handlers.Handler{WorkflowUsecase: mockUsecase}
+	e := echo.New()
+
+	newContext := func(id string) (echo.Context, *httptest.ResponseRecorder) {
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/workflows/"+id, nil), rec)
+		c.SetPath("/v1/workflows/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().GetWorkflow(gomock.Any(), uint(5)).Return(&entities.Workflow{Id: 5, Name: "Code review"}, nil)
+
+		c, rec := newContext("5")
+		if assert.NoError(t, handler.GetWorkflow(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockUsecase.EXPECT().GetWorkflow(gomock.Any(), uint(9)).Return(nil, domainerrors.NotFound("workflow not found"))
+
+		c, rec := newContext("9")
+		if assert.Error(t, invoke(handler.GetWorkflow, c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		c, rec := newContext("abc")
+		if assert.Error(t, invoke(handler.GetWorkflow, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
+
+func TestGetActiveWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWorkflowUsecase(ctrl)
+	handler := &handlers.Handler{WorkflowUsecase: mockUsecase}
+	e := echo.New()
+
+	mockUsecase.EXPECT().GetActiveWorkflow(gomock.Any()).Return(entities.DefaultWorkflow(), nil)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/workflows/active", nil), rec)
+
+	if assert.NoError(t, handler.GetActiveWorkflow(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Data entities.Workflow `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "default", response.Data.Name)
+		assert.Equal(t, entities.DefaultWorkflow().Transitions, response.Data.Transitions)
+	}
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workflows/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+type Handler struct {
+	WorkflowUsecase usecases.WorkflowUsecase
+}
+
+func NewWorkflowHandler(e *echo.Echo, workflowUsecase usecases.WorkflowUsecase) {
+	handler := &Handler{
+		WorkflowUsecase: workflowUsecase,
+	}
+	// Workflows govern the tasks of the workspace, so they take the task scopes of API keys;
+	// changing them is reserved to admins.
+	read := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleViewer),
+		interfaces.RequireScope(entities.ScopeTasksRead),
+	}
+	write := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleAdmin),
+		interfaces.RequireScope(entities.ScopeTasksWrite),
+	}
+	remove := []echo.MiddlewareFunc{
+		interfaces.RequireRole(entities.WorkspaceRoleAdmin),
+		interfaces.RequireScope(entities.ScopeTasksDelete),
+	}
+
+	e.POST("/v1/workflows", handler.CreateWorkflow, write...)
+	e.GET("/v1/workflows", handler.ListWorkflows, read...)
+	e.GET("/v1/workflows/active", handler.GetActiveWorkflow, read...)
+	e.GET("/v1/workflows/:id", handler.GetWorkflow, read...)
+	e.PUT("/v1/workflows/:id", handler.UpdateWorkflow, write...)
+	e.DELETE("/v1/workflows/:id", handler.DeleteWorkflow, remove...)
+}
+
+// parseWorkflowID reads the workflow ID path parameter.
+func parseWorkflowID(c echo.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		return 0, domainerrors.Validation("Invalid ID format")
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,44 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	handlers "github.com/supachai1998/task_services/internal/domains/workflows/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/usecases"
+)
+
+func TestListWorkflows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWorkflowUsecase(ctrl)
+	handler := &handlers.Handler{WorkflowUsecase: mockUsecase}
+	e := echo.New()
+
+	mockUsecase.EXPECT().ListWorkflows(gomock.Any()).Return([]entities.Workflow{
+		{Id: 5, Name: "Code review", Active: true},
+		{Id: 6, Name: "Support"},
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/v1/workflows", nil), rec)
+
+	if assert.NoError(t, handler.ListWorkflows(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Data []entities.Workflow `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		if assert.Len(t, response.Data, 2) {
+			assert.True(t, response.Data[0].Active)
+		}
+	}
+}
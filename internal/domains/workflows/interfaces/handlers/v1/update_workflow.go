@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domains/workflows/models"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+// UpdateWorkflow replaces a workflow
+// @Summary Update a workflow
+// @Description Replace a workflow; the tasks follow the change when it is or becomes active, and the default workflow applies when it stops being active. Only admins of the workspace can
+// @Tags workflows
+// @Accept json
+// @Produce json
+// @Param id path int true "Workflow ID"
+// @Param workflow body models.WorkflowRequest true "Workflow"
+// @Param X-Workspace-ID header int false "Workspace, by default the first workspace of the token"
+// @Success 200 {object} models.ResponseSuccess{data=entities.Workflow} "Workflow updated"
+// @Failure 400 {object} models.ProblemDetails "Invalid input"
+// @Failure 401 {object} models.ProblemDetails "Missing or invalid bearer token or API key"
+// @Failure 403 {object} models.ProblemDetails "Workspace role or API key scope not allowed"
+// @Failure 404 {object} models.ProblemDetails "Workflow not found"
+// @Failure 409 {object} models.ProblemDetails "A workflow with this name exists, or tasks are in statuses the workflow does not have"
+// @Failure 500 {object} models.ProblemDetails "Internal server error"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Router /v1/workflows/{id} [put]
+func (h *Handler) UpdateWorkflow(c echo.Context) error {
+	id, err := parseWorkflowID(c)
+	if err != nil {
+		return err
+	}
+	req := new(models.WorkflowRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	workflow := req.Workflow()
+	workflow.Id = id
+	if err := h.WorkflowUsecase.UpdateWorkflow(c.Request().Context(), interfaces.ActorFrom(c), workflow); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, helpers.NewResponseSuccess("Workflow updated", workflow))
+}
@@ -0,0 +1,73 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	handlers "github.com/supachai1998/task_services/internal/domains/workflows/interfaces/handlers/v1"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/usecases"
+)
+
+func TestUpdateWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mocks.NewMockWorkflowUsecase(ctrl)
+	handler := &handlers.Handler{WorkflowUsecase: mockUsecase}
+	e := echo.New()
+	e.Validator = interfaces.NewCustomValidator()
+
+	newContext := func(id, body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPut, "/v1/workflows/"+id, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/v1/workflows/:id")
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		return c, rec
+	}
+	body := `{"name": "Support", "statuses": [{"name": "NEW", "category": "todo"}, {"name": "SOLVED", "category": "done"}], "transitions": [{"from": "NEW", "to": "SOLVED"}]}`
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateWorkflow(gomock.Any(), anonymous, &entities.Workflow{
+			Id:   6,
+			Name: "Support",
+			Statuses: entities.WorkflowStatuses{
+				{Name: "NEW", Category: entities.StatusCategoryTodo},
+				{Name: "SOLVED", Category: entities.StatusCategoryDone},
+			},
+			Transitions: entities.WorkflowTransitions{{From: "NEW", To: "SOLVED"}},
+		}).Return(nil)
+
+		c, rec := newContext("6", body)
+		if assert.NoError(t, handler.UpdateWorkflow(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+	})
+
+	t.Run("NameTaken", func(t *testing.T) {
+		mockUsecase.EXPECT().UpdateWorkflow(gomock.Any(), anonymous, gomock.Any()).
+			Return(domainerrors.Conflict("a workflow with this name already exists"))
+
+		c, rec := newContext("6", body)
+		if assert.Error(t, invoke(handler.UpdateWorkflow, c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		c, rec := newContext("abc", body)
+		if assert.Error(t, invoke(handler.UpdateWorkflow, c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
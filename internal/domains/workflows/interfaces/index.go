@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// WorkflowRepository stores the workflows of the workspace of ctx.
+type WorkflowRepository interface {
+	Create(ctx context.Context, workflow *entities.Workflow) error
+	GetByID(ctx context.Context, id uint) (*entities.Workflow, error)
+	// GetActive returns the active workflow, or nil when no workflow is active.
+	GetActive(ctx context.Context) (*entities.Workflow, error)
+	// List returns every workflow, by name.
+	List(ctx context.Context) ([]entities.Workflow, error)
+	// Update saves the name, the statuses, the transitions and whether a workflow is active.
+	Update(ctx context.Context, workflow *entities.Workflow) error
+	Delete(ctx context.Context, id uint) error
+	// Deactivate makes every workflow but the workflow exceptID inactive.
+	Deactivate(ctx context.Context, exceptID uint) error
+	// ListStatusesInUse returns the distinct statuses of the tasks, soft-deleted or not.
+	ListStatusesInUse(ctx context.Context) ([]entities.TaskStatus, error)
+	// UpdateTaskCategories gives every task, soft-deleted or not, the category of its status in statuses,
+	// setting completed_at on the tasks that become done and clearing it on the others.
+	UpdateTaskCategories(ctx context.Context, statuses entities.WorkflowStatuses) error
+	// Transaction runs fn with a repository bound to a single database transaction.
+	Transaction(ctx context.Context, fn func(repo WorkflowRepository) error) error
+}
@@ -0,0 +1,52 @@
+package models
+
+import "github.com/supachai1998/task_services/internal/entities"
+
+// WorkflowRequest is the body of the requests creating or replacing a workflow.
+type WorkflowRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100" example:"Code review"`
+	// Active makes the workflow the one of the tasks of the workspace, in place of the active workflow.
+	Active bool `json:"active" example:"true"`
+	// Statuses are the statuses of the tasks; new tasks take the first one, which must be a todo status.
+	Statuses    []WorkflowStatusRequest     `json:"statuses" validate:"required,min=1,max=50,dive"`
+	Transitions []WorkflowTransitionRequest `json:"transitions" validate:"max=500,dive"`
+}
+
+type WorkflowStatusRequest struct {
+	Name     string `json:"name" validate:"required,task_status" example:"IN_REVIEW"`
+	Category string `json:"category" validate:"required,oneof=todo active done" example:"active"`
+	// ReadOnly forbids changing the details of the tasks in this status.
+	ReadOnly bool `json:"read_only" example:"false"`
+}
+
+type WorkflowTransitionRequest struct {
+	From string `json:"from" validate:"required,task_status" example:"IN_PROGRESS"`
+	To   string `json:"to" validate:"required,task_status" example:"IN_REVIEW"`
+	// Role is the workspace role required to make the transition; members can make it when omitted.
+	Role string `json:"role" validate:"omitempty,oneof=member admin" example:"admin"`
+}
+
+// Workflow returns the workflow of the request.
+func (r WorkflowRequest) Workflow() *entities.Workflow {
+	workflow := &entities.Workflow{
+		Name:        r.Name,
+		Active:      r.Active,
+		Statuses:    make(entities.WorkflowStatuses, 0, len(r.Statuses)),
+		Transitions: make(entities.WorkflowTransitions, 0, len(r.Transitions)),
+	}
+	for _, status := range r.Statuses {
+		workflow.Statuses = append(workflow.Statuses, entities.WorkflowStatus{
+			Name:     entities.TaskStatus(status.Name),
+			Category: entities.StatusCategory(status.Category),
+			ReadOnly: status.ReadOnly,
+		})
+	}
+	for _, transition := range r.Transitions {
+		workflow.Transitions = append(workflow.Transitions, entities.WorkflowTransition{
+			From: entities.TaskStatus(transition.From),
+			To:   entities.TaskStatus(transition.To),
+			Role: entities.WorkspaceRole(transition.Role),
+		})
+	}
+	return workflow
+}
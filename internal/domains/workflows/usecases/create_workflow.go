@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/workflows/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// CreateWorkflow is reserved to admins, since an active workflow changes every task.
+func (u *usecase) CreateWorkflow(ctx context.Context, actor entities.Actor, workflow *entities.Workflow) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleAdmin, "create workflows"); err != nil {
+		return err
+	}
+	if err := validate(workflow); err != nil {
+		return err
+	}
+	return u.workflowRepo.Transaction(ctx, func(repo interfaces.WorkflowRepository) error {
+		if workflow.Active {
+			if err := activate(ctx, repo, workflow); err != nil {
+				return err
+			}
+		}
+		return repo.Create(ctx, workflow)
+	})
+}
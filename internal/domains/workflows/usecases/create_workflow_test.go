@@ -0,0 +1,128 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workflows/interfaces"
+	"github.com/supachai1998/task_services/internal/domains/workflows/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/interfaces"
+)
+
+// reviewWorkflow returns a valid workflow adding an IN_REVIEW status to the default statuses.
+func reviewWorkflow() *entities.Workflow {
+	return &entities.Workflow{
+		Name:   " Code review ",
+		Active: true,
+		Statuses: entities.WorkflowStatuses{
+			{Name: entities.TaskStatusToDo, Category: entities.StatusCategoryTodo},
+			{Name: entities.TaskStatusInProgress, Category: entities.StatusCategoryActive},
+			{Name: "IN_REVIEW", Category: entities.StatusCategoryActive},
+			{Name: entities.TaskStatusDone, Category: entities.StatusCategoryDone, ReadOnly: true},
+		},
+		Transitions: entities.WorkflowTransitions{
+			{From: entities.TaskStatusToDo, To: entities.TaskStatusInProgress},
+			{From: entities.TaskStatusInProgress, To: "IN_REVIEW"},
+			{From: "IN_REVIEW", To: entities.TaskStatusDone, Role: entities.WorkspaceRoleAdmin},
+		},
+	}
+}
+
+// inTransaction runs the transaction callbacks against the same mock repository.
+func inTransaction(ctx context.Context, mockRepo *mocks.MockWorkflowRepository) {
+	mockRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repo interfaces.WorkflowRepository) error) error {
+		return fn(mockRepo)
+	})
+}
+
+func TestCreateWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockWorkflowRepository(ctrl)
+	usecase := usecases.NewWorkflowUsecase(mockRepo)
+	admin := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleAdmin}
+
+	t.Run("SuccessActive", func(t *testing.T) {
+		workflow := reviewWorkflow()
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().ListStatusesInUse(ctx).Return([]entities.TaskStatus{entities.TaskStatusDone, entities.TaskStatusToDo}, nil)
+		mockRepo.EXPECT().Deactivate(ctx, uint(0)).Return(nil)
+		mockRepo.EXPECT().UpdateTaskCategories(ctx, workflow.Statuses).Return(nil)
+		mockRepo.EXPECT().Create(ctx, workflow).Return(nil)
+
+		if assert.NoError(t, usecase.CreateWorkflow(ctx, admin, workflow)) {
+			assert.Equal(t, "Code review", workflow.Name)
+		}
+	})
+
+	t.Run("SuccessInactive", func(t *testing.T) {
+		workflow := reviewWorkflow()
+		workflow.Active = false
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().Create(ctx, workflow).Return(nil)
+
+		assert.NoError(t, usecase.CreateWorkflow(ctx, admin, workflow))
+	})
+
+	t.Run("StatusesInUseMissing", func(t *testing.T) {
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().ListStatusesInUse(ctx).Return([]entities.TaskStatus{"BLOCKED", entities.TaskStatusToDo}, nil)
+
+		err := usecase.CreateWorkflow(ctx, admin, reviewWorkflow())
+
+		var domainErr *domainerrors.Error
+		if assert.True(t, errors.As(err, &domainErr)) {
+			assert.Equal(t, domainerrors.KindConflict, domainErr.Kind)
+			assert.Equal(t, []entities.TaskStatus{"BLOCKED"}, domainErr.Details["missing_statuses"])
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		member := entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleMember}
+
+		err := usecase.CreateWorkflow(ctx, member, reviewWorkflow())
+
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindForbidden))
+	})
+
+	invalid := map[string]func(workflow *entities.Workflow){
+		"BlankName": func(workflow *entities.Workflow) { workflow.Name = "  " },
+		"NoStatus":  func(workflow *entities.Workflow) { workflow.Statuses = nil },
+		"DuplicateStatus": func(workflow *entities.Workflow) {
+			workflow.Statuses = append(workflow.Statuses, entities.WorkflowStatus{Name: "IN_REVIEW", Category: entities.StatusCategoryDone})
+		},
+		"MalformedStatus": func(workflow *entities.Workflow) { workflow.Statuses[2].Name = "in review" },
+		"UnknownCategory": func(workflow *entities.Workflow) { workflow.Statuses[2].Category = "waiting" },
+		"InitialNotTodo":  func(workflow *entities.Workflow) { workflow.Statuses[0].Category = entities.StatusCategoryActive },
+		"TransitionToUnknownStatus": func(workflow *entities.Workflow) {
+			workflow.Transitions[0].To = "BLOCKED"
+		},
+		"TransitionToSameStatus": func(workflow *entities.Workflow) {
+			workflow.Transitions[0].To = entities.TaskStatusToDo
+		},
+		"DuplicateTransition": func(workflow *entities.Workflow) {
+			workflow.Transitions = append(workflow.Transitions, workflow.Transitions[0])
+		},
+		"TransitionRoleViewer": func(workflow *entities.Workflow) {
+			workflow.Transitions[0].Role = entities.WorkspaceRoleViewer
+		},
+	}
+	for name, change := range invalid {
+		change := change
+		t.Run("Invalid_"+name, func(t *testing.T) {
+			workflow := reviewWorkflow()
+			change(workflow)
+
+			err := usecase.CreateWorkflow(ctx, admin, workflow)
+
+			assert.True(t, domainerrors.IsKind(err, domainerrors.KindValidation))
+		})
+	}
+}
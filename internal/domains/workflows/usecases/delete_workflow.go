@@ -0,0 +1,28 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/workflows/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) DeleteWorkflow(ctx context.Context, actor entities.Actor, id uint) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleAdmin, "delete workflows"); err != nil {
+		return err
+	}
+	return u.workflowRepo.Transaction(ctx, func(repo interfaces.WorkflowRepository) error {
+		current, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		if !current.Active {
+			return nil
+		}
+		// The tasks fall back to the default workflow.
+		return activate(ctx, repo, entities.DefaultWorkflow())
+	})
+}
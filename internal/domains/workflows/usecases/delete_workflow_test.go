@@ -0,0 +1,57 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workflows/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/interfaces"
+)
+
+func TestDeleteWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockWorkflowRepository(ctrl)
+	usecase := usecases.NewWorkflowUsecase(mockRepo)
+	admin := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleAdmin}
+
+	t.Run("ActiveFallsBackToDefault", func(t *testing.T) {
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().GetByID(ctx, uint(5)).Return(&entities.Workflow{Id: 5, Active: true}, nil)
+		mockRepo.EXPECT().Delete(ctx, uint(5)).Return(nil)
+		mockRepo.EXPECT().ListStatusesInUse(ctx).Return([]entities.TaskStatus{entities.TaskStatusInProgress}, nil)
+		mockRepo.EXPECT().Deactivate(ctx, uint(0)).Return(nil)
+		mockRepo.EXPECT().UpdateTaskCategories(ctx, entities.DefaultWorkflow().Statuses).Return(nil)
+
+		assert.NoError(t, usecase.DeleteWorkflow(ctx, admin, 5))
+	})
+
+	t.Run("ActiveWithCustomStatusesInUse", func(t *testing.T) {
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().GetByID(ctx, uint(5)).Return(&entities.Workflow{Id: 5, Active: true}, nil)
+		mockRepo.EXPECT().Delete(ctx, uint(5)).Return(nil)
+		mockRepo.EXPECT().ListStatusesInUse(ctx).Return([]entities.TaskStatus{"IN_REVIEW"}, nil)
+
+		assert.True(t, domainerrors.IsKind(usecase.DeleteWorkflow(ctx, admin, 5), domainerrors.KindConflict))
+	})
+
+	t.Run("Inactive", func(t *testing.T) {
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().GetByID(ctx, uint(6)).Return(&entities.Workflow{Id: 6}, nil)
+		mockRepo.EXPECT().Delete(ctx, uint(6)).Return(nil)
+
+		assert.NoError(t, usecase.DeleteWorkflow(ctx, admin, 6))
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		member := entities.Actor{Name: "bob", UserId: "bob", Role: entities.WorkspaceRoleMember}
+
+		assert.True(t, domainerrors.IsKind(usecase.DeleteWorkflow(ctx, member, 6), domainerrors.KindForbidden))
+	})
+}
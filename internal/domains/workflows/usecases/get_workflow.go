@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) GetWorkflow(ctx context.Context, id uint) (*entities.Workflow, error) {
+	return u.workflowRepo.GetByID(ctx, id)
+}
+
+func (u *usecase) GetActiveWorkflow(ctx context.Context) (*entities.Workflow, error) {
+	workflow, err := u.workflowRepo.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		return entities.DefaultWorkflow(), nil
+	}
+	return workflow, nil
+}
@@ -0,0 +1,40 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/workflows/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/interfaces"
+)
+
+func TestGetActiveWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockWorkflowRepository(ctrl)
+	usecase := usecases.NewWorkflowUsecase(mockRepo)
+
+	t.Run("Stored", func(t *testing.T) {
+		stored := reviewWorkflow()
+		mockRepo.EXPECT().GetActive(ctx).Return(stored, nil)
+
+		workflow, err := usecase.GetActiveWorkflow(ctx)
+
+		assert.NoError(t, err)
+		assert.Same(t, stored, workflow)
+	})
+
+	t.Run("DefaultWhenNoneActive", func(t *testing.T) {
+		mockRepo.EXPECT().GetActive(ctx).Return(nil, nil)
+
+		workflow, err := usecase.GetActiveWorkflow(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.DefaultWorkflow(), workflow)
+	})
+}
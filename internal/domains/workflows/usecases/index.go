@@ -0,0 +1,112 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workflows/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type WorkflowUsecase interface {
+	// CreateWorkflow creates a workflow in the workspace of ctx, activating it when it is active.
+	CreateWorkflow(ctx context.Context, actor entities.Actor, workflow *entities.Workflow) error
+	// ListWorkflows returns the workflows of the workspace of ctx, by name.
+	ListWorkflows(ctx context.Context) ([]entities.Workflow, error)
+	GetWorkflow(ctx context.Context, id uint) (*entities.Workflow, error)
+	// GetActiveWorkflow returns the active workflow of the workspace of ctx, or the default workflow when none is.
+	GetActiveWorkflow(ctx context.Context) (*entities.Workflow, error)
+	// UpdateWorkflow replaces a workflow; the tasks follow the change when it is or becomes the active one.
+	UpdateWorkflow(ctx context.Context, actor entities.Actor, workflow *entities.Workflow) error
+	// DeleteWorkflow deletes a workflow; the default workflow applies when it was the active one.
+	DeleteWorkflow(ctx context.Context, actor entities.Actor, id uint) error
+}
+
+type usecase struct {
+	workflowRepo interfaces.WorkflowRepository
+}
+
+func NewWorkflowUsecase(workflowRepo interfaces.WorkflowRepository) WorkflowUsecase {
+	return &usecase{workflowRepo}
+}
+
+// authorizeRole fails when the workspace role of actor does not include required. Admins and
+// anonymous actors, which only exist when authentication is disabled, are not restricted.
+func authorizeRole(actor entities.Actor, required entities.WorkspaceRole, action string) error {
+	if actor.Admin || actor.UserId == "" || actor.Role.Includes(required) {
+		return nil
+	}
+	return domainerrors.Forbidden(fmt.Sprintf("the %s role is required to %s", required, action)).
+		WithDetail("role", actor.Role).
+		WithDetail("required_role", required)
+}
+
+// validate trims the name of a workflow and checks that its statuses and transitions are consistent.
+func validate(workflow *entities.Workflow) error {
+	workflow.Name = strings.TrimSpace(workflow.Name)
+	if workflow.Name == "" {
+		return domainerrors.Validation("name must not be blank")
+	}
+	if len(workflow.Statuses) == 0 {
+		return domainerrors.Validation("a workflow needs at least one status")
+	}
+	names := map[entities.TaskStatus]bool{}
+	for _, status := range workflow.Statuses {
+		if !status.Name.Valid() {
+			return domainerrors.Validation(fmt.Sprintf("invalid status name %q", status.Name))
+		}
+		if !lo.Contains(entities.StatusCategories, status.Category) {
+			return domainerrors.Validation(fmt.Sprintf("invalid category %q of status %s", status.Category, status.Name))
+		}
+		if names[status.Name] {
+			return domainerrors.Validation(fmt.Sprintf("duplicate status %s", status.Name))
+		}
+		names[status.Name] = true
+	}
+	if initial := workflow.InitialStatus(); initial.Category != entities.StatusCategoryTodo {
+		return domainerrors.Validation(fmt.Sprintf("the first status %s, which new tasks take, must be a todo status", initial.Name))
+	}
+	type move struct{ from, to entities.TaskStatus }
+	moves := map[move]bool{}
+	for _, transition := range workflow.Transitions {
+		if !names[transition.From] || !names[transition.To] {
+			return domainerrors.Validation(fmt.Sprintf("the transition from %s to %s uses an unknown status", transition.From, transition.To))
+		}
+		if transition.From == transition.To {
+			return domainerrors.Validation(fmt.Sprintf("the transition from %s to %s does not change the status", transition.From, transition.To))
+		}
+		if transition.Role != "" && transition.Role != entities.WorkspaceRoleMember && transition.Role != entities.WorkspaceRoleAdmin {
+			return domainerrors.Validation(fmt.Sprintf("invalid role %q of the transition from %s to %s", transition.Role, transition.From, transition.To))
+		}
+		if moves[move{transition.From, transition.To}] {
+			return domainerrors.Validation(fmt.Sprintf("duplicate transition from %s to %s", transition.From, transition.To))
+		}
+		moves[move{transition.From, transition.To}] = true
+	}
+	return nil
+}
+
+// activate makes workflow the workflow of the tasks of the workspace in the transaction of repo:
+// every status the tasks are in must be one of its statuses, and the tasks take the category of
+// their status. The other workflows become inactive.
+func activate(ctx context.Context, repo interfaces.WorkflowRepository, workflow *entities.Workflow) error {
+	inUse, err := repo.ListStatusesInUse(ctx)
+	if err != nil {
+		return err
+	}
+	missing := lo.Filter(inUse, func(name entities.TaskStatus, _ int) bool {
+		_, ok := workflow.Status(name)
+		return !ok
+	})
+	if len(missing) > 0 {
+		return domainerrors.Conflict("tasks are in statuses the workflow does not have").
+			WithDetail("missing_statuses", missing)
+	}
+	if err := repo.Deactivate(ctx, workflow.Id); err != nil {
+		return err
+	}
+	return repo.UpdateTaskCategories(ctx, workflow.Statuses)
+}
@@ -0,0 +1,11 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) ListWorkflows(ctx context.Context) ([]entities.Workflow, error) {
+	return u.workflowRepo.List(ctx)
+}
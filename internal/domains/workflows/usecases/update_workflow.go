@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/domains/workflows/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+func (u *usecase) UpdateWorkflow(ctx context.Context, actor entities.Actor, workflow *entities.Workflow) error {
+	if err := authorizeRole(actor, entities.WorkspaceRoleAdmin, "change workflows"); err != nil {
+		return err
+	}
+	if err := validate(workflow); err != nil {
+		return err
+	}
+	return u.workflowRepo.Transaction(ctx, func(repo interfaces.WorkflowRepository) error {
+		current, err := repo.GetByID(ctx, workflow.Id)
+		if err != nil {
+			return err
+		}
+		switch {
+		case workflow.Active:
+			err = activate(ctx, repo, workflow)
+		case current.Active:
+			// The tasks fall back to the default workflow.
+			err = activate(ctx, repo, entities.DefaultWorkflow())
+		}
+		if err != nil {
+			return err
+		}
+		if err := repo.Update(ctx, workflow); err != nil {
+			return err
+		}
+		updated, err := repo.GetByID(ctx, workflow.Id)
+		if err != nil {
+			return err
+		}
+		*workflow = *updated
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domains/workflows/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workflows/interfaces"
+)
+
+func TestUpdateWorkflow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mocks.NewMockWorkflowRepository(ctrl)
+	usecase := usecases.NewWorkflowUsecase(mockRepo)
+	admin := entities.Actor{Name: "alice", UserId: "alice", Role: entities.WorkspaceRoleAdmin}
+
+	t.Run("ActivateKeepsItself", func(t *testing.T) {
+		workflow := reviewWorkflow()
+		workflow.Id = 5
+		stored := *workflow
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().GetByID(ctx, uint(5)).Return(&entities.Workflow{Id: 5}, nil)
+		mockRepo.EXPECT().ListStatusesInUse(ctx).Return([]entities.TaskStatus{"IN_REVIEW"}, nil)
+		mockRepo.EXPECT().Deactivate(ctx, uint(5)).Return(nil)
+		mockRepo.EXPECT().UpdateTaskCategories(ctx, workflow.Statuses).Return(nil)
+		mockRepo.EXPECT().Update(ctx, workflow).Return(nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(5)).Return(&stored, nil)
+
+		assert.NoError(t, usecase.UpdateWorkflow(ctx, admin, workflow))
+	})
+
+	t.Run("DeactivateFallsBackToDefault", func(t *testing.T) {
+		workflow := reviewWorkflow()
+		workflow.Id = 5
+		workflow.Active = false
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().GetByID(ctx, uint(5)).Return(&entities.Workflow{Id: 5, Active: true}, nil)
+		mockRepo.EXPECT().ListStatusesInUse(ctx).Return([]entities.TaskStatus{entities.TaskStatusToDo}, nil)
+		mockRepo.EXPECT().Deactivate(ctx, uint(0)).Return(nil)
+		mockRepo.EXPECT().UpdateTaskCategories(ctx, entities.DefaultWorkflow().Statuses).Return(nil)
+		mockRepo.EXPECT().Update(ctx, workflow).Return(nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(5)).Return(workflow, nil)
+
+		assert.NoError(t, usecase.UpdateWorkflow(ctx, admin, workflow))
+	})
+
+	t.Run("InactiveLeavesTasks", func(t *testing.T) {
+		workflow := reviewWorkflow()
+		workflow.Id = 6
+		workflow.Active = false
+		inTransaction(ctx, mockRepo)
+		mockRepo.EXPECT().GetByID(ctx, uint(6)).Return(&entities.Workflow{Id: 6}, nil)
+		mockRepo.EXPECT().Update(ctx, workflow).Return(nil)
+		mockRepo.EXPECT().GetByID(ctx, uint(6)).Return(workflow, nil)
+
+		assert.NoError(t, usecase.UpdateWorkflow(ctx, admin, workflow))
+	})
+}
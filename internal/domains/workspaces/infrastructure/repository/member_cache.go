@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workspaces/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type memberKey struct {
+	workspaceID uint
+	userID      string
+}
+
+type cachedRole struct {
+	role entities.WorkspaceRole
+	// err is the not found error of a user without a row, cached like a role.
+	err       error
+	expiresAt time.Time
+}
+
+// memberCache keeps the roles read from a member repository in memory.
+type memberCache struct {
+	repo  interfaces.MemberRepository
+	ttl   time.Duration
+	mu    sync.Mutex
+	roles map[memberKey]cachedRole
+}
+
+// NewMemberCache caches the roles and the missing rows read from repo for ttl, so a role change
+// takes up to ttl to apply. Other errors are not cached.
+func NewMemberCache(repo interfaces.MemberRepository, ttl time.Duration) interfaces.MemberRepository {
+	return &memberCache{repo: repo, ttl: ttl, roles: map[memberKey]cachedRole{}}
+}
+
+func (c *memberCache) GetRole(ctx context.Context, workspaceID uint, userID string) (entities.WorkspaceRole, error) {
+	key := memberKey{workspaceID, userID}
+	now := time.Now()
+
+	c.mu.Lock()
+	cached, ok := c.roles[key]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.role, cached.err
+	}
+
+	role, err := c.repo.GetRole(ctx, workspaceID, userID)
+	if err != nil && !domainerrors.IsKind(err, domainerrors.KindNotFound) {
+		return "", err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Drop the expired entries so the users that stopped calling do not stay in memory.
+	for k, v := range c.roles {
+		if !now.Before(v.expiresAt) {
+			delete(c.roles, k)
+		}
+	}
+	c.roles[key] = cachedRole{role: role, err: err, expiresAt: now.Add(c.ttl)}
+	return role, err
+}
@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workspaces/infrastructure/repository"
+	"github.com/supachai1998/task_services/internal/entities"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workspaces/interfaces"
+)
+
+func TestMemberCache(t *testing.T) {
+	ctx := context.Background()
+
+	newCache := func(t *testing.T, ttl time.Duration) (*mocks.MockMemberRepository, func(workspaceID uint, userID string) (entities.WorkspaceRole, error)) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		repo := mocks.NewMockMemberRepository(ctrl)
+		cache := repository.NewMemberCache(repo, ttl)
+		return repo, func(workspaceID uint, userID string) (entities.WorkspaceRole, error) {
+			return cache.GetRole(ctx, workspaceID, userID)
+		}
+	}
+
+	t.Run("CachesRoles", func(t *testing.T) {
+		repo, getRole := newCache(t, time.Minute)
+		repo.EXPECT().GetRole(ctx, uint(1), "alice").Times(1).Return(entities.WorkspaceRoleAdmin, nil)
+		repo.EXPECT().GetRole(ctx, uint(2), "alice").Times(1).Return(entities.WorkspaceRoleViewer, nil)
+
+		for i := 0; i < 3; i++ {
+			role, err := getRole(1, "alice")
+			assert.NoError(t, err)
+			assert.Equal(t, entities.WorkspaceRoleAdmin, role)
+		}
+		role, err := getRole(2, "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, entities.WorkspaceRoleViewer, role)
+	})
+
+	t.Run("CachesMissingMembers", func(t *testing.T) {
+		repo, getRole := newCache(t, time.Minute)
+		repo.EXPECT().GetRole(ctx, uint(1), "bob").Times(1).Return(entities.WorkspaceRole(""), domainerrors.NotFound("workspace member not found"))
+
+		for i := 0; i < 2; i++ {
+			_, err := getRole(1, "bob")
+			assert.True(t, domainerrors.IsKind(err, domainerrors.KindNotFound))
+		}
+	})
+
+	t.Run("DoesNotCacheErrors", func(t *testing.T) {
+		repo, getRole := newCache(t, time.Minute)
+		gomock.InOrder(
+			repo.EXPECT().GetRole(ctx, uint(1), "alice").Return(entities.WorkspaceRole(""), domainerrors.Internal(errors.New("connection refused"))),
+			repo.EXPECT().GetRole(ctx, uint(1), "alice").Return(entities.WorkspaceRoleMember, nil),
+		)
+
+		_, err := getRole(1, "alice")
+		assert.True(t, domainerrors.IsKind(err, domainerrors.KindInternal))
+		role, err := getRole(1, "alice")
+		assert.NoError(t, err)
+		assert.Equal(t, entities.WorkspaceRoleMember, role)
+	})
+
+	t.Run("Expires", func(t *testing.T) {
+		repo, getRole := newCache(t, 10*time.Millisecond)
+		gomock.InOrder(
+			repo.EXPECT().GetRole(ctx, uint(1), "alice").Return(entities.WorkspaceRoleMember, nil),
+			repo.EXPECT().GetRole(ctx, uint(1), "alice").Return(entities.WorkspaceRoleViewer, nil),
+		)
+
+		role, _ := getRole(1, "alice")
+		assert.Equal(t, entities.WorkspaceRoleMember, role)
+		time.Sleep(20 * time.Millisecond)
+		role, _ = getRole(1, "alice")
+		assert.Equal(t, entities.WorkspaceRoleViewer, role)
+	})
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/domains/workspaces/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+
+	"gorm.io/gorm"
+)
+
+var errMemberNotFound = domainerrors.NotFound("workspace member not found")
+
+// Options tunes the member repository.
+type Options struct {
+	// QueryTimeout bounds every query; zero means no timeout.
+	QueryTimeout time.Duration
+}
+
+type memberRepository struct {
+	db      *gorm.DB
+	options Options
+}
+
+func NewMemberRepository(db *gorm.DB, options Options) interfaces.MemberRepository {
+	return &memberRepository{db, options}
+}
+
+func (r *memberRepository) GetRole(ctx context.Context, workspaceID uint, userID string) (entities.WorkspaceRole, error) {
+	if r.options.QueryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.options.QueryTimeout)
+		defer cancel()
+	}
+	var member entities.WorkspaceMember
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Take(&member).Error
+	switch {
+	case err == nil:
+		return member.Role, nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return "", errMemberNotFound
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return "", domainerrors.Unavailable("the query was canceled or timed out").WithCause(err)
+	default:
+		return "", domainerrors.Internal(err)
+	}
+}
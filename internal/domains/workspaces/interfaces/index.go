@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+type MemberRepository interface {
+	// GetRole returns the role of a user in a workspace, or a not found error when the user has no row.
+	GetRole(ctx context.Context, workspaceID uint, userID string) (entities.WorkspaceRole, error)
+}
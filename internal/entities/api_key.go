@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ApiKeyScope is a permission granted to an API key.
+type ApiKeyScope string
+
+const (
+	ScopeTasksRead   ApiKeyScope = "tasks:read"
+	ScopeTasksWrite  ApiKeyScope = "tasks:write"
+	ScopeTasksDelete ApiKeyScope = "tasks:delete"
+)
+
+// ApiKeyScopes is a list of scopes stored space-separated, like an OAuth scope.
+type ApiKeyScopes []ApiKeyScope
+
+// Contains reports whether scope is one of the scopes.
+func (s ApiKeyScopes) Contains(scope ApiKeyScope) bool {
+	for _, granted := range s {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (s ApiKeyScopes) Value() (driver.Value, error) {
+	scopes := make([]string, len(s))
+	for i, scope := range s {
+		scopes[i] = string(scope)
+	}
+	return strings.Join(scopes, " "), nil
+}
+
+func (s *ApiKeyScopes) Scan(value interface{}) error {
+	var scopes string
+	switch v := value.(type) {
+	case []byte:
+		scopes = string(v)
+	case string:
+		scopes = v
+	default:
+		return fmt.Errorf("cannot scan %T into entities.ApiKeyScopes", value)
+	}
+	*s = ApiKeyScopes{}
+	for _, scope := range strings.Fields(scopes) {
+		*s = append(*s, ApiKeyScope(scope))
+	}
+	return nil
+}
+
+// ApiKey authenticates a machine client on behalf of the user who created it, in one workspace
+// and limited to its scopes. Only the SHA-256 hash of the key is stored.
+type ApiKey struct {
+	Id          uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint   `gorm:"not null;index" json:"workspace_id"`
+	Name        string `gorm:"not null;type:varchar(100)" json:"name"`
+	// Prefix is the start of the key, shown to recognize it.
+	Prefix    string       `gorm:"not null;type:varchar(16)" json:"prefix"`
+	KeyHash   string       `gorm:"not null;uniqueIndex;type:char(64)" json:"-"`
+	Scopes    ApiKeyScopes `gorm:"not null;type:varchar(255)" json:"scopes" swaggertype:"array,string"`
+	CreatedBy string       `gorm:"not null;type:varchar(255);index" json:"created_by"`
+	CreatedAt time.Time    `gorm:"not null" json:"created_at"`
+	// ExpiresAt is when the key stops working; keys without it never expire.
+	ExpiresAt *time.Time `json:"expires_at"`
+	// LastUsedAt is when the key last authenticated a request, to the minute.
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+func (ApiKey) TableName() string {
+	return TableNameApiKey
+}
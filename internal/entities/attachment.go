@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// Attachment is the metadata of a file attached to a task; the content is in the blob store.
+type Attachment struct {
+	Id          uint `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint `gorm:"not null;index" json:"workspace_id"`
+	TaskId      uint `gorm:"not null;index" json:"task_id"`
+	// FileName is the name of the uploaded file, without its directories.
+	FileName string `gorm:"not null;type:varchar(255)" json:"file_name"`
+	// ContentType is detected from the content, not taken from the client.
+	ContentType string `gorm:"not null;type:varchar(255)" json:"content_type"`
+	Size        int64  `gorm:"not null" json:"size"`
+	// Sha256 is the hex SHA-256 of the content, which names its blob: files with the same content share it.
+	Sha256     string    `gorm:"not null;type:char(64)" json:"sha256"`
+	UploadedBy string    `gorm:"not null;type:varchar(255)" json:"uploaded_by"`
+	CreatedAt  time.Time `gorm:"not null" json:"created_at"`
+}
+
+func (Attachment) TableName() string {
+	return TableNameAttachment
+}
@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Comment is a message of the discussion of a task.
+type Comment struct {
+	Id          uint `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint `gorm:"not null;index" json:"workspace_id"`
+	TaskId      uint `gorm:"not null;index" json:"task_id"`
+	// Author is the actor who wrote the comment; only they and admins can edit or delete it.
+	Author string `gorm:"not null;type:varchar(255)" json:"author"`
+	// Body is the comment in Markdown, stored as written; clients render it.
+	Body      string    `gorm:"not null;type:text" json:"body"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+	// EditedAt is set when the body is edited.
+	EditedAt *time.Time `json:"edited_at"`
+	// DeletedAt is only set on soft-deleted comments, which are no longer listed.
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+func (Comment) TableName() string {
+	return TableNameComment
+}
@@ -1,5 +1,19 @@
 package entities
 
 var (
-	TableNameTask = "tasks"
+	TableNameTask            = "tasks"
+	TableNameTaskEvent       = "task_events"
+	TableNameWorkspace       = "workspaces"
+	TableNameWorkspaceMember = "workspace_members"
+	TableNameApiKey          = "api_keys"
+	TableNameTaskDependency  = "task_dependencies"
+	TableNameLabel           = "labels"
+	TableNameTaskLabel       = "task_labels"
+	TableNameComment         = "comments"
+	TableNameAttachment      = "attachments"
+	TableNameWorkflow        = "workflows"
+	TableNameWebhook         = "webhooks"
+	TableNameWebhookDelivery = "webhook_deliveries"
+	TableNameOutbox          = "outbox"
+	TableNameRecurrence      = "recurrences"
 )
@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+// Label is a free-form tag of the tasks of a workspace; its name is unique in the workspace, ignoring case.
+type Label struct {
+	Id          uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint   `gorm:"not null;index" json:"workspace_id"`
+	Name        string `gorm:"not null;type:varchar(50)" json:"name"`
+	// Color is a hex color, such as #d73a4a.
+	Color string `gorm:"not null;type:varchar(7)" json:"color"`
+	// CreatedBy is the user who created the label.
+	CreatedBy *string   `gorm:"type:varchar(255)" json:"created_by"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
+
+func (Label) TableName() string {
+	return TableNameLabel
+}
+
+// TaskLabel puts a label on a task.
+type TaskLabel struct {
+	WorkspaceId uint      `gorm:"not null" json:"workspace_id"`
+	TaskId      uint      `gorm:"primaryKey;autoIncrement:false" json:"task_id"`
+	LabelId     uint      `gorm:"primaryKey;autoIncrement:false" json:"label_id"`
+	CreatedAt   time.Time `gorm:"not null" json:"created_at"`
+}
+
+func (TaskLabel) TableName() string {
+	return TableNameTaskLabel
+}
@@ -0,0 +1,144 @@
+package entities
+
+import (
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DomainEventType is the kind of a domain event, which integrations subscribe to.
+type DomainEventType string
+
+const (
+	// DomainEventTaskCreated is emitted for created and restored tasks.
+	DomainEventTaskCreated DomainEventType = "TaskCreated"
+	// DomainEventTaskUpdated is emitted for every other change of a task, such as an assignment or a new label.
+	DomainEventTaskUpdated       DomainEventType = "TaskUpdated"
+	DomainEventTaskStatusChanged DomainEventType = "TaskStatusChanged"
+	DomainEventTaskDeleted       DomainEventType = "TaskDeleted"
+)
+
+// DomainEventTypeOf returns the domain event emitted for an action of the history of a task.
+func DomainEventTypeOf(action TaskEventAction) DomainEventType {
+	switch action {
+	case TaskEventCreated, TaskEventRestored:
+		return DomainEventTaskCreated
+	case TaskEventStatusChanged:
+		return DomainEventTaskStatusChanged
+	case TaskEventDeleted:
+		return DomainEventTaskDeleted
+	default:
+		return DomainEventTaskUpdated
+	}
+}
+
+// DomainEvent is a change of a task published to the integrations. Events are delivered at
+// least once, so consumers drop the ones whose Id they have already seen.
+type DomainEvent struct {
+	// Id is a UUID that stays the same across the redeliveries of the event.
+	Id          string          `json:"id" example:"0b9a1c9e-5d4f-4f0e-9a51-63d1f6b0c2aa"`
+	Type        DomainEventType `json:"type" example:"TaskStatusChanged"`
+	WorkspaceId uint            `json:"workspace_id" example:"1"`
+	TaskId      uint            `json:"task_id" example:"7"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	// TaskEvent is the entry of the history of the task, with the action and the changed fields.
+	TaskEvent TaskEvent `json:"task_event"`
+}
+
+// OutboxMessage is a domain event written in the transaction of the change it describes, and
+// kept until the relay has published it to every sink.
+type OutboxMessage struct {
+	Id          uint            `gorm:"primaryKey;autoIncrement"`
+	EventId     string          `gorm:"not null;type:uuid"`
+	WorkspaceId uint            `gorm:"not null"`
+	Type        DomainEventType `gorm:"not null;type:varchar(32)"`
+	// Payload is the DomainEvent, as JSON.
+	Payload  JSON `gorm:"not null;type:jsonb"`
+	Attempts int  `gorm:"not null;default:0"`
+	// NextAttemptAt is when the relay publishes an unpublished message next.
+	NextAttemptAt time.Time `gorm:"not null"`
+	// LastError tells why the last attempt failed.
+	LastError string `gorm:"not null;type:text;default:''"`
+	// PublishedSinks are the names of the sinks that accepted the event, which the relay skips.
+	PublishedSinks SinkNames `gorm:"not null;type:varchar(255);default:''"`
+	CreatedAt      time.Time `gorm:"not null"`
+	PublishedAt    *time.Time
+}
+
+// SinkNames is a list of names of sinks stored space-separated, like WebhookEvents.
+type SinkNames []string
+
+// Contains reports whether name is one of the names.
+func (n SinkNames) Contains(name string) bool {
+	for _, published := range n {
+		if published == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (n SinkNames) Value() (driver.Value, error) {
+	return strings.Join(n, " "), nil
+}
+
+func (n *SinkNames) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		*n = strings.Fields(string(v))
+	case string:
+		*n = strings.Fields(v)
+	default:
+		return fmt.Errorf("cannot scan %T into entities.SinkNames", value)
+	}
+	return nil
+}
+
+func (OutboxMessage) TableName() string {
+	return TableNameOutbox
+}
+
+// NewOutboxMessage returns the message publishing the domain event of a recorded entry of the
+// history of a task.
+func NewOutboxMessage(event *TaskEvent) (*OutboxMessage, error) {
+	id, err := newUUID()
+	if err != nil {
+		return nil, err
+	}
+	domainEvent := DomainEvent{
+		Id:          id,
+		Type:        DomainEventTypeOf(event.Action),
+		WorkspaceId: event.WorkspaceId,
+		TaskId:      event.TaskId,
+		OccurredAt:  event.CreatedAt,
+		TaskEvent:   *event,
+	}
+	payload, err := json.Marshal(domainEvent)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxMessage{
+		EventId:       id,
+		WorkspaceId:   event.WorkspaceId,
+		Type:          domainEvent.Type,
+		Payload:       payload,
+		NextAttemptAt: event.CreatedAt,
+		CreatedAt:     event.CreatedAt,
+	}, nil
+}
+
+// newUUID returns a random (version 4) UUID.
+func newUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	s := hex.EncodeToString(b)
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:], nil
+}
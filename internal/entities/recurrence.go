@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+)
+
+// ErrOccurrenceExists is the error of creating a task for an occurrence of a recurrence that
+// already has one.
+var ErrOccurrenceExists = domainerrors.Conflict("the occurrence of the recurrence already has a task")
+
+// Recurrence creates a task from its template at every occurrence of its schedule, a cron
+// expression or an RRULE evaluated in its time zone.
+type Recurrence struct {
+	Id          uint `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint `gorm:"not null;index" json:"workspace_id"`
+	// Title, Description, Priority, EstimateMinutes and AssigneeId are copied to the tasks.
+	Title           string       `gorm:"not null;type:varchar(100)" json:"title"`
+	Description     string       `gorm:"not null;type:text" json:"description"`
+	Priority        TaskPriority `gorm:"not null;default:MEDIUM" swagger:"enum(LOW,MEDIUM,HIGH,URGENT)" json:"priority"`
+	EstimateMinutes *int         `json:"estimate_minutes"`
+	AssigneeId      *string      `gorm:"type:varchar(255)" json:"assignee_id"`
+	// DueAfterMinutes makes the tasks due that long after their occurrence; they have no due date when it is null.
+	DueAfterMinutes *int `json:"due_after_minutes"`
+	// Schedule is a 5-field cron expression or an RRULE.
+	Schedule string `gorm:"not null;type:varchar(255)" json:"schedule" example:"FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=0"`
+	// Timezone is the IANA time zone of the wall clock times of the schedule.
+	Timezone string `gorm:"not null;type:varchar(64)" json:"timezone" example:"Asia/Bangkok"`
+	// StartsAt is the first possible occurrence, and the DTSTART of an RRULE.
+	StartsAt time.Time `gorm:"not null" json:"starts_at"`
+	// EndsAt, when set, is the last possible occurrence.
+	EndsAt *time.Time `json:"ends_at"`
+	// Paused recurrences create no task; the occurrences missed while paused are skipped.
+	Paused bool `gorm:"not null;default:false" json:"paused"`
+	// NextOccurrenceAt is when the next task is created, null once the schedule is over.
+	NextOccurrenceAt *time.Time `json:"next_occurrence_at"`
+	// LastOccurrenceAt is the occurrence of the last task created.
+	LastOccurrenceAt *time.Time `json:"last_occurrence_at"`
+	// LockedUntil is the lease of the scheduler materializing the next occurrence.
+	LockedUntil *time.Time `json:"-"`
+	// CreatedBy is the user who created the recurrence, the owner of its tasks.
+	CreatedBy *string   `gorm:"type:varchar(255)" json:"created_by"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
+
+func (Recurrence) TableName() string {
+	return TableNameRecurrence
+}
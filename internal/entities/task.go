@@ -1,32 +1,119 @@
 package entities
 
-import "gorm.io/gorm"
+import (
+	"time"
 
+	"gorm.io/gorm"
+)
+
+// TaskStatus is the name of a status of the workflow of the workspace of a task.
 type TaskStatus string
 
+// The statuses of DefaultWorkflow.
 const (
 	TaskStatusToDo       TaskStatus = "TO_DO"
 	TaskStatusInProgress TaskStatus = "IN_PROGRESS"
 	TaskStatusDone       TaskStatus = "DONE"
 )
 
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "LOW"
+	TaskPriorityMedium TaskPriority = "MEDIUM"
+	TaskPriorityHigh   TaskPriority = "HIGH"
+	TaskPriorityUrgent TaskPriority = "URGENT"
+)
+
+// TaskPriorities lists the priorities from the lowest to the highest, the order of the task_priority
+// enum by which tasks are sorted.
+var TaskPriorities = []TaskPriority{TaskPriorityLow, TaskPriorityMedium, TaskPriorityHigh, TaskPriorityUrgent}
+
 type Task struct {
-	Id          uint           `gorm:"primaryKey;autoIncrement" json:"id"`
-	Title       string         `gorm:"not null;type:varchar(100)" json:"title"`
-	Description string         `gorm:"not null;type:text" json:"description"`
-	Status      TaskStatus     `gorm:"not null;default:TO_DO;" swagger:"enum(TO_DO,IN_PROGRESS,DONE)" json:"status"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+	Id          uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint       `gorm:"not null;index" json:"workspace_id"`
+	Title       string     `gorm:"not null;type:varchar(100)" json:"title"`
+	Description string     `gorm:"not null;type:text" json:"description"`
+	Status      TaskStatus `gorm:"not null;type:varchar(50);default:TO_DO" json:"status" example:"TO_DO"`
+	// StatusCategory is the category of the status in the workflow of the workspace.
+	StatusCategory StatusCategory `gorm:"not null;type:varchar(10);default:todo" swagger:"enum(todo,active,done)" json:"status_category"`
+	Version        uint           `gorm:"not null;default:1" json:"version"`
+	CreatedAt      time.Time      `gorm:"not null;index" json:"created_at"`
+	UpdatedAt      time.Time      `gorm:"not null;index" json:"updated_at"`
+	// CreatedBy is the user who created the task; tasks created anonymously have no owner.
+	CreatedBy *string `gorm:"type:varchar(255);index" json:"created_by"`
+	// AssigneeId is the user the task is assigned to.
+	AssigneeId *string `gorm:"type:varchar(255);index" json:"assignee_id"`
+	// ParentId is the task this task is a subtask of.
+	ParentId *uint `gorm:"index" json:"parent_id"`
+	// RecurrenceId is the recurrence that created the task at OccurrenceAt.
+	RecurrenceId *uint      `json:"recurrence_id"`
+	OccurrenceAt *time.Time `json:"occurrence_at"`
+	TaskSchedule
+	// CompletedAt is set when the task moves to a done status.
+	CompletedAt *time.Time `json:"completed_at"`
+	// DeletedAt is only set on soft-deleted tasks, which are listed with include_deleted.
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" swaggertype:"string" format:"date-time"`
+	// Labels are the labels of the task, sorted by name.
+	Labels []Label `gorm:"-" json:"labels"`
+	// CommentCount is the number of comments of the task that are not deleted.
+	CommentCount int `gorm:"-" json:"comment_count"`
+	// Blocked reports whether a task that is not done blocks the task.
+	Blocked bool `gorm:"-" json:"blocked"`
+	// Progress is the roll-up of the subtasks of the task; it is only set when the task has subtasks.
+	Progress *TaskProgress `gorm:"-" json:"progress,omitempty"`
 }
 
 func (Task) TableName() string {
 	return TableNameTask
 }
 
+// TaskSchedule holds the planning fields of a task.
+type TaskSchedule struct {
+	// DueAt is when the task is due; open tasks due before now are overdue.
+	DueAt    *time.Time   `json:"due_at"`
+	Priority TaskPriority `gorm:"not null;default:MEDIUM" swagger:"enum(LOW,MEDIUM,HIGH,URGENT)" json:"priority"`
+	// EstimateMinutes is the estimated effort of the task.
+	EstimateMinutes *int `json:"estimate_minutes"`
+}
+
+// TaskProgress counts the subtasks of a task that are done.
+type TaskProgress struct {
+	Total int `json:"total" example:"4"`
+	Done  int `json:"done" example:"1"`
+	// Percent is the percentage of the subtasks that are done, rounded down.
+	Percent int `json:"percent" example:"25"`
+}
+
+// NewTaskProgress returns the progress of a task with total subtasks, done of which are done.
+func NewTaskProgress(total, done int) TaskProgress {
+	progress := TaskProgress{Total: total, Done: done}
+	if total > 0 {
+		progress.Percent = done * 100 / total
+	}
+	return progress
+}
+
+// Open is the number of subtasks that are not done.
+func (p TaskProgress) Open() int {
+	return p.Total - p.Done
+}
+
 type TaskUpdate struct {
 	Id          uint        `json:"id"`
 	Title       *string     `json:"title"`
 	Description *string     `json:"description"`
 	Status      *TaskStatus `json:"status"`
+	// StatusCategory, set by the usecase along with Status, is the category of the new status.
+	StatusCategory StatusCategory `gorm:"-" json:"-"`
+	// TaskSchedule, when set, replaces the schedule of the task: a nil DueAt or EstimateMinutes clears it.
+	*TaskSchedule
+	// Version is the version of the task after the update.
+	Version uint `gorm:"-" json:"version"`
+	// ExpectedVersions, when set, makes the update conditional on the current version (If-Match).
+	ExpectedVersions []uint `gorm:"-" json:"-"`
+	// Force completes a task whose subtasks are not all done.
+	Force bool `gorm:"-" json:"-"`
 }
 
 func (TaskUpdate) TableName() string {
@@ -35,6 +122,17 @@ func (TaskUpdate) TableName() string {
 
 // beforeCreate
 func (t *Task) BeforeCreate(tx *gorm.DB) (err error) {
-	t.Status = TaskStatusToDo
+	if t.Status == "" {
+		t.Status = TaskStatusToDo
+		t.StatusCategory = StatusCategoryTodo
+	}
+	if t.Priority == "" {
+		t.Priority = TaskPriorityMedium
+	}
+	// due_at has no time zone: store it in UTC like the other timestamps.
+	if t.DueAt != nil {
+		dueAt := t.DueAt.UTC()
+		t.DueAt = &dueAt
+	}
 	return
 }
@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// TaskDependency records that the blocker task blocks the blocked task: the blocked task cannot start
+// or be completed until the blocker is DONE.
+type TaskDependency struct {
+	WorkspaceId uint `gorm:"not null" json:"workspace_id"`
+	BlockerId   uint `gorm:"primaryKey;autoIncrement:false" json:"blocker_id"`
+	BlockedId   uint `gorm:"primaryKey;autoIncrement:false" json:"blocked_id"`
+	// CreatedBy is the user who added the dependency.
+	CreatedBy *string   `gorm:"type:varchar(255)" json:"created_by"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+}
+
+func (TaskDependency) TableName() string {
+	return TableNameTaskDependency
+}
@@ -0,0 +1,67 @@
+package entities
+
+import "time"
+
+type TaskEventAction string
+
+const (
+	TaskEventCreated       TaskEventAction = "created"
+	TaskEventUpdated       TaskEventAction = "updated"
+	TaskEventStatusChanged TaskEventAction = "status_changed"
+	TaskEventDeleted       TaskEventAction = "deleted"
+	TaskEventRestored      TaskEventAction = "restored"
+	TaskEventAssigned      TaskEventAction = "assigned"
+	TaskEventMoved         TaskEventAction = "moved"
+	// TaskEventDependencyAdded and TaskEventDependencyRemoved are recorded on the blocked task.
+	TaskEventDependencyAdded   TaskEventAction = "dependency_added"
+	TaskEventDependencyRemoved TaskEventAction = "dependency_removed"
+	TaskEventLabelAdded        TaskEventAction = "label_added"
+	TaskEventLabelRemoved      TaskEventAction = "label_removed"
+)
+
+// TaskEvent is one entry of the audit trail of a task.
+type TaskEvent struct {
+	Id          uint            `gorm:"primaryKey;autoIncrement" json:"id"`
+	TaskId      uint            `gorm:"not null;index" json:"task_id"`
+	WorkspaceId uint            `gorm:"not null;index" json:"workspace_id"`
+	Action      TaskEventAction `gorm:"not null;type:varchar(32)" swagger:"enum(created,updated,status_changed,deleted,restored,assigned,moved,dependency_added,dependency_removed,label_added,label_removed)" json:"action"`
+	Changes     JSON            `gorm:"not null;type:jsonb" json:"changes" swaggertype:"object"`
+	Actor       string          `gorm:"not null;type:varchar(255)" json:"actor"`
+	RequestId   string          `gorm:"not null;type:varchar(255)" json:"request_id"`
+	CreatedAt   time.Time       `gorm:"not null" json:"created_at"`
+}
+
+func (TaskEvent) TableName() string {
+	return TableNameTaskEvent
+}
+
+// FieldChange is the old and new value of a field in TaskEvent.Changes.
+type FieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// Actor identifies who performed a change and the request it was made in.
+type Actor struct {
+	Name string
+	// UserId is the subject of the bearer token, empty when authentication is disabled.
+	UserId    string
+	RequestId string
+	// Admin reports whether the request was made with admin privileges.
+	Admin bool
+	// Role is the role of the user in the workspace of the request, empty when authentication is disabled.
+	Role WorkspaceRole
+	// ApiKeyId is the API key the request was authenticated with, zero for bearer tokens.
+	ApiKeyId uint
+}
+
+// TaskEventsChannel is the Postgres notification channel of the recorded task events.
+const TaskEventsChannel = "task_events"
+
+// TaskEventNotification is the payload of a notification of TaskEventsChannel, sent when the
+// transaction recording a task event commits.
+type TaskEventNotification struct {
+	Id          uint `json:"id"`
+	WorkspaceId uint `json:"workspace_id"`
+	TaskId      uint `json:"task_id"`
+}
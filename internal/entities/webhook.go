@@ -0,0 +1,159 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WebhookEvent is a kind of change of the tasks that webhooks subscribe to.
+type WebhookEvent string
+
+const (
+	// WebhookEventTaskCreated notifies of created and restored tasks.
+	WebhookEventTaskCreated WebhookEvent = "task.created"
+	// WebhookEventTaskUpdated notifies of every other change of a task, such as an assignment or a new label.
+	WebhookEventTaskUpdated       WebhookEvent = "task.updated"
+	WebhookEventTaskStatusChanged WebhookEvent = "task.status_changed"
+	WebhookEventTaskDeleted       WebhookEvent = "task.deleted"
+)
+
+// webhookEvents are the webhook events of the domain events.
+var webhookEvents = map[DomainEventType]WebhookEvent{
+	DomainEventTaskCreated:       WebhookEventTaskCreated,
+	DomainEventTaskUpdated:       WebhookEventTaskUpdated,
+	DomainEventTaskStatusChanged: WebhookEventTaskStatusChanged,
+	DomainEventTaskDeleted:       WebhookEventTaskDeleted,
+}
+
+// WebhookEventOf returns the webhook event of an action of the history of a task.
+func WebhookEventOf(action TaskEventAction) WebhookEvent {
+	return webhookEvents[DomainEventTypeOf(action)]
+}
+
+// WebhookEvents is a list of events stored space-separated, like ApiKeyScopes.
+type WebhookEvents []WebhookEvent
+
+// Contains reports whether event is one of the events.
+func (e WebhookEvents) Contains(event WebhookEvent) bool {
+	for _, subscribed := range e {
+		if subscribed == event {
+			return true
+		}
+	}
+	return false
+}
+
+func (e WebhookEvents) Value() (driver.Value, error) {
+	events := make([]string, len(e))
+	for i, event := range e {
+		events[i] = string(event)
+	}
+	return strings.Join(events, " "), nil
+}
+
+func (e *WebhookEvents) Scan(value interface{}) error {
+	var events string
+	switch v := value.(type) {
+	case []byte:
+		events = string(v)
+	case string:
+		events = v
+	default:
+		return fmt.Errorf("cannot scan %T into entities.WebhookEvents", value)
+	}
+	*e = WebhookEvents{}
+	for _, event := range strings.Fields(events) {
+		*e = append(*e, WebhookEvent(event))
+	}
+	return nil
+}
+
+// Webhook subscribes a URL to events of the tasks of a workspace. The requests are signed with
+// an HMAC-SHA256 of their body keyed by Secret, which is never returned.
+type Webhook struct {
+	Id          uint          `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint          `gorm:"not null;index" json:"workspace_id"`
+	Url         string        `gorm:"not null;type:varchar(2048)" json:"url"`
+	Secret      string        `gorm:"not null;type:varchar(255)" json:"-"`
+	Events      WebhookEvents `gorm:"not null;type:varchar(255)" json:"events" swaggertype:"array,string" example:"task.created,task.status_changed"`
+	CreatedBy   string        `gorm:"not null;type:varchar(255)" json:"created_by"`
+	CreatedAt   time.Time     `gorm:"not null" json:"created_at"`
+	UpdatedAt   time.Time     `gorm:"not null" json:"updated_at"`
+}
+
+func (Webhook) TableName() string {
+	return TableNameWebhook
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending deliveries wait for their next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded deliveries got a 2xx response.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed deliveries used up their attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is the notification of an event to a webhook, queued until it succeeds or
+// runs out of attempts.
+type WebhookDelivery struct {
+	Id          uint         `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint         `gorm:"not null;index" json:"workspace_id"`
+	WebhookId   uint         `gorm:"not null" json:"webhook_id"`
+	Event       WebhookEvent `gorm:"not null;type:varchar(32)" json:"event" swagger:"enum(task.created,task.updated,task.status_changed,task.deleted)"`
+	// TaskEventId is the entry of the history of the task the delivery notifies of.
+	TaskEventId uint `gorm:"not null" json:"task_event_id"`
+	// EventId is the domain event the delivery was queued for, of which a webhook gets a single
+	// delivery; redeliveries have none.
+	EventId *string `gorm:"type:uuid" json:"-"`
+	// Payload is the body of the requests, a WebhookPayload.
+	Payload  JSON                  `gorm:"not null;type:jsonb" json:"payload" swaggertype:"object"`
+	Status   WebhookDeliveryStatus `gorm:"not null;type:varchar(16);default:pending" json:"status" swagger:"enum(pending,succeeded,failed)"`
+	Attempts int                   `gorm:"not null;default:0" json:"attempts"`
+	// NextAttemptAt is when a pending delivery is sent next.
+	NextAttemptAt time.Time `gorm:"not null" json:"next_attempt_at"`
+	// ResponseStatus is the HTTP status of the last attempt, null when it got no response.
+	ResponseStatus *int `json:"response_status"`
+	// LastError tells why the last attempt failed.
+	LastError   string     `gorm:"not null;type:text;default:''" json:"last_error"`
+	CreatedAt   time.Time  `gorm:"not null" json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return TableNameWebhookDelivery
+}
+
+// WebhookPayload is the body of the requests of webhooks.
+type WebhookPayload struct {
+	Event WebhookEvent `json:"event" example:"task.status_changed"`
+	// Action is the action of the history of the task, which tells apart the changes of task.updated.
+	Action      TaskEventAction `json:"action" example:"status_changed"`
+	TaskEventId uint            `json:"task_event_id" example:"42"`
+	WorkspaceId uint            `json:"workspace_id" example:"1"`
+	TaskId      uint            `json:"task_id" example:"7"`
+	// Changes are the old and new values of the changed fields, as in the history of the task.
+	Changes    JSON      `json:"changes" swaggertype:"object"`
+	Actor      string    `json:"actor" example:"alice"`
+	RequestId  string    `json:"request_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NewWebhookPayload returns the payload notifying of event.
+func NewWebhookPayload(event *TaskEvent) WebhookPayload {
+	return WebhookPayload{
+		Event:       WebhookEventOf(event.Action),
+		Action:      event.Action,
+		TaskEventId: event.Id,
+		WorkspaceId: event.WorkspaceId,
+		TaskId:      event.TaskId,
+		Changes:     event.Changes,
+		Actor:       event.Actor,
+		RequestId:   event.RequestId,
+		OccurredAt:  event.CreatedAt,
+	}
+}
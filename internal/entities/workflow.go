@@ -0,0 +1,167 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// StatusCategory groups the statuses of a workflow by what they mean for the task.
+type StatusCategory string
+
+const (
+	// StatusCategoryTodo is the category of the statuses of tasks not started.
+	StatusCategoryTodo StatusCategory = "todo"
+	// StatusCategoryActive is the category of the statuses of tasks in progress.
+	StatusCategoryActive StatusCategory = "active"
+	// StatusCategoryDone is the category of the statuses of completed tasks: they do not block
+	// other tasks, count as done in the progress of their parent and are never overdue.
+	StatusCategoryDone StatusCategory = "done"
+)
+
+// StatusCategories lists the categories in the order tasks move through them.
+var StatusCategories = []StatusCategory{StatusCategoryTodo, StatusCategoryActive, StatusCategoryDone}
+
+// maxTaskStatusLength is the length of the status column of tasks.
+const maxTaskStatusLength = 50
+
+var taskStatusPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// Valid reports whether s is a well-formed status name: upper case letters, digits and
+// underscores, starting with a letter, such as IN_REVIEW.
+func (s TaskStatus) Valid() bool {
+	return len(s) <= maxTaskStatusLength && taskStatusPattern.MatchString(string(s))
+}
+
+// WorkflowStatus is a status tasks can be in.
+type WorkflowStatus struct {
+	Name     TaskStatus     `json:"name" example:"IN_REVIEW"`
+	Category StatusCategory `json:"category" swagger:"enum(todo,active,done)" example:"active"`
+	// ReadOnly forbids changing the details of the tasks in this status.
+	ReadOnly bool `json:"read_only" example:"false"`
+}
+
+// WorkflowTransition allows tasks to move from one status to another.
+type WorkflowTransition struct {
+	From TaskStatus `json:"from" example:"IN_PROGRESS"`
+	To   TaskStatus `json:"to" example:"IN_REVIEW"`
+	// Role is the workspace role required to make the transition; members can make it when empty.
+	Role WorkspaceRole `json:"role,omitempty" swagger:"enum(member,admin)" example:"admin"`
+}
+
+// WorkflowStatuses is the list of statuses of a workflow, stored as JSON.
+type WorkflowStatuses []WorkflowStatus
+
+func (s WorkflowStatuses) Value() (driver.Value, error) {
+	return jsonValue(s)
+}
+
+func (s *WorkflowStatuses) Scan(value interface{}) error {
+	return scanJSON(value, s)
+}
+
+// WorkflowTransitions is the list of transitions of a workflow, stored as JSON.
+type WorkflowTransitions []WorkflowTransition
+
+func (t WorkflowTransitions) Value() (driver.Value, error) {
+	return jsonValue(t)
+}
+
+func (t *WorkflowTransitions) Scan(value interface{}) error {
+	return scanJSON(value, t)
+}
+
+// Workflow defines the statuses of the tasks of a workspace and the transitions between them.
+// The active workflow of a workspace applies to all its tasks; DefaultWorkflow applies when none is.
+type Workflow struct {
+	Id          uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceId uint   `gorm:"not null;index" json:"workspace_id"`
+	Name        string `gorm:"not null;type:varchar(100)" json:"name"`
+	// Active makes the workflow the one of the tasks of the workspace; at most one workflow is active.
+	Active bool `gorm:"not null;default:false" json:"active"`
+	// Statuses are the statuses of the tasks; new tasks take the first one, which is a todo status.
+	Statuses    WorkflowStatuses    `gorm:"not null;type:jsonb" json:"statuses"`
+	Transitions WorkflowTransitions `gorm:"not null;type:jsonb" json:"transitions"`
+	CreatedAt   time.Time           `gorm:"not null" json:"created_at"`
+	UpdatedAt   time.Time           `gorm:"not null" json:"updated_at"`
+}
+
+func (Workflow) TableName() string {
+	return TableNameWorkflow
+}
+
+// DefaultWorkflow returns the workflow of the workspaces without an active workflow: TO_DO,
+// IN_PROGRESS and DONE, done tasks being read-only and only admins completing tasks not started.
+func DefaultWorkflow() *Workflow {
+	return &Workflow{
+		Name:   "default",
+		Active: true,
+		Statuses: WorkflowStatuses{
+			{Name: TaskStatusToDo, Category: StatusCategoryTodo},
+			{Name: TaskStatusInProgress, Category: StatusCategoryActive},
+			{Name: TaskStatusDone, Category: StatusCategoryDone, ReadOnly: true},
+		},
+		Transitions: WorkflowTransitions{
+			{From: TaskStatusToDo, To: TaskStatusInProgress},
+			{From: TaskStatusToDo, To: TaskStatusDone, Role: WorkspaceRoleAdmin},
+			{From: TaskStatusInProgress, To: TaskStatusDone},
+		},
+	}
+}
+
+// InitialStatus returns the status of new tasks.
+func (w *Workflow) InitialStatus() WorkflowStatus {
+	return w.Statuses[0]
+}
+
+// Status returns the status named name.
+func (w *Workflow) Status(name TaskStatus) (WorkflowStatus, bool) {
+	for _, status := range w.Statuses {
+		if status.Name == name {
+			return status, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// Transition returns the transition from one status to another.
+func (w *Workflow) Transition(from, to TaskStatus) (WorkflowTransition, bool) {
+	for _, transition := range w.Transitions {
+		if transition.From == from && transition.To == to {
+			return transition, true
+		}
+	}
+	return WorkflowTransition{}, false
+}
+
+// NextStatuses returns the statuses tasks can move to from the status from.
+func (w *Workflow) NextStatuses(from TaskStatus) []TaskStatus {
+	next := []TaskStatus{}
+	for _, transition := range w.Transitions {
+		if transition.From == from {
+			next = append(next, transition.To)
+		}
+	}
+	return next
+}
+
+func jsonValue(v interface{}) (driver.Value, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func scanJSON(value interface{}, v interface{}) error {
+	switch data := value.(type) {
+	case []byte:
+		return json.Unmarshal(data, v)
+	case string:
+		return json.Unmarshal([]byte(data), v)
+	default:
+		return fmt.Errorf("cannot scan %T into %T", value, v)
+	}
+}
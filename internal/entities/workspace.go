@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultWorkspaceId is the workspace created by the migrations, used when a request names none.
+const DefaultWorkspaceId uint = 1
+
+// Workspace isolates the tasks of a team from the other teams of the deployment.
+type Workspace struct {
+	Id        uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Slug      string    `gorm:"not null;uniqueIndex;type:varchar(64)" json:"slug"`
+	Name      string    `gorm:"not null;type:varchar(255)" json:"name"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+}
+
+func (Workspace) TableName() string {
+	return TableNameWorkspace
+}
+
+// WorkspaceRole decides what a member can do in a workspace. Each role can do everything
+// the roles before it can: viewer, member, then admin.
+type WorkspaceRole string
+
+const (
+	// WorkspaceRoleViewer reads tasks.
+	WorkspaceRoleViewer WorkspaceRole = "viewer"
+	// WorkspaceRoleMember also creates tasks and changes the tasks it owns or is assigned to.
+	WorkspaceRoleMember WorkspaceRole = "member"
+	// WorkspaceRoleAdmin can do anything in the workspace.
+	WorkspaceRoleAdmin WorkspaceRole = "admin"
+)
+
+var workspaceRoleRanks = map[WorkspaceRole]int{
+	WorkspaceRoleViewer: 1,
+	WorkspaceRoleMember: 2,
+	WorkspaceRoleAdmin:  3,
+}
+
+// ParseWorkspaceRole parses the name of a workspace role.
+func ParseWorkspaceRole(s string) (WorkspaceRole, error) {
+	role := WorkspaceRole(s)
+	if _, ok := workspaceRoleRanks[role]; !ok {
+		return "", fmt.Errorf("unknown workspace role %q", s)
+	}
+	return role, nil
+}
+
+// Includes reports whether the role can do what the required role can; an unknown role includes no role.
+func (r WorkspaceRole) Includes(required WorkspaceRole) bool {
+	rank, ok := workspaceRoleRanks[r]
+	return ok && rank >= workspaceRoleRanks[required]
+}
+
+// WorkspaceMember is the role of a user in a workspace.
+type WorkspaceMember struct {
+	WorkspaceId uint          `gorm:"primaryKey" json:"workspace_id"`
+	UserId      string        `gorm:"primaryKey;type:varchar(255)" json:"user_id"`
+	Role        WorkspaceRole `gorm:"not null;type:varchar(16)" json:"role"`
+	CreatedAt   time.Time     `gorm:"not null" json:"created_at"`
+}
+
+func (WorkspaceMember) TableName() string {
+	return TableNameWorkspaceMember
+}
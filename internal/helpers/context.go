@@ -0,0 +1,32 @@
+package helpers
+
+import "context"
+
+type contextKey string
+
+const (
+	requestIDKey   contextKey = "request_id"
+	workspaceIDKey contextKey = "workspace_id"
+)
+
+// ContextWithRequestID returns a copy of ctx carrying the ID of the request.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, or an empty string.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// ContextWithWorkspaceID returns a copy of ctx carrying the workspace the request works in.
+func ContextWithWorkspaceID(ctx context.Context, workspaceID uint) context.Context {
+	return context.WithValue(ctx, workspaceIDKey, workspaceID)
+}
+
+// WorkspaceIDFromContext returns the workspace carried by ctx, if any.
+func WorkspaceIDFromContext(ctx context.Context) (uint, bool) {
+	workspaceID, ok := ctx.Value(workspaceIDKey).(uint)
+	return workspaceID, ok
+}
@@ -0,0 +1,36 @@
+package helpers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/supachai1998/task_services/internal/domainerrors"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded.
+var ErrInvalidCursor = domainerrors.Validation("invalid cursor")
+
+// cursor is the position of the last row of a page: the value of the sort column and the row id as tie breaker.
+type cursor struct {
+	Value string `json:"v"`
+	Id    uint   `json:"id"`
+}
+
+// EncodeCursor builds an opaque pagination cursor.
+func EncodeCursor(value string, id uint) string {
+	b, _ := json.Marshal(cursor{Value: value, Id: id})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor reads a cursor built by EncodeCursor.
+func DecodeCursor(s string) (string, uint, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return "", 0, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Id == 0 {
+		return "", 0, ErrInvalidCursor
+	}
+	return c.Value, c.Id, nil
+}
@@ -11,9 +11,12 @@ func NewResponseSuccess(message string, data interface{}) models.ResponseSuccess
 	}
 }
 
-func NewResponseError(message string, status string) models.ResponseError {
-	return models.ResponseError{
-		Message: message,
-		Status:  status,
+func NewResponsePaginated(message string, data interface{}, nextCursor string) models.ResponsePaginated {
+	status := "success"
+	return models.ResponsePaginated{
+		Message:    message,
+		Status:     status,
+		Data:       data,
+		NextCursor: nextCursor,
 	}
 }
@@ -0,0 +1,105 @@
+package infrastructure
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgconn"
+
+	"github.com/supachai1998/task_services/internal/configs"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// PostgresListener receives the notifications of a Postgres channel on a connection of its own,
+// as LISTEN does not work through the connection pool of gorm.
+type PostgresListener struct {
+	dsn     string
+	channel string
+	handle  func(ctx context.Context, payload string)
+	// listening is called each time the listener starts listening, since the notifications sent
+	// while it was disconnected are lost.
+	listening func()
+}
+
+// NewPostgresListener returns a listener calling handle with the payload of every notification of
+// channel, a plain identifier, and listening each time it starts listening.
+func NewPostgresListener(config *configs.DatabaseConfig, channel string, handle func(ctx context.Context, payload string), listening func()) *PostgresListener {
+	return &PostgresListener{
+		dsn:       postgresDSN(config),
+		channel:   channel,
+		handle:    handle,
+		listening: listening,
+	}
+}
+
+// Run listens until ctx is done, reconnecting after a second when the connection is lost, then
+// twice as long after each failed attempt up to 30 seconds.
+func (l *PostgresListener) Run(ctx context.Context) {
+	delay := minReconnectDelay
+	for {
+		listened, err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if listened {
+			delay = minReconnectDelay
+		}
+		log.Printf("postgres: stopped listening to %s, reconnecting in %s: %v", l.channel, delay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		if !listened && delay < maxReconnectDelay {
+			delay *= 2
+			if delay > maxReconnectDelay {
+				delay = maxReconnectDelay
+			}
+		}
+	}
+}
+
+// listen connects and handles the notifications until the connection fails, reporting whether
+// it got to listen.
+func (l *PostgresListener) listen(ctx context.Context) (bool, error) {
+	config, err := pgconn.ParseConfig(l.dsn)
+	if err != nil {
+		return false, err
+	}
+	// Notifications arrive while WaitForNotification reads the connection; they are handled
+	// once it returns, so the handler can take its time.
+	var payloads []string
+	config.OnNotification = func(_ *pgconn.PgConn, notification *pgconn.Notification) {
+		if notification.Channel == l.channel {
+			payloads = append(payloads, notification.Payload)
+		}
+	}
+	conn, err := pgconn.ConnectConfig(ctx, config)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+	if _, err := conn.Exec(ctx, "LISTEN "+l.channel).ReadAll(); err != nil {
+		return false, err
+	}
+	l.listening()
+
+	for {
+		if err := conn.WaitForNotification(ctx); err != nil {
+			return true, err
+		}
+		for _, payload := range payloads {
+			l.handle(ctx, payload)
+		}
+		payloads = payloads[:0]
+	}
+}
@@ -1,26 +1,26 @@
 package infrastructure
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
 	"github.com/supachai1998/task_services/internal/configs"
+	"github.com/supachai1998/task_services/internal/helpers"
 )
 
 func NewPostgreSQL(config *configs.DatabaseConfig) (*gorm.DB, error) {
 	// Set up the database connection
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable",
-		configs.AppConfig.Database.Host,
-		configs.AppConfig.Database.User,
-		configs.AppConfig.Database.Password,
-		configs.AppConfig.Database.DbName,
-		configs.AppConfig.Database.Port,
-	)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+	db, err := gorm.Open(postgres.Open(postgresDSN(config)), &gorm.Config{
 		Logger: GormLogger{logger.Default.LogMode(logger.Info)},
+		// Timestamp columns have no time zone, store them in UTC
+		NowFunc: func() time.Time {
+			return time.Now().UTC()
+		},
 	})
 	if err != nil {
 		panic("failed to connect to database")
@@ -29,6 +29,33 @@ func NewPostgreSQL(config *configs.DatabaseConfig) (*gorm.DB, error) {
 	return db, nil
 }
 
+// postgresDSN returns the connection string of the database of config.
+func postgresDSN(config *configs.DatabaseConfig) string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=disable",
+		config.Host,
+		config.User,
+		config.Password,
+		config.DbName,
+		config.Port,
+	)
+}
+
+// GormLogger prefixes the logged queries with the ID of the request that made them.
 type GormLogger struct {
 	logger.Interface
 }
+
+func (l GormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	return GormLogger{l.Interface.LogMode(level)}
+}
+
+func (l GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if requestID := helpers.RequestIDFromContext(ctx); requestID != "" {
+		trace := fc
+		fc = func() (string, int64) {
+			sql, rowsAffected := trace()
+			return fmt.Sprintf("/* request_id=%s */ %s", requestID, sql), rowsAffected
+		}
+	}
+	l.Interface.Trace(ctx, begin, fc, err)
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// HeaderActor names the caller recorded in the audit trail when authentication is disabled.
+const HeaderActor = "X-Actor"
+
+// ActorFrom returns who performs the request, its request ID, whether it is an admin request
+// and its workspace role.
+func ActorFrom(c echo.Context) entities.Actor {
+	actor := entities.Actor{
+		Name:      c.Request().Header.Get(HeaderActor),
+		RequestId: c.Response().Header().Get(echo.HeaderXRequestID),
+		Admin:     IsAdmin(c),
+		Role:      RoleFrom(c),
+	}
+	// An authenticated caller cannot act under another name
+	if principal := auth.PrincipalFrom(c); principal != nil {
+		actor.Name = principal.Subject
+		actor.UserId = principal.Subject
+		actor.Admin = actor.Admin || principal.HasRole(auth.RoleAdmin)
+		actor.ApiKeyId = principal.ApiKeyId
+	}
+	if actor.Name == "" {
+		actor.Name = "anonymous"
+	}
+	return actor
+}
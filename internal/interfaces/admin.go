@@ -0,0 +1,39 @@
+package interfaces
+
+import (
+	"crypto/subtle"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+)
+
+const (
+	// HeaderAdminKey carries the admin key of admin requests.
+	HeaderAdminKey = "X-Admin-Key"
+	// ContextKeyAdmin is set to true in the Echo context of requests authenticated with the admin key.
+	ContextKeyAdmin = "admin"
+)
+
+// AdminKey marks requests carrying the configured admin key as admin requests.
+// Requests with a wrong key are rejected; an empty key disables admin access.
+func AdminKey(key string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			given := c.Request().Header.Get(HeaderAdminKey)
+			if given == "" {
+				return next(c)
+			}
+			if key == "" || subtle.ConstantTimeCompare([]byte(given), []byte(key)) != 1 {
+				return domainerrors.Forbidden("invalid admin key")
+			}
+			c.Set(ContextKeyAdmin, true)
+			return next(c)
+		}
+	}
+}
+
+// IsAdmin reports whether the request was authenticated with the admin key.
+func IsAdmin(c echo.Context) bool {
+	admin, _ := c.Get(ContextKeyAdmin).(bool)
+	return admin
+}
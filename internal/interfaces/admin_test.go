@@ -0,0 +1,52 @@
+package interfaces_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+func TestAdminKey(t *testing.T) {
+	e := echo.New()
+
+	cases := []struct {
+		name          string
+		key           string
+		header        string
+		expectedAdmin bool
+		expectedKind  domainerrors.Kind
+	}{
+		{"NoHeader", "secret", "", false, ""},
+		{"ValidKey", "secret", "secret", true, ""},
+		{"WrongKey", "secret", "guess", false, domainerrors.KindForbidden},
+		{"AdminDisabled", "", "secret", false, domainerrors.KindForbidden},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks", nil)
+			if tc.header != "" {
+				req.Header.Set(interfaces.HeaderAdminKey, tc.header)
+			}
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			var admin bool
+			err := interfaces.AdminKey(tc.key)(func(c echo.Context) error {
+				admin = interfaces.IsAdmin(c)
+				return nil
+			})(c)
+
+			if tc.expectedKind != "" {
+				assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedAdmin, admin)
+		})
+	}
+}
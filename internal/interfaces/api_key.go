@@ -0,0 +1,73 @@
+package interfaces
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	apiKeyUsecases "github.com/supachai1998/task_services/internal/domains/apikeys/usecases"
+	"github.com/supachai1998/task_services/internal/entities"
+)
+
+// schemeApiKey is the scheme of the "Authorization: ApiKey <key>" header.
+const schemeApiKey = "ApiKey"
+
+// ApiKey authenticates the requests carrying an "Authorization: ApiKey <key>" header. It sets the
+// principal of the key like auth.Middleware, which then lets the request through; requests with
+// another Authorization header are left to auth.Middleware.
+func ApiKey(apiKeys apiKeyUsecases.ApiKeyUsecase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key, ok := apiKeyOf(c.Request().Header.Get(echo.HeaderAuthorization))
+			if !ok {
+				return next(c)
+			}
+			principal, err := apiKeys.Authenticate(c.Request().Context(), key)
+			if err != nil {
+				if domainerrors.IsKind(err, domainerrors.KindUnauthorized) {
+					c.Response().Header().Set(echo.HeaderWWWAuthenticate, schemeApiKey)
+				}
+				return err
+			}
+			c.Set(auth.ContextKeyPrincipal, principal)
+			c.SetRequest(c.Request().WithContext(auth.ContextWithPrincipal(c.Request().Context(), principal)))
+			return next(c)
+		}
+	}
+}
+
+// apiKeyOf extracts the key of an "Authorization: ApiKey <key>" header.
+func apiKeyOf(header string) (string, bool) {
+	prefix := schemeApiKey + " "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	key := strings.TrimSpace(header[len(prefix):])
+	return key, key != ""
+}
+
+// HasScope reports whether the caller was granted scope; only API keys lack scopes.
+func HasScope(c echo.Context, scope entities.ApiKeyScope) bool {
+	principal := auth.PrincipalFrom(c)
+	return principal == nil || principal.HasScope(scope)
+}
+
+// RequireScope rejects the API keys that were not granted scope.
+func RequireScope(scope entities.ApiKeyScope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !HasScope(c, scope) {
+				return ErrMissingScope(scope)
+			}
+			return next(c)
+		}
+	}
+}
+
+// ErrMissingScope is the error of an API key used without scope.
+func ErrMissingScope(scope entities.ApiKeyScope) error {
+	return domainerrors.Forbidden(fmt.Sprintf("the API key lacks the %s scope", scope)).
+		WithDetail("required_scope", scope)
+}
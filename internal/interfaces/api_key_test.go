@@ -0,0 +1,120 @@
+package interfaces_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/apikeys/usecases"
+)
+
+func TestApiKey(t *testing.T) {
+	e := echo.New()
+	bot := &auth.Principal{Subject: "alice", Workspaces: []uint{2}, Scopes: entities.ApiKeyScopes{entities.ScopeTasksRead}, ApiKeyId: 7}
+
+	cases := []struct {
+		name              string
+		authorization     string
+		authenticated     *auth.Principal
+		authenticateErr   error
+		expectedPrincipal *auth.Principal
+		expectedKind      domainerrors.Kind
+		expectedChallenge string
+	}{
+		{"NoHeader", "", nil, nil, nil, "", ""},
+		{"BearerToken", "Bearer token", nil, nil, nil, "", ""},
+		{"ValidKey", "ApiKey tsk_valid", bot, nil, bot, "", ""},
+		{"LowercaseScheme", "apikey tsk_valid", bot, nil, bot, "", ""},
+		{"InvalidKey", "ApiKey tsk_invalid", nil, domainerrors.Unauthorized("invalid API key"), nil, domainerrors.KindUnauthorized, "ApiKey"},
+		{"Unavailable", "ApiKey tsk_valid", nil, domainerrors.Unavailable("the query was canceled or timed out"), nil, domainerrors.KindUnavailable, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			apiKeys := mocks.NewMockApiKeyUsecase(ctrl)
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks", nil)
+			if tc.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tc.authenticated != nil || tc.authenticateErr != nil {
+				apiKeys.EXPECT().Authenticate(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key string) (*auth.Principal, error) {
+					assert.Contains(t, tc.authorization, key)
+					return tc.authenticated, tc.authenticateErr
+				})
+			}
+
+			var fromEcho, fromContext *auth.Principal
+			err := interfaces.ApiKey(apiKeys)(func(c echo.Context) error {
+				fromEcho = auth.PrincipalFrom(c)
+				fromContext = auth.PrincipalFromContext(c.Request().Context())
+				return nil
+			})(c)
+
+			assert.Equal(t, tc.expectedChallenge, rec.Header().Get(echo.HeaderWWWAuthenticate))
+			if tc.expectedKind != "" {
+				assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Same(t, tc.expectedPrincipal, fromEcho)
+			assert.Same(t, tc.expectedPrincipal, fromContext)
+		})
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	e := echo.New()
+	readOnly := &auth.Principal{Subject: "alice", Scopes: entities.ApiKeyScopes{entities.ScopeTasksRead}, ApiKeyId: 7}
+
+	cases := []struct {
+		name      string
+		principal *auth.Principal
+		scope     entities.ApiKeyScope
+		allowed   bool
+	}{
+		{"Anonymous", nil, entities.ScopeTasksDelete, true},
+		{"BearerTokenHasEveryScope", &auth.Principal{Subject: "alice"}, entities.ScopeTasksDelete, true},
+		{"KeyWithScope", readOnly, entities.ScopeTasksRead, true},
+		{"KeyWithoutScope", readOnly, entities.ScopeTasksWrite, false},
+		{"KeyWithoutScopes", &auth.Principal{Subject: "alice", Scopes: entities.ApiKeyScopes{}, ApiKeyId: 8}, entities.ScopeTasksRead, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/v1/tasks", nil), httptest.NewRecorder())
+			if tc.principal != nil {
+				c.Set(auth.ContextKeyPrincipal, tc.principal)
+			}
+
+			called := false
+			err := interfaces.RequireScope(tc.scope)(func(c echo.Context) error {
+				called = true
+				return nil
+			})(c)
+
+			assert.Equal(t, tc.allowed, called)
+			if tc.allowed {
+				assert.NoError(t, err)
+				return
+			}
+			var domainErr *domainerrors.Error
+			if assert.ErrorAs(t, err, &domainErr) {
+				assert.Equal(t, domainerrors.KindForbidden, domainErr.Kind)
+				assert.Equal(t, tc.scope, domainErr.Details["required_scope"])
+			}
+		})
+	}
+}
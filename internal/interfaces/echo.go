@@ -2,38 +2,99 @@ package interfaces
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/supachai1998/task_services/docs"
+	"github.com/supachai1998/task_services/internal/auth"
 	"github.com/supachai1998/task_services/internal/configs"
+	apiKeyUsecases "github.com/supachai1998/task_services/internal/domains/apikeys/usecases"
+	workspaceInterfaces "github.com/supachai1998/task_services/internal/domains/workspaces/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
 	echoSwagger "github.com/swaggo/echo-swagger"
 )
 
-func NewEchoInterface(config *configs.ServerConfig) *echo.Echo {
+const (
+	pathSwagger = "/swagger/*"
+	pathHealth  = "/healthz"
+	// pathTaskStream prefixes the long-lived task streams, which take their connection over.
+	pathTaskStream = "/v1/tasks/stream"
+)
+
+// AuthOptions wires the authentication and the authorization of requests.
+type AuthOptions struct {
+	// Verifier verifies bearer tokens; every route but swagger and the health check requires a
+	// bearer token or an API key, unless it is nil.
+	Verifier *auth.Verifier
+	// ApiKeys authenticates the requests with an "Authorization: ApiKey <key>" header.
+	ApiKeys apiKeyUsecases.ApiKeyUsecase
+	// Members holds the workspace roles of users, DefaultRole being the role of users without a row.
+	Members     workspaceInterfaces.MemberRepository
+	DefaultRole entities.WorkspaceRole
+}
+
+// NewEchoInterface creates the Echo server.
+func NewEchoInterface(config *configs.ServerConfig, options AuthOptions) *echo.Echo {
 	e := echo.New()
 	e.Use(
 		middleware.Logger(),
 		middleware.Recover(),
-		middleware.CORS(),
+		middleware.CORSWithConfig(middleware.CORSConfig{
+			// Let browser clients read the task version for If-Match
+			ExposeHeaders: []string{"ETag"},
+		}),
 		middleware.Secure(),
 		middleware.RequestIDWithConfig(middleware.RequestIDConfig{
 			Generator: func() string {
 				return fmt.Sprintf("%s-%d", configs.AppConfig.Server.AppName, time.Now().UnixNano())
 			},
+			// Pass the request ID down to the usecases and the database logs
+			RequestIDHandler: func(c echo.Context, requestID string) {
+				c.SetRequest(c.Request().WithContext(helpers.ContextWithRequestID(c.Request().Context(), requestID)))
+			},
 		}),
 		middleware.GzipWithConfig(middleware.GzipConfig{
+			// Attachment downloads are served as is, so byte ranges apply to the stored content,
+			// and the task streams write to their connection themselves
 			Skipper: func(c echo.Context) bool {
-				return strings.Contains(c.Request().URL.Path, "swagger")
+				path := c.Request().URL.Path
+				return strings.Contains(path, "swagger") || strings.Contains(path, "/attachments/") ||
+					strings.HasPrefix(path, pathTaskStream)
 			},
 		}),
+		AdminKey(config.AdminKey),
+		ApiKey(options.ApiKeys),
 	)
+	if options.Verifier != nil {
+		e.Use(auth.Middleware(options.Verifier, pathSwagger, pathHealth))
+	}
+	e.Use(Workspace(), WorkspaceRole(options.Members, options.DefaultRole))
+	if config.WriteTimeout > 0 {
+		// Cancel the queries of a request once its response can no longer be written; the task
+		// streams take their connection over from the server and are not bounded by its timeout
+		e.Use(middleware.ContextTimeoutWithConfig(middleware.ContextTimeoutConfig{
+			Timeout: time.Duration(config.WriteTimeout) * time.Second,
+			Skipper: func(c echo.Context) bool {
+				path := c.Request().URL.Path
+				return strings.Contains(path, "swagger") || strings.HasPrefix(path, pathTaskStream)
+			},
+			// Timeouts are already domain errors, mapped by HTTPErrorHandler
+			ErrorHandler: func(err error, c echo.Context) error {
+				return err
+			},
+		}))
+	}
 
 	// Initialize custom validator
 	e.Validator = NewCustomValidator()
 
+	// Write every handler error as an application/problem+json body
+	e.HTTPErrorHandler = HTTPErrorHandler
+
 	// Initialize Swagger docs
 	docs.SwaggerInfo.Title = "Swagger Example API"
 	docs.SwaggerInfo.Description = "This is a sample server."
@@ -42,7 +103,10 @@ func NewEchoInterface(config *configs.ServerConfig) *echo.Echo {
 	docs.SwaggerInfo.BasePath = "/"
 	docs.SwaggerInfo.Schemes = []string{"http"}
 
-	e.GET("/swagger/*", echoSwagger.WrapHandler)
+	e.GET(pathSwagger, echoSwagger.WrapHandler)
+	e.GET(pathHealth, func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
 
 	return e
 }
@@ -0,0 +1,89 @@
+package interfaces
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/models"
+)
+
+// MIMEApplicationProblemJSON is the media type of RFC 7807 error bodies.
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// kindStatus maps domain error kinds to HTTP status codes.
+var kindStatus = map[domainerrors.Kind]int{
+	domainerrors.KindNotFound:           http.StatusNotFound,
+	domainerrors.KindConflict:           http.StatusConflict,
+	domainerrors.KindValidation:         http.StatusBadRequest,
+	domainerrors.KindUnauthorized:       http.StatusUnauthorized,
+	domainerrors.KindForbidden:          http.StatusForbidden,
+	domainerrors.KindPreconditionFailed: http.StatusPreconditionFailed,
+	domainerrors.KindFailedDependency:   http.StatusFailedDependency,
+	domainerrors.KindTooLarge:           http.StatusRequestEntityTooLarge,
+	domainerrors.KindUnsupportedMedia:   http.StatusUnsupportedMediaType,
+	domainerrors.KindUnavailable:        http.StatusServiceUnavailable,
+	domainerrors.KindInternal:           http.StatusInternalServerError,
+}
+
+// HTTPErrorHandler writes every error returned by a handler as an RFC 7807 problem.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	problem := NewProblemDetails(err)
+	problem.Instance = c.Request().URL.Path
+	problem.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+	if problem.Status >= http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(problem.Status)
+	} else {
+		c.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+		err = c.JSON(problem.Status, problem)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+// NewProblemDetails converts an error into a problem, without the request members.
+func NewProblemDetails(err error) models.ProblemDetails {
+	var (
+		domainErr *domainerrors.Error
+		httpErr   *echo.HTTPError
+	)
+	switch {
+	case errors.As(err, &domainErr):
+		status, ok := kindStatus[domainErr.Kind]
+		if !ok {
+			status = http.StatusInternalServerError
+		}
+		return models.ProblemDetails{
+			Type:       "about:blank",
+			Title:      http.StatusText(status),
+			Status:     status,
+			Detail:     domainErr.Message,
+			Extensions: domainErr.Details,
+		}
+	case errors.As(err, &httpErr):
+		return models.ProblemDetails{
+			Type:   "about:blank",
+			Title:  http.StatusText(httpErr.Code),
+			Status: httpErr.Code,
+			Detail: fmt.Sprint(httpErr.Message),
+		}
+	default:
+		return models.ProblemDetails{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+			Detail: "internal server error",
+		}
+	}
+}
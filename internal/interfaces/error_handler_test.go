@@ -0,0 +1,79 @@
+package interfaces_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+func TestHTTPErrorHandler(t *testing.T) {
+	e := echo.New()
+
+	cases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedDetail string
+	}{
+		{"NotFound", domainerrors.NotFound("task not found"), http.StatusNotFound, "task not found"},
+		{"Conflict", domainerrors.Conflict("task is done"), http.StatusConflict, "task is done"},
+		{"Validation", domainerrors.Validation("invalid input"), http.StatusBadRequest, "invalid input"},
+		{"Unauthorized", domainerrors.Unauthorized("invalid bearer token"), http.StatusUnauthorized, "invalid bearer token"},
+		{"Forbidden", domainerrors.Forbidden("not allowed"), http.StatusForbidden, "not allowed"},
+		{"PreconditionFailed", domainerrors.PreconditionFailed("task has been modified"), http.StatusPreconditionFailed, "task has been modified"},
+		{"FailedDependency", domainerrors.FailedDependency("batch rolled back"), http.StatusFailedDependency, "batch rolled back"},
+		{"TooLarge", domainerrors.TooLarge("the file is too large"), http.StatusRequestEntityTooLarge, "the file is too large"},
+		{"UnsupportedMedia", domainerrors.UnsupportedMedia("image/svg+xml files are not allowed"), http.StatusUnsupportedMediaType, "image/svg+xml files are not allowed"},
+		{"Unavailable", domainerrors.Unavailable("the query was canceled or timed out").WithCause(context.DeadlineExceeded), http.StatusServiceUnavailable, "the query was canceled or timed out"},
+		{"Internal", domainerrors.Internal(errors.New("pq: connection refused")), http.StatusInternalServerError, "internal server error"},
+		{"UnknownError", errors.New("boom"), http.StatusInternalServerError, "internal server error"},
+		{"EchoHTTPError", echo.NewHTTPError(http.StatusMethodNotAllowed, "Method Not Allowed"), http.StatusMethodNotAllowed, "Method Not Allowed"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Response().Header().Set(echo.HeaderXRequestID, "request-1")
+
+			interfaces.HTTPErrorHandler(tc.err, c)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, interfaces.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+
+			var body map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, "about:blank", body["type"])
+			assert.Equal(t, http.StatusText(tc.expectedStatus), body["title"])
+			assert.Equal(t, float64(tc.expectedStatus), body["status"])
+			assert.Equal(t, tc.expectedDetail, body["detail"])
+			assert.Equal(t, "/v1/tasks/1", body["instance"])
+			assert.Equal(t, "request-1", body["request_id"])
+		})
+	}
+
+	t.Run("Extensions", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPatch, "/v1/tasks/1/status", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := domainerrors.Conflict("cannot change task status from DONE to TO_DO").
+			WithDetail("current_status", "DONE").
+			WithDetail("allowed_statuses", []string{})
+		interfaces.HTTPErrorHandler(err, c)
+
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "DONE", body["current_status"])
+		assert.Equal(t, []any{}, body["allowed_statuses"])
+	})
+}
@@ -0,0 +1,68 @@
+package interfaces
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	workspaceInterfaces "github.com/supachai1998/task_services/internal/domains/workspaces/interfaces"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// ContextKeyWorkspaceRole holds the entities.WorkspaceRole of the caller in the Echo context.
+const ContextKeyWorkspaceRole = "workspace_role"
+
+// WorkspaceRole resolves the role of the caller in the workspace of the request, set by Workspace.
+// Admin key holders and tokens with the admin role are workspace admins; other token holders have
+// their role of the workspace_members table, or defaultRole without a row. Anonymous requests,
+// which only exist when authentication is disabled, get no role and are not restricted by roles.
+func WorkspaceRole(members workspaceInterfaces.MemberRepository, defaultRole entities.WorkspaceRole) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal := auth.PrincipalFrom(c)
+			switch {
+			case IsAdmin(c), principal != nil && principal.HasRole(auth.RoleAdmin):
+				c.Set(ContextKeyWorkspaceRole, entities.WorkspaceRoleAdmin)
+			case principal != nil:
+				ctx := c.Request().Context()
+				workspaceID, _ := helpers.WorkspaceIDFromContext(ctx)
+				role, err := members.GetRole(ctx, workspaceID, principal.Subject)
+				if domainerrors.IsKind(err, domainerrors.KindNotFound) {
+					role, err = defaultRole, nil
+				}
+				if err != nil {
+					return err
+				}
+				c.Set(ContextKeyWorkspaceRole, role)
+			}
+			return next(c)
+		}
+	}
+}
+
+// RoleFrom returns the workspace role of the caller, or an empty role for anonymous requests.
+func RoleFrom(c echo.Context) entities.WorkspaceRole {
+	role, _ := c.Get(ContextKeyWorkspaceRole).(entities.WorkspaceRole)
+	return role
+}
+
+// RequireRole rejects the callers whose workspace role does not include required.
+// Anonymous requests pass; a token holder without a resolved role is rejected.
+func RequireRole(required entities.WorkspaceRole) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			role := RoleFrom(c)
+			if role == "" && auth.PrincipalFrom(c) == nil && !IsAdmin(c) {
+				return next(c)
+			}
+			if !role.Includes(required) {
+				return domainerrors.Forbidden(fmt.Sprintf("the %s role is required for this action", required)).
+					WithDetail("role", role).
+					WithDetail("required_role", required)
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package interfaces_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+	mocks "github.com/supachai1998/task_services/internal/mocks/workspaces/interfaces"
+)
+
+func TestWorkspaceRole(t *testing.T) {
+	e := echo.New()
+	alice := &auth.Principal{Subject: "alice"}
+
+	cases := []struct {
+		name         string
+		principal    *auth.Principal
+		admin        bool
+		storedRole   entities.WorkspaceRole
+		storedErr    error
+		expectedRole entities.WorkspaceRole
+		expectedKind domainerrors.Kind
+	}{
+		{"Anonymous", nil, false, "", nil, "", ""},
+		{"AdminKey", nil, true, "", nil, entities.WorkspaceRoleAdmin, ""},
+		{"AdminToken", &auth.Principal{Subject: "dave", Roles: []string{auth.RoleAdmin}}, false, "", nil, entities.WorkspaceRoleAdmin, ""},
+		{"StoredRole", alice, false, entities.WorkspaceRoleViewer, nil, entities.WorkspaceRoleViewer, ""},
+		{"DefaultRole", alice, false, "", domainerrors.NotFound("workspace member not found"), entities.WorkspaceRoleMember, ""},
+		{"StoreError", alice, false, "", domainerrors.Internal(errors.New("connection refused")), "", domainerrors.KindInternal},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			members := mocks.NewMockMemberRepository(ctrl)
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks", nil)
+			req = req.WithContext(helpers.ContextWithWorkspaceID(req.Context(), 2))
+			c := e.NewContext(req, httptest.NewRecorder())
+			if tc.principal != nil {
+				c.Set(auth.ContextKeyPrincipal, tc.principal)
+			}
+			if tc.admin {
+				c.Set(interfaces.ContextKeyAdmin, true)
+			}
+			if tc.storedRole != "" || tc.storedErr != nil {
+				members.EXPECT().GetRole(gomock.Any(), uint(2), "alice").Return(tc.storedRole, tc.storedErr)
+			}
+
+			var role entities.WorkspaceRole
+			err := interfaces.WorkspaceRole(members, entities.WorkspaceRoleMember)(func(c echo.Context) error {
+				role = interfaces.RoleFrom(c)
+				return nil
+			})(c)
+
+			if tc.expectedKind != "" {
+				assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRole, role)
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	e := echo.New()
+	alice := &auth.Principal{Subject: "alice"}
+
+	cases := []struct {
+		name      string
+		principal *auth.Principal
+		role      entities.WorkspaceRole
+		required  entities.WorkspaceRole
+		allowed   bool
+	}{
+		{"Anonymous", nil, "", entities.WorkspaceRoleAdmin, true},
+		{"ViewerReads", alice, entities.WorkspaceRoleViewer, entities.WorkspaceRoleViewer, true},
+		{"ViewerCannotWrite", alice, entities.WorkspaceRoleViewer, entities.WorkspaceRoleMember, false},
+		{"MemberWrites", alice, entities.WorkspaceRoleMember, entities.WorkspaceRoleMember, true},
+		{"MemberCannotAdmin", alice, entities.WorkspaceRoleMember, entities.WorkspaceRoleAdmin, false},
+		{"AdminDoesAnything", alice, entities.WorkspaceRoleAdmin, entities.WorkspaceRoleAdmin, true},
+		{"TokenWithoutRole", alice, "", entities.WorkspaceRoleViewer, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := e.NewContext(httptest.NewRequest(http.MethodPost, "/v1/tasks", nil), httptest.NewRecorder())
+			if tc.principal != nil {
+				c.Set(auth.ContextKeyPrincipal, tc.principal)
+			}
+			if tc.role != "" {
+				c.Set(interfaces.ContextKeyWorkspaceRole, tc.role)
+			}
+
+			called := false
+			err := interfaces.RequireRole(tc.required)(func(c echo.Context) error {
+				called = true
+				return nil
+			})(c)
+
+			assert.Equal(t, tc.allowed, called)
+			if tc.allowed {
+				assert.NoError(t, err)
+				return
+			}
+			var domainErr *domainerrors.Error
+			if assert.ErrorAs(t, err, &domainErr) {
+				assert.Equal(t, domainerrors.KindForbidden, domainErr.Kind)
+				assert.Equal(t, tc.required, domainErr.Details["required_role"])
+			}
+		})
+	}
+}
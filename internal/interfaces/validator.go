@@ -1,12 +1,13 @@
 package interfaces
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/stoewer/go-strcase"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
 )
 
 type CustomValidator struct {
@@ -15,6 +16,10 @@ type CustomValidator struct {
 
 func NewCustomValidator() *CustomValidator {
 	v := validator.New()
+	// task_status accepts the well-formed names of workflow statuses; the workflow decides which exist.
+	_ = v.RegisterValidation("task_status", func(fl validator.FieldLevel) bool {
+		return entities.TaskStatus(fl.Field().String()).Valid()
+	})
 
 	return &CustomValidator{
 		Validator: v,
@@ -50,5 +55,5 @@ func translateValidationErrors(validationErrs validator.ValidationErrors) error
 		messages = append(messages, message)
 	}
 
-	return errors.New(strings.Join(messages, ", "))
+	return domainerrors.Validation(strings.Join(messages, ", "))
 }
@@ -0,0 +1,52 @@
+package interfaces
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/entities"
+	"github.com/supachai1998/task_services/internal/helpers"
+)
+
+// HeaderWorkspaceID names the workspace a request works in.
+const HeaderWorkspaceID = "X-Workspace-ID"
+
+var (
+	errInvalidWorkspaceID = domainerrors.Validation("invalid " + HeaderWorkspaceID + " header")
+	errNotWorkspaceMember = domainerrors.Forbidden("not a member of the workspace")
+)
+
+// Workspace resolves the workspace of every request and puts it in the request context, where the
+// repositories read it. The workspace is the X-Workspace-ID header, else the first workspace of
+// the token, else the default workspace. Token holders can only use the workspaces of their token,
+// or the default workspace when it lists none; admin key holders and anonymous requests, which only
+// exist when authentication is disabled, can use any workspace.
+func Workspace() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal := auth.PrincipalFrom(c)
+			members := []uint{entities.DefaultWorkspaceId}
+			if principal != nil && len(principal.Workspaces) > 0 {
+				members = principal.Workspaces
+			}
+
+			workspaceID := members[0]
+			if header := c.Request().Header.Get(HeaderWorkspaceID); header != "" {
+				id, err := strconv.ParseUint(header, 10, 0)
+				if err != nil || id == 0 {
+					return errInvalidWorkspaceID
+				}
+				workspaceID = uint(id)
+			}
+			if principal != nil && !IsAdmin(c) && !lo.Contains(members, workspaceID) {
+				return errNotWorkspaceMember
+			}
+
+			c.SetRequest(c.Request().WithContext(helpers.ContextWithWorkspaceID(c.Request().Context(), workspaceID)))
+			return next(c)
+		}
+	}
+}
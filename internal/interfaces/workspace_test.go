@@ -0,0 +1,73 @@
+package interfaces_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/supachai1998/task_services/internal/auth"
+	"github.com/supachai1998/task_services/internal/domainerrors"
+	"github.com/supachai1998/task_services/internal/helpers"
+	"github.com/supachai1998/task_services/internal/interfaces"
+)
+
+func TestWorkspace(t *testing.T) {
+	e := echo.New()
+	member := &auth.Principal{Subject: "alice", Workspaces: []uint{2, 3}}
+	noWorkspaces := &auth.Principal{Subject: "bob"}
+
+	cases := []struct {
+		name              string
+		principal         *auth.Principal
+		admin             bool
+		header            string
+		expectedWorkspace uint
+		expectedKind      domainerrors.Kind
+	}{
+		{"AnonymousDefault", nil, false, "", 1, ""},
+		{"AnonymousHeader", nil, false, "7", 7, ""},
+		{"TokenFirstWorkspace", member, false, "", 2, ""},
+		{"TokenMemberHeader", member, false, "3", 3, ""},
+		{"TokenNotMember", member, false, "1", 0, domainerrors.KindForbidden},
+		{"TokenWithoutWorkspaces", noWorkspaces, false, "", 1, ""},
+		{"TokenWithoutWorkspacesOtherWorkspace", noWorkspaces, false, "2", 0, domainerrors.KindForbidden},
+		{"AdminKeyAnyWorkspace", member, true, "9", 9, ""},
+		{"AdminRoleIsNotEnough", &auth.Principal{Subject: "dave", Roles: []string{auth.RoleAdmin}}, false, "9", 0, domainerrors.KindForbidden},
+		{"InvalidHeader", nil, false, "abc", 0, domainerrors.KindValidation},
+		{"ZeroHeader", nil, false, "0", 0, domainerrors.KindValidation},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks", nil)
+			if tc.header != "" {
+				req.Header.Set(interfaces.HeaderWorkspaceID, tc.header)
+			}
+			c := e.NewContext(req, httptest.NewRecorder())
+			if tc.principal != nil {
+				c.Set(auth.ContextKeyPrincipal, tc.principal)
+			}
+			if tc.admin {
+				c.Set(interfaces.ContextKeyAdmin, true)
+			}
+
+			var workspaceID uint
+			var ok bool
+			err := interfaces.Workspace()(func(c echo.Context) error {
+				workspaceID, ok = helpers.WorkspaceIDFromContext(c.Request().Context())
+				return nil
+			})(c)
+
+			if tc.expectedKind != "" {
+				assert.True(t, domainerrors.IsKind(err, tc.expectedKind))
+				assert.False(t, ok)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expectedWorkspace, workspaceID)
+		})
+	}
+}
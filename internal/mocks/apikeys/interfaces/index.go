@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/apikeys/interfaces/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockApiKeyRepository is a mock of ApiKeyRepository interface.
+type MockApiKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiKeyRepositoryMockRecorder
+}
+
+// MockApiKeyRepositoryMockRecorder is the mock recorder for MockApiKeyRepository.
+type MockApiKeyRepositoryMockRecorder struct {
+	mock *MockApiKeyRepository
+}
+
+// NewMockApiKeyRepository creates a new mock instance.
+func NewMockApiKeyRepository(ctrl *gomock.Controller) *MockApiKeyRepository {
+	mock := &MockApiKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockApiKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApiKeyRepository) EXPECT() *MockApiKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockApiKeyRepository) Create(ctx context.Context, key *entities.ApiKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockApiKeyRepositoryMockRecorder) Create(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockApiKeyRepository)(nil).Create), ctx, key)
+}
+
+// GetByHash mocks base method.
+func (m *MockApiKeyRepository) GetByHash(ctx context.Context, hash string) (*entities.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHash", ctx, hash)
+	ret0, _ := ret[0].(*entities.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHash indicates an expected call of GetByHash.
+func (mr *MockApiKeyRepositoryMockRecorder) GetByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHash", reflect.TypeOf((*MockApiKeyRepository)(nil).GetByHash), ctx, hash)
+}
+
+// GetByID mocks base method.
+func (m *MockApiKeyRepository) GetByID(ctx context.Context, id uint) (*entities.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockApiKeyRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockApiKeyRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockApiKeyRepository) List(ctx context.Context, createdBy string) ([]entities.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, createdBy)
+	ret0, _ := ret[0].([]entities.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockApiKeyRepositoryMockRecorder) List(ctx, createdBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockApiKeyRepository)(nil).List), ctx, createdBy)
+}
+
+// Revoke mocks base method.
+func (m *MockApiKeyRepository) Revoke(ctx context.Context, id uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockApiKeyRepositoryMockRecorder) Revoke(ctx, id, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockApiKeyRepository)(nil).Revoke), ctx, id, at)
+}
+
+// TouchLastUsed mocks base method.
+func (m *MockApiKeyRepository) TouchLastUsed(ctx context.Context, id uint, at, olderThan time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchLastUsed", ctx, id, at, olderThan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchLastUsed indicates an expected call of TouchLastUsed.
+func (mr *MockApiKeyRepositoryMockRecorder) TouchLastUsed(ctx, id, at, olderThan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchLastUsed", reflect.TypeOf((*MockApiKeyRepository)(nil).TouchLastUsed), ctx, id, at, olderThan)
+}
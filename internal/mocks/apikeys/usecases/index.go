@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/apikeys/usecases/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	auth "github.com/supachai1998/task_services/internal/auth"
+	models "github.com/supachai1998/task_services/internal/domains/apikeys/models"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockApiKeyUsecase is a mock of ApiKeyUsecase interface.
+type MockApiKeyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiKeyUsecaseMockRecorder
+}
+
+// MockApiKeyUsecaseMockRecorder is the mock recorder for MockApiKeyUsecase.
+type MockApiKeyUsecaseMockRecorder struct {
+	mock *MockApiKeyUsecase
+}
+
+// NewMockApiKeyUsecase creates a new mock instance.
+func NewMockApiKeyUsecase(ctrl *gomock.Controller) *MockApiKeyUsecase {
+	mock := &MockApiKeyUsecase{ctrl: ctrl}
+	mock.recorder = &MockApiKeyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApiKeyUsecase) EXPECT() *MockApiKeyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockApiKeyUsecase) Authenticate(ctx context.Context, rawKey string) (*auth.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, rawKey)
+	ret0, _ := ret[0].(*auth.Principal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockApiKeyUsecaseMockRecorder) Authenticate(ctx, rawKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockApiKeyUsecase)(nil).Authenticate), ctx, rawKey)
+}
+
+// CreateApiKey mocks base method.
+func (m *MockApiKeyUsecase) CreateApiKey(ctx context.Context, actor entities.Actor, key *entities.ApiKey) (*models.CreatedApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApiKey", ctx, actor, key)
+	ret0, _ := ret[0].(*models.CreatedApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockApiKeyUsecaseMockRecorder) CreateApiKey(ctx, actor, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockApiKeyUsecase)(nil).CreateApiKey), ctx, actor, key)
+}
+
+// ListApiKeys mocks base method.
+func (m *MockApiKeyUsecase) ListApiKeys(ctx context.Context, actor entities.Actor) ([]entities.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApiKeys", ctx, actor)
+	ret0, _ := ret[0].([]entities.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApiKeys indicates an expected call of ListApiKeys.
+func (mr *MockApiKeyUsecaseMockRecorder) ListApiKeys(ctx, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApiKeys", reflect.TypeOf((*MockApiKeyUsecase)(nil).ListApiKeys), ctx, actor)
+}
+
+// RevokeApiKey mocks base method.
+func (m *MockApiKeyUsecase) RevokeApiKey(ctx context.Context, actor entities.Actor, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeApiKey", ctx, actor, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeApiKey indicates an expected call of RevokeApiKey.
+func (mr *MockApiKeyUsecaseMockRecorder) RevokeApiKey(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKey", reflect.TypeOf((*MockApiKeyUsecase)(nil).RevokeApiKey), ctx, actor, id)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/attachments/usecases/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockAttachmentUsecase is a mock of AttachmentUsecase interface.
+type MockAttachmentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentUsecaseMockRecorder
+}
+
+// MockAttachmentUsecaseMockRecorder is the mock recorder for MockAttachmentUsecase.
+type MockAttachmentUsecaseMockRecorder struct {
+	mock *MockAttachmentUsecase
+}
+
+// NewMockAttachmentUsecase creates a new mock instance.
+func NewMockAttachmentUsecase(ctrl *gomock.Controller) *MockAttachmentUsecase {
+	mock := &MockAttachmentUsecase{ctrl: ctrl}
+	mock.recorder = &MockAttachmentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentUsecase) EXPECT() *MockAttachmentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ListAttachments mocks base method.
+func (m *MockAttachmentUsecase) ListAttachments(ctx context.Context, actor entities.Actor, taskID uint) ([]entities.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, actor, taskID)
+	ret0, _ := ret[0].([]entities.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockAttachmentUsecaseMockRecorder) ListAttachments(ctx, actor, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockAttachmentUsecase)(nil).ListAttachments), ctx, actor, taskID)
+}
+
+// OpenAttachment mocks base method.
+func (m *MockAttachmentUsecase) OpenAttachment(ctx context.Context, actor entities.Actor, taskID, id uint) (*entities.Attachment, io.ReadSeekCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAttachment", ctx, actor, taskID, id)
+	ret0, _ := ret[0].(*entities.Attachment)
+	ret1, _ := ret[1].(io.ReadSeekCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenAttachment indicates an expected call of OpenAttachment.
+func (mr *MockAttachmentUsecaseMockRecorder) OpenAttachment(ctx, actor, taskID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAttachment", reflect.TypeOf((*MockAttachmentUsecase)(nil).OpenAttachment), ctx, actor, taskID, id)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockAttachmentUsecase) UploadAttachment(ctx context.Context, actor entities.Actor, attachment *entities.Attachment, content io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, actor, attachment, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockAttachmentUsecaseMockRecorder) UploadAttachment(ctx, actor, attachment, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockAttachmentUsecase)(nil).UploadAttachment), ctx, actor, attachment, content)
+}
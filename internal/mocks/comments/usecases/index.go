@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/comments/usecases/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/supachai1998/task_services/internal/domains/comments/models"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockCommentUsecase is a mock of CommentUsecase interface.
+type MockCommentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentUsecaseMockRecorder
+}
+
+// MockCommentUsecaseMockRecorder is the mock recorder for MockCommentUsecase.
+type MockCommentUsecaseMockRecorder struct {
+	mock *MockCommentUsecase
+}
+
+// NewMockCommentUsecase creates a new mock instance.
+func NewMockCommentUsecase(ctrl *gomock.Controller) *MockCommentUsecase {
+	mock := &MockCommentUsecase{ctrl: ctrl}
+	mock.recorder = &MockCommentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentUsecase) EXPECT() *MockCommentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateComment mocks base method.
+func (m *MockCommentUsecase) CreateComment(ctx context.Context, actor entities.Actor, comment *entities.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, actor, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockCommentUsecaseMockRecorder) CreateComment(ctx, actor, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentUsecase)(nil).CreateComment), ctx, actor, comment)
+}
+
+// DeleteComment mocks base method.
+func (m *MockCommentUsecase) DeleteComment(ctx context.Context, actor entities.Actor, taskID, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, actor, taskID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockCommentUsecaseMockRecorder) DeleteComment(ctx, actor, taskID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentUsecase)(nil).DeleteComment), ctx, actor, taskID, id)
+}
+
+// ListComments mocks base method.
+func (m *MockCommentUsecase) ListComments(ctx context.Context, actor entities.Actor, taskID uint, query *models.ListCommentsQuery) ([]entities.Comment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", ctx, actor, taskID, query)
+	ret0, _ := ret[0].([]entities.Comment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockCommentUsecaseMockRecorder) ListComments(ctx, actor, taskID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockCommentUsecase)(nil).ListComments), ctx, actor, taskID, query)
+}
+
+// UpdateComment mocks base method.
+func (m *MockCommentUsecase) UpdateComment(ctx context.Context, actor entities.Actor, taskID, id uint, body string) (*entities.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, actor, taskID, id, body)
+	ret0, _ := ret[0].(*entities.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockCommentUsecaseMockRecorder) UpdateComment(ctx, actor, taskID, id, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommentUsecase)(nil).UpdateComment), ctx, actor, taskID, id, body)
+}
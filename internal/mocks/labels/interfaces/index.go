@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/labels/interfaces/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockLabelRepository is a mock of LabelRepository interface.
+type MockLabelRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelRepositoryMockRecorder
+}
+
+// MockLabelRepositoryMockRecorder is the mock recorder for MockLabelRepository.
+type MockLabelRepositoryMockRecorder struct {
+	mock *MockLabelRepository
+}
+
+// NewMockLabelRepository creates a new mock instance.
+func NewMockLabelRepository(ctrl *gomock.Controller) *MockLabelRepository {
+	mock := &MockLabelRepository{ctrl: ctrl}
+	mock.recorder = &MockLabelRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelRepository) EXPECT() *MockLabelRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLabelRepository) Create(ctx context.Context, label *entities.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLabelRepositoryMockRecorder) Create(ctx, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLabelRepository)(nil).Create), ctx, label)
+}
+
+// Delete mocks base method.
+func (m *MockLabelRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLabelRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLabelRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockLabelRepository) GetByID(ctx context.Context, id uint) (*entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLabelRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLabelRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockLabelRepository) List(ctx context.Context) ([]entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLabelRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLabelRepository)(nil).List), ctx)
+}
+
+// Update mocks base method.
+func (m *MockLabelRepository) Update(ctx context.Context, label *entities.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLabelRepositoryMockRecorder) Update(ctx, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLabelRepository)(nil).Update), ctx, label)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/labels/usecases/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockLabelUsecase is a mock of LabelUsecase interface.
+type MockLabelUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelUsecaseMockRecorder
+}
+
+// MockLabelUsecaseMockRecorder is the mock recorder for MockLabelUsecase.
+type MockLabelUsecaseMockRecorder struct {
+	mock *MockLabelUsecase
+}
+
+// NewMockLabelUsecase creates a new mock instance.
+func NewMockLabelUsecase(ctrl *gomock.Controller) *MockLabelUsecase {
+	mock := &MockLabelUsecase{ctrl: ctrl}
+	mock.recorder = &MockLabelUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelUsecase) EXPECT() *MockLabelUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateLabel mocks base method.
+func (m *MockLabelUsecase) CreateLabel(ctx context.Context, actor entities.Actor, label *entities.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLabel", ctx, actor, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLabel indicates an expected call of CreateLabel.
+func (mr *MockLabelUsecaseMockRecorder) CreateLabel(ctx, actor, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLabel", reflect.TypeOf((*MockLabelUsecase)(nil).CreateLabel), ctx, actor, label)
+}
+
+// DeleteLabel mocks base method.
+func (m *MockLabelUsecase) DeleteLabel(ctx context.Context, actor entities.Actor, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLabel", ctx, actor, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLabel indicates an expected call of DeleteLabel.
+func (mr *MockLabelUsecaseMockRecorder) DeleteLabel(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLabel", reflect.TypeOf((*MockLabelUsecase)(nil).DeleteLabel), ctx, actor, id)
+}
+
+// ListLabels mocks base method.
+func (m *MockLabelUsecase) ListLabels(ctx context.Context) ([]entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLabels", ctx)
+	ret0, _ := ret[0].([]entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLabels indicates an expected call of ListLabels.
+func (mr *MockLabelUsecaseMockRecorder) ListLabels(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLabels", reflect.TypeOf((*MockLabelUsecase)(nil).ListLabels), ctx)
+}
+
+// UpdateLabel mocks base method.
+func (m *MockLabelUsecase) UpdateLabel(ctx context.Context, actor entities.Actor, label *entities.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabel", ctx, actor, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLabel indicates an expected call of UpdateLabel.
+func (mr *MockLabelUsecaseMockRecorder) UpdateLabel(ctx, actor, label interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabel", reflect.TypeOf((*MockLabelUsecase)(nil).UpdateLabel), ctx, actor, label)
+}
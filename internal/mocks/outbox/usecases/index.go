@@ -0,0 +1,62 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/outbox/usecases/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRelay is a mock of Relay interface.
+type MockRelay struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayMockRecorder
+}
+
+// MockRelayMockRecorder is the mock recorder for MockRelay.
+type MockRelayMockRecorder struct {
+	mock *MockRelay
+}
+
+// NewMockRelay creates a new mock instance.
+func NewMockRelay(ctrl *gomock.Controller) *MockRelay {
+	mock := &MockRelay{ctrl: ctrl}
+	mock.recorder = &MockRelayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelay) EXPECT() *MockRelayMockRecorder {
+	return m.recorder
+}
+
+// RelayDue mocks base method.
+func (m *MockRelay) RelayDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayDue indicates an expected call of RelayDue.
+func (mr *MockRelayMockRecorder) RelayDue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayDue", reflect.TypeOf((*MockRelay)(nil).RelayDue), ctx)
+}
+
+// Run mocks base method.
+func (m *MockRelay) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockRelayMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockRelay)(nil).Run), ctx)
+}
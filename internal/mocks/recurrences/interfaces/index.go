@@ -0,0 +1,175 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/recurrences/interfaces/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockRecurrenceRepository is a mock of RecurrenceRepository interface.
+type MockRecurrenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurrenceRepositoryMockRecorder
+}
+
+// MockRecurrenceRepositoryMockRecorder is the mock recorder for MockRecurrenceRepository.
+type MockRecurrenceRepositoryMockRecorder struct {
+	mock *MockRecurrenceRepository
+}
+
+// NewMockRecurrenceRepository creates a new mock instance.
+func NewMockRecurrenceRepository(ctrl *gomock.Controller) *MockRecurrenceRepository {
+	mock := &MockRecurrenceRepository{ctrl: ctrl}
+	mock.recorder = &MockRecurrenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurrenceRepository) EXPECT() *MockRecurrenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Advance mocks base method.
+func (m *MockRecurrenceRepository) Advance(ctx context.Context, recurrence *entities.Recurrence, claimed time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Advance", ctx, recurrence, claimed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Advance indicates an expected call of Advance.
+func (mr *MockRecurrenceRepositoryMockRecorder) Advance(ctx, recurrence, claimed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Advance", reflect.TypeOf((*MockRecurrenceRepository)(nil).Advance), ctx, recurrence, claimed)
+}
+
+// ClaimDue mocks base method.
+func (m *MockRecurrenceRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entities.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]entities.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockRecurrenceRepositoryMockRecorder) ClaimDue(ctx, now, lease, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockRecurrenceRepository)(nil).ClaimDue), ctx, now, lease, limit)
+}
+
+// Create mocks base method.
+func (m *MockRecurrenceRepository) Create(ctx context.Context, recurrence *entities.Recurrence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, recurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecurrenceRepositoryMockRecorder) Create(ctx, recurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecurrenceRepository)(nil).Create), ctx, recurrence)
+}
+
+// Delete mocks base method.
+func (m *MockRecurrenceRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecurrenceRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurrenceRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockRecurrenceRepository) GetByID(ctx context.Context, id uint) (*entities.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRecurrenceRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRecurrenceRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRecurrenceRepository) List(ctx context.Context) ([]entities.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]entities.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRecurrenceRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRecurrenceRepository)(nil).List), ctx)
+}
+
+// Update mocks base method.
+func (m *MockRecurrenceRepository) Update(ctx context.Context, recurrence *entities.Recurrence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, recurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRecurrenceRepositoryMockRecorder) Update(ctx, recurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRecurrenceRepository)(nil).Update), ctx, recurrence)
+}
+
+// MockTaskCreator is a mock of TaskCreator interface.
+type MockTaskCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskCreatorMockRecorder
+}
+
+// MockTaskCreatorMockRecorder is the mock recorder for MockTaskCreator.
+type MockTaskCreatorMockRecorder struct {
+	mock *MockTaskCreator
+}
+
+// NewMockTaskCreator creates a new mock instance.
+func NewMockTaskCreator(ctrl *gomock.Controller) *MockTaskCreator {
+	mock := &MockTaskCreator{ctrl: ctrl}
+	mock.recorder = &MockTaskCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskCreator) EXPECT() *MockTaskCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateTask mocks base method.
+func (m *MockTaskCreator) CreateTask(ctx context.Context, actor entities.Actor, task *entities.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", ctx, actor, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockTaskCreatorMockRecorder) CreateTask(ctx, actor, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockTaskCreator)(nil).CreateTask), ctx, actor, task)
+}
@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/recurrences/usecases/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/supachai1998/task_services/internal/domains/recurrences/models"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockRecurrenceUsecase is a mock of RecurrenceUsecase interface.
+type MockRecurrenceUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurrenceUsecaseMockRecorder
+}
+
+// MockRecurrenceUsecaseMockRecorder is the mock recorder for MockRecurrenceUsecase.
+type MockRecurrenceUsecaseMockRecorder struct {
+	mock *MockRecurrenceUsecase
+}
+
+// NewMockRecurrenceUsecase creates a new mock instance.
+func NewMockRecurrenceUsecase(ctrl *gomock.Controller) *MockRecurrenceUsecase {
+	mock := &MockRecurrenceUsecase{ctrl: ctrl}
+	mock.recorder = &MockRecurrenceUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurrenceUsecase) EXPECT() *MockRecurrenceUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateRecurrence mocks base method.
+func (m *MockRecurrenceUsecase) CreateRecurrence(ctx context.Context, actor entities.Actor, recurrence *entities.Recurrence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurrence", ctx, actor, recurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecurrence indicates an expected call of CreateRecurrence.
+func (mr *MockRecurrenceUsecaseMockRecorder) CreateRecurrence(ctx, actor, recurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurrence", reflect.TypeOf((*MockRecurrenceUsecase)(nil).CreateRecurrence), ctx, actor, recurrence)
+}
+
+// DeleteRecurrence mocks base method.
+func (m *MockRecurrenceUsecase) DeleteRecurrence(ctx context.Context, actor entities.Actor, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecurrence", ctx, actor, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecurrence indicates an expected call of DeleteRecurrence.
+func (mr *MockRecurrenceUsecaseMockRecorder) DeleteRecurrence(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecurrence", reflect.TypeOf((*MockRecurrenceUsecase)(nil).DeleteRecurrence), ctx, actor, id)
+}
+
+// GetRecurrence mocks base method.
+func (m *MockRecurrenceUsecase) GetRecurrence(ctx context.Context, id uint) (*entities.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurrence", ctx, id)
+	ret0, _ := ret[0].(*entities.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurrence indicates an expected call of GetRecurrence.
+func (mr *MockRecurrenceUsecaseMockRecorder) GetRecurrence(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurrence", reflect.TypeOf((*MockRecurrenceUsecase)(nil).GetRecurrence), ctx, id)
+}
+
+// ListOccurrences mocks base method.
+func (m *MockRecurrenceUsecase) ListOccurrences(ctx context.Context, id uint, count int) (*models.Occurrences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOccurrences", ctx, id, count)
+	ret0, _ := ret[0].(*models.Occurrences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOccurrences indicates an expected call of ListOccurrences.
+func (mr *MockRecurrenceUsecaseMockRecorder) ListOccurrences(ctx, id, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOccurrences", reflect.TypeOf((*MockRecurrenceUsecase)(nil).ListOccurrences), ctx, id, count)
+}
+
+// ListRecurrences mocks base method.
+func (m *MockRecurrenceUsecase) ListRecurrences(ctx context.Context) ([]entities.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecurrences", ctx)
+	ret0, _ := ret[0].([]entities.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecurrences indicates an expected call of ListRecurrences.
+func (mr *MockRecurrenceUsecaseMockRecorder) ListRecurrences(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecurrences", reflect.TypeOf((*MockRecurrenceUsecase)(nil).ListRecurrences), ctx)
+}
+
+// MaterializeDue mocks base method.
+func (m *MockRecurrenceUsecase) MaterializeDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaterializeDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaterializeDue indicates an expected call of MaterializeDue.
+func (mr *MockRecurrenceUsecaseMockRecorder) MaterializeDue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaterializeDue", reflect.TypeOf((*MockRecurrenceUsecase)(nil).MaterializeDue), ctx)
+}
+
+// PreviewOccurrences mocks base method.
+func (m *MockRecurrenceUsecase) PreviewOccurrences(ctx context.Context, schedule *models.ScheduleRequest, count int) (*models.Occurrences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewOccurrences", ctx, schedule, count)
+	ret0, _ := ret[0].(*models.Occurrences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewOccurrences indicates an expected call of PreviewOccurrences.
+func (mr *MockRecurrenceUsecaseMockRecorder) PreviewOccurrences(ctx, schedule, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewOccurrences", reflect.TypeOf((*MockRecurrenceUsecase)(nil).PreviewOccurrences), ctx, schedule, count)
+}
+
+// RunScheduler mocks base method.
+func (m *MockRecurrenceUsecase) RunScheduler(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunScheduler", ctx)
+}
+
+// RunScheduler indicates an expected call of RunScheduler.
+func (mr *MockRecurrenceUsecaseMockRecorder) RunScheduler(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunScheduler", reflect.TypeOf((*MockRecurrenceUsecase)(nil).RunScheduler), ctx)
+}
+
+// UpdateRecurrence mocks base method.
+func (m *MockRecurrenceUsecase) UpdateRecurrence(ctx context.Context, actor entities.Actor, recurrence *entities.Recurrence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurrence", ctx, actor, recurrence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecurrence indicates an expected call of UpdateRecurrence.
+func (mr *MockRecurrenceUsecaseMockRecorder) UpdateRecurrence(ctx, actor, recurrence interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurrence", reflect.TypeOf((*MockRecurrenceUsecase)(nil).UpdateRecurrence), ctx, actor, recurrence)
+}
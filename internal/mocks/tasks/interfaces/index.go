@@ -5,9 +5,12 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	interfaces "github.com/supachai1998/task_services/internal/domains/tasks/interfaces"
+	models "github.com/supachai1998/task_services/internal/domains/tasks/models"
 	entities "github.com/supachai1998/task_services/internal/entities"
 )
 
@@ -34,74 +37,522 @@ func (m *MockTaskRepository) EXPECT() *MockTaskRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ChildProgress mocks base method.
+func (m *MockTaskRepository) ChildProgress(ctx context.Context, ids []uint) (map[uint]entities.TaskProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChildProgress", ctx, ids)
+	ret0, _ := ret[0].(map[uint]entities.TaskProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChildProgress indicates an expected call of ChildProgress.
+func (mr *MockTaskRepositoryMockRecorder) ChildProgress(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChildProgress", reflect.TypeOf((*MockTaskRepository)(nil).ChildProgress), ctx, ids)
+}
+
+// CountComments mocks base method.
+func (m *MockTaskRepository) CountComments(ctx context.Context, ids []uint) (map[uint]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountComments", ctx, ids)
+	ret0, _ := ret[0].(map[uint]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountComments indicates an expected call of CountComments.
+func (mr *MockTaskRepositoryMockRecorder) CountComments(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountComments", reflect.TypeOf((*MockTaskRepository)(nil).CountComments), ctx, ids)
+}
+
 // Create mocks base method.
-func (m *MockTaskRepository) Create(task *entities.Task) error {
+func (m *MockTaskRepository) Create(ctx context.Context, task *entities.Task) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", task)
+	ret := m.ctrl.Call(m, "Create", ctx, task)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTaskRepositoryMockRecorder) Create(task interface{}) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) Create(ctx, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskRepository)(nil).Create), task)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskRepository)(nil).Create), ctx, task)
+}
+
+// CreateBatch mocks base method.
+func (m *MockTaskRepository) CreateBatch(ctx context.Context, tasks []*entities.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, tasks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockTaskRepositoryMockRecorder) CreateBatch(ctx, tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockTaskRepository)(nil).CreateBatch), ctx, tasks)
+}
+
+// CreateDependency mocks base method.
+func (m *MockTaskRepository) CreateDependency(ctx context.Context, dependency *entities.TaskDependency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDependency", ctx, dependency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDependency indicates an expected call of CreateDependency.
+func (mr *MockTaskRepositoryMockRecorder) CreateDependency(ctx, dependency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDependency", reflect.TypeOf((*MockTaskRepository)(nil).CreateDependency), ctx, dependency)
+}
+
+// CreateEvent mocks base method.
+func (m *MockTaskRepository) CreateEvent(ctx context.Context, event *entities.TaskEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockTaskRepositoryMockRecorder) CreateEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockTaskRepository)(nil).CreateEvent), ctx, event)
+}
+
+// CreateEvents mocks base method.
+func (m *MockTaskRepository) CreateEvents(ctx context.Context, events []*entities.TaskEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEvents indicates an expected call of CreateEvents.
+func (mr *MockTaskRepositoryMockRecorder) CreateEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvents", reflect.TypeOf((*MockTaskRepository)(nil).CreateEvents), ctx, events)
+}
+
+// CreateTaskLabel mocks base method.
+func (m *MockTaskRepository) CreateTaskLabel(ctx context.Context, taskLabel *entities.TaskLabel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaskLabel", ctx, taskLabel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTaskLabel indicates an expected call of CreateTaskLabel.
+func (mr *MockTaskRepositoryMockRecorder) CreateTaskLabel(ctx, taskLabel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskLabel", reflect.TypeOf((*MockTaskRepository)(nil).CreateTaskLabel), ctx, taskLabel)
 }
 
 // DeleteByID mocks base method.
-func (m *MockTaskRepository) DeleteByID(id uint) error {
+func (m *MockTaskRepository) DeleteByID(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", id)
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteByID indicates an expected call of DeleteByID.
-func (mr *MockTaskRepositoryMockRecorder) DeleteByID(id interface{}) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) DeleteByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockTaskRepository)(nil).DeleteByID), ctx, id)
+}
+
+// DeleteDependency mocks base method.
+func (m *MockTaskRepository) DeleteDependency(ctx context.Context, blockerID, blockedID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDependency", ctx, blockerID, blockedID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDependency indicates an expected call of DeleteDependency.
+func (mr *MockTaskRepositoryMockRecorder) DeleteDependency(ctx, blockerID, blockedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDependency", reflect.TypeOf((*MockTaskRepository)(nil).DeleteDependency), ctx, blockerID, blockedID)
+}
+
+// DeleteTaskLabel mocks base method.
+func (m *MockTaskRepository) DeleteTaskLabel(ctx context.Context, taskID, labelID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskLabel", ctx, taskID, labelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaskLabel indicates an expected call of DeleteTaskLabel.
+func (mr *MockTaskRepositoryMockRecorder) DeleteTaskLabel(ctx, taskID, labelID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockTaskRepository)(nil).DeleteByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskLabel", reflect.TypeOf((*MockTaskRepository)(nil).DeleteTaskLabel), ctx, taskID, labelID)
 }
 
 // GetByID mocks base method.
-func (m *MockTaskRepository) GetByID(id uint) (*entities.Task, error) {
+func (m *MockTaskRepository) GetByID(ctx context.Context, id uint) (*entities.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
 	ret0, _ := ret[0].(*entities.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockTaskRepositoryMockRecorder) GetByID(id interface{}) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTaskRepository)(nil).GetByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTaskRepository)(nil).GetByID), ctx, id)
+}
+
+// GetByIDForUpdate mocks base method.
+func (m *MockTaskRepository) GetByIDForUpdate(ctx context.Context, id uint) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDForUpdate", ctx, id)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDForUpdate indicates an expected call of GetByIDForUpdate.
+func (mr *MockTaskRepositoryMockRecorder) GetByIDForUpdate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockTaskRepository)(nil).GetByIDForUpdate), ctx, id)
+}
+
+// GetByIDUnscoped mocks base method.
+func (m *MockTaskRepository) GetByIDUnscoped(ctx context.Context, id uint) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDUnscoped", ctx, id)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDUnscoped indicates an expected call of GetByIDUnscoped.
+func (mr *MockTaskRepositoryMockRecorder) GetByIDUnscoped(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDUnscoped", reflect.TypeOf((*MockTaskRepository)(nil).GetByIDUnscoped), ctx, id)
+}
+
+// GetByIDUnscopedForUpdate mocks base method.
+func (m *MockTaskRepository) GetByIDUnscopedForUpdate(ctx context.Context, id uint) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDUnscopedForUpdate", ctx, id)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDUnscopedForUpdate indicates an expected call of GetByIDUnscopedForUpdate.
+func (mr *MockTaskRepositoryMockRecorder) GetByIDUnscopedForUpdate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDUnscopedForUpdate", reflect.TypeOf((*MockTaskRepository)(nil).GetByIDUnscopedForUpdate), ctx, id)
+}
+
+// GetEvent mocks base method.
+func (m *MockTaskRepository) GetEvent(ctx context.Context, id uint) (*entities.TaskEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, id)
+	ret0, _ := ret[0].(*entities.TaskEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockTaskRepositoryMockRecorder) GetEvent(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockTaskRepository)(nil).GetEvent), ctx, id)
 }
 
 // List mocks base method.
-func (m *MockTaskRepository) List() ([]entities.Task, error) {
+func (m *MockTaskRepository) List(ctx context.Context, query *models.ListTasksQuery) ([]entities.Task, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List")
+	ret := m.ctrl.Call(m, "List", ctx, query)
 	ret0, _ := ret[0].([]entities.Task)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTaskRepositoryMockRecorder) List(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskRepository)(nil).List), ctx, query)
+}
+
+// ListAncestorIDs mocks base method.
+func (m *MockTaskRepository) ListAncestorIDs(ctx context.Context, id uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAncestorIDs", ctx, id)
+	ret0, _ := ret[0].([]uint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// List indicates an expected call of List.
-func (mr *MockTaskRepositoryMockRecorder) List() *gomock.Call {
+// ListAncestorIDs indicates an expected call of ListAncestorIDs.
+func (mr *MockTaskRepositoryMockRecorder) ListAncestorIDs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAncestorIDs", reflect.TypeOf((*MockTaskRepository)(nil).ListAncestorIDs), ctx, id)
+}
+
+// ListDependencies mocks base method.
+func (m *MockTaskRepository) ListDependencies(ctx context.Context, taskID uint) ([]entities.TaskDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDependencies", ctx, taskID)
+	ret0, _ := ret[0].([]entities.TaskDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDependencies indicates an expected call of ListDependencies.
+func (mr *MockTaskRepositoryMockRecorder) ListDependencies(ctx, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDependencies", reflect.TypeOf((*MockTaskRepository)(nil).ListDependencies), ctx, taskID)
+}
+
+// ListDescendants mocks base method.
+func (m *MockTaskRepository) ListDescendants(ctx context.Context, id uint, depth, limit int) ([]entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDescendants", ctx, id, depth, limit)
+	ret0, _ := ret[0].([]entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDescendants indicates an expected call of ListDescendants.
+func (mr *MockTaskRepositoryMockRecorder) ListDescendants(ctx, id, depth, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDescendants", reflect.TypeOf((*MockTaskRepository)(nil).ListDescendants), ctx, id, depth, limit)
+}
+
+// ListEvents mocks base method.
+func (m *MockTaskRepository) ListEvents(ctx context.Context, taskID uint, query *models.ListTaskHistoryQuery) ([]entities.TaskEvent, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, taskID, query)
+	ret0, _ := ret[0].([]entities.TaskEvent)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockTaskRepositoryMockRecorder) ListEvents(ctx, taskID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockTaskRepository)(nil).ListEvents), ctx, taskID, query)
+}
+
+// ListEventsAfter mocks base method.
+func (m *MockTaskRepository) ListEventsAfter(ctx context.Context, afterID uint, limit int) ([]entities.TaskEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventsAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]entities.TaskEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventsAfter indicates an expected call of ListEventsAfter.
+func (mr *MockTaskRepositoryMockRecorder) ListEventsAfter(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsAfter", reflect.TypeOf((*MockTaskRepository)(nil).ListEventsAfter), ctx, afterID, limit)
+}
+
+// ListLabels mocks base method.
+func (m *MockTaskRepository) ListLabels(ctx context.Context, ids []uint) (map[uint][]entities.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLabels", ctx, ids)
+	ret0, _ := ret[0].(map[uint][]entities.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLabels indicates an expected call of ListLabels.
+func (mr *MockTaskRepositoryMockRecorder) ListLabels(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLabels", reflect.TypeOf((*MockTaskRepository)(nil).ListLabels), ctx, ids)
+}
+
+// ListOpenBlockers mocks base method.
+func (m *MockTaskRepository) ListOpenBlockers(ctx context.Context, ids []uint) (map[uint][]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenBlockers", ctx, ids)
+	ret0, _ := ret[0].(map[uint][]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenBlockers indicates an expected call of ListOpenBlockers.
+func (mr *MockTaskRepositoryMockRecorder) ListOpenBlockers(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenBlockers", reflect.TypeOf((*MockTaskRepository)(nil).ListOpenBlockers), ctx, ids)
+}
+
+// ListUpstreamBlockerIDs mocks base method.
+func (m *MockTaskRepository) ListUpstreamBlockerIDs(ctx context.Context, id uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUpstreamBlockerIDs", ctx, id)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUpstreamBlockerIDs indicates an expected call of ListUpstreamBlockerIDs.
+func (mr *MockTaskRepositoryMockRecorder) ListUpstreamBlockerIDs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUpstreamBlockerIDs", reflect.TypeOf((*MockTaskRepository)(nil).ListUpstreamBlockerIDs), ctx, id)
+}
+
+// LockDependencies mocks base method.
+func (m *MockTaskRepository) LockDependencies(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockDependencies", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockDependencies indicates an expected call of LockDependencies.
+func (mr *MockTaskRepositoryMockRecorder) LockDependencies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockDependencies", reflect.TypeOf((*MockTaskRepository)(nil).LockDependencies), ctx)
+}
+
+// LockHierarchy mocks base method.
+func (m *MockTaskRepository) LockHierarchy(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockHierarchy", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockHierarchy indicates an expected call of LockHierarchy.
+func (mr *MockTaskRepositoryMockRecorder) LockHierarchy(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockHierarchy", reflect.TypeOf((*MockTaskRepository)(nil).LockHierarchy), ctx)
+}
+
+// Restore mocks base method.
+func (m *MockTaskRepository) Restore(ctx context.Context, task *entities.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTaskRepositoryMockRecorder) Restore(ctx, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskRepository)(nil).List))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTaskRepository)(nil).Restore), ctx, task)
+}
+
+// Search mocks base method.
+func (m *MockTaskRepository) Search(ctx context.Context, query *models.SearchTasksQuery) ([]models.TaskSearchResult, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, query)
+	ret0, _ := ret[0].([]models.TaskSearchResult)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTaskRepositoryMockRecorder) Search(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTaskRepository)(nil).Search), ctx, query)
+}
+
+// Transaction mocks base method.
+func (m *MockTaskRepository) Transaction(ctx context.Context, fn func(interfaces.TaskRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockTaskRepositoryMockRecorder) Transaction(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockTaskRepository)(nil).Transaction), ctx, fn)
 }
 
 // Update mocks base method.
-func (m *MockTaskRepository) Update(task *entities.TaskUpdate) error {
+func (m *MockTaskRepository) Update(ctx context.Context, task *entities.TaskUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", task)
+	ret := m.ctrl.Call(m, "Update", ctx, task)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTaskRepositoryMockRecorder) Update(task interface{}) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) Update(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepository)(nil).Update), ctx, task)
+}
+
+// UpdateAssignee mocks base method.
+func (m *MockTaskRepository) UpdateAssignee(ctx context.Context, task *entities.TaskUpdate, assigneeID *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAssignee", ctx, task, assigneeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAssignee indicates an expected call of UpdateAssignee.
+func (mr *MockTaskRepositoryMockRecorder) UpdateAssignee(ctx, task, assigneeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAssignee", reflect.TypeOf((*MockTaskRepository)(nil).UpdateAssignee), ctx, task, assigneeID)
+}
+
+// UpdateParent mocks base method.
+func (m *MockTaskRepository) UpdateParent(ctx context.Context, task *entities.TaskUpdate, parentID *uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateParent", ctx, task, parentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateParent indicates an expected call of UpdateParent.
+func (mr *MockTaskRepositoryMockRecorder) UpdateParent(ctx, task, parentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateParent", reflect.TypeOf((*MockTaskRepository)(nil).UpdateParent), ctx, task, parentID)
+}
+
+// MockWorkflowReader is a mock of WorkflowReader interface.
+type MockWorkflowReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkflowReaderMockRecorder
+}
+
+// MockWorkflowReaderMockRecorder is the mock recorder for MockWorkflowReader.
+type MockWorkflowReaderMockRecorder struct {
+	mock *MockWorkflowReader
+}
+
+// NewMockWorkflowReader creates a new mock instance.
+func NewMockWorkflowReader(ctrl *gomock.Controller) *MockWorkflowReader {
+	mock := &MockWorkflowReader{ctrl: ctrl}
+	mock.recorder = &MockWorkflowReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkflowReader) EXPECT() *MockWorkflowReaderMockRecorder {
+	return m.recorder
+}
+
+// GetActiveWorkflow mocks base method.
+func (m *MockWorkflowReader) GetActiveWorkflow(ctx context.Context) (*entities.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveWorkflow", ctx)
+	ret0, _ := ret[0].(*entities.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveWorkflow indicates an expected call of GetActiveWorkflow.
+func (mr *MockWorkflowReaderMockRecorder) GetActiveWorkflow(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepository)(nil).Update), task)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveWorkflow", reflect.TypeOf((*MockWorkflowReader)(nil).GetActiveWorkflow), ctx)
 }
@@ -5,9 +5,12 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	models "github.com/supachai1998/task_services/internal/domains/tasks/models"
+	usecases "github.com/supachai1998/task_services/internal/domains/tasks/usecases"
 	entities "github.com/supachai1998/task_services/internal/entities"
 )
 
@@ -34,88 +37,344 @@ func (m *MockTaskUsecase) EXPECT() *MockTaskUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AddTaskDependency mocks base method.
+func (m *MockTaskUsecase) AddTaskDependency(ctx context.Context, actor entities.Actor, blockedID, blockerID uint) (*entities.TaskDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTaskDependency", ctx, actor, blockedID, blockerID)
+	ret0, _ := ret[0].(*entities.TaskDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTaskDependency indicates an expected call of AddTaskDependency.
+func (mr *MockTaskUsecaseMockRecorder) AddTaskDependency(ctx, actor, blockedID, blockerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTaskDependency", reflect.TypeOf((*MockTaskUsecase)(nil).AddTaskDependency), ctx, actor, blockedID, blockerID)
+}
+
+// AddTaskLabel mocks base method.
+func (m *MockTaskUsecase) AddTaskLabel(ctx context.Context, actor entities.Actor, taskID, labelID uint) (*entities.TaskLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTaskLabel", ctx, actor, taskID, labelID)
+	ret0, _ := ret[0].(*entities.TaskLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTaskLabel indicates an expected call of AddTaskLabel.
+func (mr *MockTaskUsecaseMockRecorder) AddTaskLabel(ctx, actor, taskID, labelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTaskLabel", reflect.TypeOf((*MockTaskUsecase)(nil).AddTaskLabel), ctx, actor, taskID, labelID)
+}
+
+// AssignTask mocks base method.
+func (m *MockTaskUsecase) AssignTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate, assigneeID *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignTask", ctx, actor, task, assigneeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignTask indicates an expected call of AssignTask.
+func (mr *MockTaskUsecaseMockRecorder) AssignTask(ctx, actor, task, assigneeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTask", reflect.TypeOf((*MockTaskUsecase)(nil).AssignTask), ctx, actor, task, assigneeID)
+}
+
 // CreateTask mocks base method.
-func (m *MockTaskUsecase) CreateTask(task *entities.Task) error {
+func (m *MockTaskUsecase) CreateTask(ctx context.Context, actor entities.Actor, task *entities.Task) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTask", task)
+	ret := m.ctrl.Call(m, "CreateTask", ctx, actor, task)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateTask indicates an expected call of CreateTask.
-func (mr *MockTaskUsecaseMockRecorder) CreateTask(task interface{}) *gomock.Call {
+func (mr *MockTaskUsecaseMockRecorder) CreateTask(ctx, actor, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockTaskUsecase)(nil).CreateTask), task)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockTaskUsecase)(nil).CreateTask), ctx, actor, task)
 }
 
 // DeleteTaskByID mocks base method.
-func (m *MockTaskUsecase) DeleteTaskByID(id uint) error {
+func (m *MockTaskUsecase) DeleteTaskByID(ctx context.Context, actor entities.Actor, id uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTaskByID", id)
+	ret := m.ctrl.Call(m, "DeleteTaskByID", ctx, actor, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteTaskByID indicates an expected call of DeleteTaskByID.
-func (mr *MockTaskUsecaseMockRecorder) DeleteTaskByID(id interface{}) *gomock.Call {
+func (mr *MockTaskUsecaseMockRecorder) DeleteTaskByID(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskByID", reflect.TypeOf((*MockTaskUsecase)(nil).DeleteTaskByID), ctx, actor, id)
+}
+
+// ExecuteBatch mocks base method.
+func (m *MockTaskUsecase) ExecuteBatch(ctx context.Context, actor entities.Actor, ops []models.TaskOperation, atomic bool) []models.TaskOperationResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteBatch", ctx, actor, ops, atomic)
+	ret0, _ := ret[0].([]models.TaskOperationResult)
+	return ret0
+}
+
+// ExecuteBatch indicates an expected call of ExecuteBatch.
+func (mr *MockTaskUsecaseMockRecorder) ExecuteBatch(ctx, actor, ops, atomic interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskByID", reflect.TypeOf((*MockTaskUsecase)(nil).DeleteTaskByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteBatch", reflect.TypeOf((*MockTaskUsecase)(nil).ExecuteBatch), ctx, actor, ops, atomic)
 }
 
 // GetTaskByID mocks base method.
-func (m *MockTaskUsecase) GetTaskByID(id uint) (*entities.Task, error) {
+func (m *MockTaskUsecase) GetTaskByID(ctx context.Context, actor entities.Actor, id uint) (*entities.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTaskByID", id)
+	ret := m.ctrl.Call(m, "GetTaskByID", ctx, actor, id)
 	ret0, _ := ret[0].(*entities.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTaskByID indicates an expected call of GetTaskByID.
-func (mr *MockTaskUsecaseMockRecorder) GetTaskByID(id interface{}) *gomock.Call {
+func (mr *MockTaskUsecaseMockRecorder) GetTaskByID(ctx, actor, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskByID", reflect.TypeOf((*MockTaskUsecase)(nil).GetTaskByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskByID", reflect.TypeOf((*MockTaskUsecase)(nil).GetTaskByID), ctx, actor, id)
 }
 
-// ListTasks mocks base method.
-func (m *MockTaskUsecase) ListTasks() ([]entities.Task, error) {
+// GetTaskTree mocks base method.
+func (m *MockTaskUsecase) GetTaskTree(ctx context.Context, actor entities.Actor, id uint, query *models.GetTaskTreeQuery) (*models.TaskTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskTree", ctx, actor, id, query)
+	ret0, _ := ret[0].(*models.TaskTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskTree indicates an expected call of GetTaskTree.
+func (mr *MockTaskUsecaseMockRecorder) GetTaskTree(ctx, actor, id, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskTree", reflect.TypeOf((*MockTaskUsecase)(nil).GetTaskTree), ctx, actor, id, query)
+}
+
+// ListTaskChildren mocks base method.
+func (m *MockTaskUsecase) ListTaskChildren(ctx context.Context, actor entities.Actor, id uint, query *models.ListTaskChildrenQuery) ([]entities.Task, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTasks")
+	ret := m.ctrl.Call(m, "ListTaskChildren", ctx, actor, id, query)
 	ret0, _ := ret[0].([]entities.Task)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTaskChildren indicates an expected call of ListTaskChildren.
+func (mr *MockTaskUsecaseMockRecorder) ListTaskChildren(ctx, actor, id, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskChildren", reflect.TypeOf((*MockTaskUsecase)(nil).ListTaskChildren), ctx, actor, id, query)
+}
+
+// ListTaskDependencies mocks base method.
+func (m *MockTaskUsecase) ListTaskDependencies(ctx context.Context, actor entities.Actor, id uint) ([]entities.TaskDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskDependencies", ctx, actor, id)
+	ret0, _ := ret[0].([]entities.TaskDependency)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// ListTaskDependencies indicates an expected call of ListTaskDependencies.
+func (mr *MockTaskUsecaseMockRecorder) ListTaskDependencies(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskDependencies", reflect.TypeOf((*MockTaskUsecase)(nil).ListTaskDependencies), ctx, actor, id)
+}
+
+// ListTaskHistory mocks base method.
+func (m *MockTaskUsecase) ListTaskHistory(ctx context.Context, actor entities.Actor, id uint, query *models.ListTaskHistoryQuery) ([]entities.TaskEvent, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskHistory", ctx, actor, id, query)
+	ret0, _ := ret[0].([]entities.TaskEvent)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTaskHistory indicates an expected call of ListTaskHistory.
+func (mr *MockTaskUsecaseMockRecorder) ListTaskHistory(ctx, actor, id, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskHistory", reflect.TypeOf((*MockTaskUsecase)(nil).ListTaskHistory), ctx, actor, id, query)
+}
+
+// ListTasks mocks base method.
+func (m *MockTaskUsecase) ListTasks(ctx context.Context, actor entities.Actor, query *models.ListTasksQuery) ([]entities.Task, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTasks", ctx, actor, query)
+	ret0, _ := ret[0].([]entities.Task)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // ListTasks indicates an expected call of ListTasks.
-func (mr *MockTaskUsecaseMockRecorder) ListTasks() *gomock.Call {
+func (mr *MockTaskUsecaseMockRecorder) ListTasks(ctx, actor, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskUsecase)(nil).ListTasks))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskUsecase)(nil).ListTasks), ctx, actor, query)
+}
+
+// MoveTask mocks base method.
+func (m *MockTaskUsecase) MoveTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate, parentID *uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTask", ctx, actor, task, parentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTask indicates an expected call of MoveTask.
+func (mr *MockTaskUsecaseMockRecorder) MoveTask(ctx, actor, task, parentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTask", reflect.TypeOf((*MockTaskUsecase)(nil).MoveTask), ctx, actor, task, parentID)
+}
+
+// RemoveTaskDependency mocks base method.
+func (m *MockTaskUsecase) RemoveTaskDependency(ctx context.Context, actor entities.Actor, blockedID, blockerID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTaskDependency", ctx, actor, blockedID, blockerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTaskDependency indicates an expected call of RemoveTaskDependency.
+func (mr *MockTaskUsecaseMockRecorder) RemoveTaskDependency(ctx, actor, blockedID, blockerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskDependency", reflect.TypeOf((*MockTaskUsecase)(nil).RemoveTaskDependency), ctx, actor, blockedID, blockerID)
+}
+
+// RemoveTaskLabel mocks base method.
+func (m *MockTaskUsecase) RemoveTaskLabel(ctx context.Context, actor entities.Actor, taskID, labelID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTaskLabel", ctx, actor, taskID, labelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTaskLabel indicates an expected call of RemoveTaskLabel.
+func (mr *MockTaskUsecaseMockRecorder) RemoveTaskLabel(ctx, actor, taskID, labelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTaskLabel", reflect.TypeOf((*MockTaskUsecase)(nil).RemoveTaskLabel), ctx, actor, taskID, labelID)
+}
+
+// RestoreTask mocks base method.
+func (m *MockTaskUsecase) RestoreTask(ctx context.Context, actor entities.Actor, id uint) (*entities.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTask", ctx, actor, id)
+	ret0, _ := ret[0].(*entities.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTask indicates an expected call of RestoreTask.
+func (mr *MockTaskUsecaseMockRecorder) RestoreTask(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTask", reflect.TypeOf((*MockTaskUsecase)(nil).RestoreTask), ctx, actor, id)
+}
+
+// SearchTasks mocks base method.
+func (m *MockTaskUsecase) SearchTasks(ctx context.Context, actor entities.Actor, query *models.SearchTasksQuery) ([]models.TaskSearchResult, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTasks", ctx, actor, query)
+	ret0, _ := ret[0].([]models.TaskSearchResult)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchTasks indicates an expected call of SearchTasks.
+func (mr *MockTaskUsecaseMockRecorder) SearchTasks(ctx, actor, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTasks", reflect.TypeOf((*MockTaskUsecase)(nil).SearchTasks), ctx, actor, query)
 }
 
 // UpdateTask mocks base method.
-func (m *MockTaskUsecase) UpdateTask(task *entities.TaskUpdate) error {
+func (m *MockTaskUsecase) UpdateTask(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTask", task)
+	ret := m.ctrl.Call(m, "UpdateTask", ctx, actor, task)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateTask indicates an expected call of UpdateTask.
-func (mr *MockTaskUsecaseMockRecorder) UpdateTask(task interface{}) *gomock.Call {
+func (mr *MockTaskUsecaseMockRecorder) UpdateTask(ctx, actor, task interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockTaskUsecase)(nil).UpdateTask), task)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockTaskUsecase)(nil).UpdateTask), ctx, actor, task)
 }
 
 // UpdateTaskStatus mocks base method.
-func (m *MockTaskUsecase) UpdateTaskStatus(id uint, status entities.TaskStatus) error {
+func (m *MockTaskUsecase) UpdateTaskStatus(ctx context.Context, actor entities.Actor, task *entities.TaskUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTaskStatus", id, status)
+	ret := m.ctrl.Call(m, "UpdateTaskStatus", ctx, actor, task)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateTaskStatus indicates an expected call of UpdateTaskStatus.
-func (mr *MockTaskUsecaseMockRecorder) UpdateTaskStatus(id, status interface{}) *gomock.Call {
+func (mr *MockTaskUsecaseMockRecorder) UpdateTaskStatus(ctx, actor, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskStatus", reflect.TypeOf((*MockTaskUsecase)(nil).UpdateTaskStatus), ctx, actor, task)
+}
+
+// MockTaskStream is a mock of TaskStream interface.
+type MockTaskStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskStreamMockRecorder
+}
+
+// MockTaskStreamMockRecorder is the mock recorder for MockTaskStream.
+type MockTaskStreamMockRecorder struct {
+	mock *MockTaskStream
+}
+
+// NewMockTaskStream creates a new mock instance.
+func NewMockTaskStream(ctrl *gomock.Controller) *MockTaskStream {
+	mock := &MockTaskStream{ctrl: ctrl}
+	mock.recorder = &MockTaskStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskStream) EXPECT() *MockTaskStreamMockRecorder {
+	return m.recorder
+}
+
+// HandleNotification mocks base method.
+func (m *MockTaskStream) HandleNotification(ctx context.Context, payload string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleNotification", ctx, payload)
+}
+
+// HandleNotification indicates an expected call of HandleNotification.
+func (mr *MockTaskStreamMockRecorder) HandleNotification(ctx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleNotification", reflect.TypeOf((*MockTaskStream)(nil).HandleNotification), ctx, payload)
+}
+
+// Reset mocks base method.
+func (m *MockTaskStream) Reset() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reset")
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockTaskStreamMockRecorder) Reset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockTaskStream)(nil).Reset))
+}
+
+// Subscribe mocks base method.
+func (m *MockTaskStream) Subscribe(ctx context.Context, actor entities.Actor, query *models.StreamTasksQuery) (*usecases.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, actor, query)
+	ret0, _ := ret[0].(*usecases.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockTaskStreamMockRecorder) Subscribe(ctx, actor, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskStatus", reflect.TypeOf((*MockTaskUsecase)(nil).UpdateTaskStatus), id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockTaskStream)(nil).Subscribe), ctx, actor, query)
 }
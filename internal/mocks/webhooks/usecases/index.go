@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/webhooks/usecases/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/supachai1998/task_services/internal/domains/webhooks/models"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockWebhookUsecase is a mock of WebhookUsecase interface.
+type MockWebhookUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookUsecaseMockRecorder
+}
+
+// MockWebhookUsecaseMockRecorder is the mock recorder for MockWebhookUsecase.
+type MockWebhookUsecaseMockRecorder struct {
+	mock *MockWebhookUsecase
+}
+
+// NewMockWebhookUsecase creates a new mock instance.
+func NewMockWebhookUsecase(ctrl *gomock.Controller) *MockWebhookUsecase {
+	mock := &MockWebhookUsecase{ctrl: ctrl}
+	mock.recorder = &MockWebhookUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookUsecase) EXPECT() *MockWebhookUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhookUsecase) CreateWebhook(ctx context.Context, actor entities.Actor, webhook *entities.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, actor, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookUsecaseMockRecorder) CreateWebhook(ctx, actor, webhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookUsecase)(nil).CreateWebhook), ctx, actor, webhook)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookUsecase) DeleteWebhook(ctx context.Context, actor entities.Actor, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, actor, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookUsecaseMockRecorder) DeleteWebhook(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookUsecase)(nil).DeleteWebhook), ctx, actor, id)
+}
+
+// DeliverDue mocks base method.
+func (m *MockWebhookUsecase) DeliverDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverDue indicates an expected call of DeliverDue.
+func (mr *MockWebhookUsecaseMockRecorder) DeliverDue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverDue", reflect.TypeOf((*MockWebhookUsecase)(nil).DeliverDue), ctx)
+}
+
+// GetWebhook mocks base method.
+func (m *MockWebhookUsecase) GetWebhook(ctx context.Context, id uint) (*entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", ctx, id)
+	ret0, _ := ret[0].(*entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockWebhookUsecaseMockRecorder) GetWebhook(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockWebhookUsecase)(nil).GetWebhook), ctx, id)
+}
+
+// HandleDomainEvent mocks base method.
+func (m *MockWebhookUsecase) HandleDomainEvent(ctx context.Context, event entities.DomainEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleDomainEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleDomainEvent indicates an expected call of HandleDomainEvent.
+func (mr *MockWebhookUsecaseMockRecorder) HandleDomainEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDomainEvent", reflect.TypeOf((*MockWebhookUsecase)(nil).HandleDomainEvent), ctx, event)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookUsecase) ListDeliveries(ctx context.Context, webhookID uint, query *models.ListDeliveriesQuery) ([]entities.WebhookDelivery, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, webhookID, query)
+	ret0, _ := ret[0].([]entities.WebhookDelivery)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookUsecaseMockRecorder) ListDeliveries(ctx, webhookID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookUsecase)(nil).ListDeliveries), ctx, webhookID, query)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockWebhookUsecase) ListWebhooks(ctx context.Context) ([]entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx)
+	ret0, _ := ret[0].([]entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockWebhookUsecaseMockRecorder) ListWebhooks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookUsecase)(nil).ListWebhooks), ctx)
+}
+
+// Redeliver mocks base method.
+func (m *MockWebhookUsecase) Redeliver(ctx context.Context, actor entities.Actor, webhookID, deliveryID uint) (*entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, actor, webhookID, deliveryID)
+	ret0, _ := ret[0].(*entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhookUsecaseMockRecorder) Redeliver(ctx, actor, webhookID, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhookUsecase)(nil).Redeliver), ctx, actor, webhookID, deliveryID)
+}
+
+// RunDispatcher mocks base method.
+func (m *MockWebhookUsecase) RunDispatcher(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunDispatcher", ctx)
+}
+
+// RunDispatcher indicates an expected call of RunDispatcher.
+func (mr *MockWebhookUsecaseMockRecorder) RunDispatcher(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDispatcher", reflect.TypeOf((*MockWebhookUsecase)(nil).RunDispatcher), ctx)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockWebhookUsecase) UpdateWebhook(ctx context.Context, actor entities.Actor, webhook *entities.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, actor, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockWebhookUsecaseMockRecorder) UpdateWebhook(ctx, actor, webhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockWebhookUsecase)(nil).UpdateWebhook), ctx, actor, webhook)
+}
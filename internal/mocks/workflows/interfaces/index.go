@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/workflows/interfaces/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	interfaces "github.com/supachai1998/task_services/internal/domains/workflows/interfaces"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockWorkflowRepository is a mock of WorkflowRepository interface.
+type MockWorkflowRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkflowRepositoryMockRecorder
+}
+
+// MockWorkflowRepositoryMockRecorder is the mock recorder for MockWorkflowRepository.
+type MockWorkflowRepositoryMockRecorder struct {
+	mock *MockWorkflowRepository
+}
+
+// NewMockWorkflowRepository creates a new mock instance.
+func NewMockWorkflowRepository(ctrl *gomock.Controller) *MockWorkflowRepository {
+	mock := &MockWorkflowRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkflowRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkflowRepository) EXPECT() *MockWorkflowRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWorkflowRepository) Create(ctx context.Context, workflow *entities.Workflow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, workflow)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkflowRepositoryMockRecorder) Create(ctx, workflow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkflowRepository)(nil).Create), ctx, workflow)
+}
+
+// Deactivate mocks base method.
+func (m *MockWorkflowRepository) Deactivate(ctx context.Context, exceptID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, exceptID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockWorkflowRepositoryMockRecorder) Deactivate(ctx, exceptID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockWorkflowRepository)(nil).Deactivate), ctx, exceptID)
+}
+
+// Delete mocks base method.
+func (m *MockWorkflowRepository) Delete(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWorkflowRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWorkflowRepository)(nil).Delete), ctx, id)
+}
+
+// GetActive mocks base method.
+func (m *MockWorkflowRepository) GetActive(ctx context.Context) (*entities.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx)
+	ret0, _ := ret[0].(*entities.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockWorkflowRepositoryMockRecorder) GetActive(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockWorkflowRepository)(nil).GetActive), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockWorkflowRepository) GetByID(ctx context.Context, id uint) (*entities.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entities.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWorkflowRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWorkflowRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockWorkflowRepository) List(ctx context.Context) ([]entities.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]entities.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWorkflowRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWorkflowRepository)(nil).List), ctx)
+}
+
+// ListStatusesInUse mocks base method.
+func (m *MockWorkflowRepository) ListStatusesInUse(ctx context.Context) ([]entities.TaskStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatusesInUse", ctx)
+	ret0, _ := ret[0].([]entities.TaskStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatusesInUse indicates an expected call of ListStatusesInUse.
+func (mr *MockWorkflowRepositoryMockRecorder) ListStatusesInUse(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatusesInUse", reflect.TypeOf((*MockWorkflowRepository)(nil).ListStatusesInUse), ctx)
+}
+
+// Transaction mocks base method.
+func (m *MockWorkflowRepository) Transaction(ctx context.Context, fn func(interfaces.WorkflowRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockWorkflowRepositoryMockRecorder) Transaction(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockWorkflowRepository)(nil).Transaction), ctx, fn)
+}
+
+// Update mocks base method.
+func (m *MockWorkflowRepository) Update(ctx context.Context, workflow *entities.Workflow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, workflow)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWorkflowRepositoryMockRecorder) Update(ctx, workflow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkflowRepository)(nil).Update), ctx, workflow)
+}
+
+// UpdateTaskCategories mocks base method.
+func (m *MockWorkflowRepository) UpdateTaskCategories(ctx context.Context, statuses entities.WorkflowStatuses) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskCategories", ctx, statuses)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskCategories indicates an expected call of UpdateTaskCategories.
+func (mr *MockWorkflowRepositoryMockRecorder) UpdateTaskCategories(ctx, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskCategories", reflect.TypeOf((*MockWorkflowRepository)(nil).UpdateTaskCategories), ctx, statuses)
+}
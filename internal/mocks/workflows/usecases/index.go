@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/domains/workflows/usecases/index.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entities "github.com/supachai1998/task_services/internal/entities"
+)
+
+// MockWorkflowUsecase is a mock of WorkflowUsecase interface.
+type MockWorkflowUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkflowUsecaseMockRecorder
+}
+
+// MockWorkflowUsecaseMockRecorder is the mock recorder for MockWorkflowUsecase.
+type MockWorkflowUsecaseMockRecorder struct {
+	mock *MockWorkflowUsecase
+}
+
+// NewMockWorkflowUsecase creates a new mock instance.
+func NewMockWorkflowUsecase(ctrl *gomock.Controller) *MockWorkflowUsecase {
+	mock := &MockWorkflowUsecase{ctrl: ctrl}
+	mock.recorder = &MockWorkflowUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkflowUsecase) EXPECT() *MockWorkflowUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateWorkflow mocks base method.
+func (m *MockWorkflowUsecase) CreateWorkflow(ctx context.Context, actor entities.Actor, workflow *entities.Workflow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflow", ctx, actor, workflow)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkflow indicates an expected call of CreateWorkflow.
+func (mr *MockWorkflowUsecaseMockRecorder) CreateWorkflow(ctx, actor, workflow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflow", reflect.TypeOf((*MockWorkflowUsecase)(nil).CreateWorkflow), ctx, actor, workflow)
+}
+
+// DeleteWorkflow mocks base method.
+func (m *MockWorkflowUsecase) DeleteWorkflow(ctx context.Context, actor entities.Actor, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflow", ctx, actor, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflow indicates an expected call of DeleteWorkflow.
+func (mr *MockWorkflowUsecaseMockRecorder) DeleteWorkflow(ctx, actor, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflow", reflect.TypeOf((*MockWorkflowUsecase)(nil).DeleteWorkflow), ctx, actor, id)
+}
+
+// GetActiveWorkflow mocks base method.
+func (m *MockWorkflowUsecase) GetActiveWorkflow(ctx context.Context) (*entities.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveWorkflow", ctx)
+	ret0, _ := ret[0].(*entities.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveWorkflow indicates an expected call of GetActiveWorkflow.
+func (mr *MockWorkflowUsecaseMockRecorder) GetActiveWorkflow(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveWorkflow", reflect.TypeOf((*MockWorkflowUsecase)(nil).GetActiveWorkflow), ctx)
+}
+
+// GetWorkflow mocks base method.
+func (m *MockWorkflowUsecase) GetWorkflow(ctx context.Context, id uint) (*entities.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflow", ctx, id)
+	ret0, _ := ret[0].(*entities.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflow indicates an expected call of GetWorkflow.
+func (mr *MockWorkflowUsecaseMockRecorder) GetWorkflow(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflow", reflect.TypeOf((*MockWorkflowUsecase)(nil).GetWorkflow), ctx, id)
+}
+
+// ListWorkflows mocks base method.
+func (m *MockWorkflowUsecase) ListWorkflows(ctx context.Context) ([]entities.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflows", ctx)
+	ret0, _ := ret[0].([]entities.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflows indicates an expected call of ListWorkflows.
+func (mr *MockWorkflowUsecaseMockRecorder) ListWorkflows(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflows", reflect.TypeOf((*MockWorkflowUsecase)(nil).ListWorkflows), ctx)
+}
+
+// UpdateWorkflow mocks base method.
+func (m *MockWorkflowUsecase) UpdateWorkflow(ctx context.Context, actor entities.Actor, workflow *entities.Workflow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflow", ctx, actor, workflow)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkflow indicates an expected call of UpdateWorkflow.
+func (mr *MockWorkflowUsecaseMockRecorder) UpdateWorkflow(ctx, actor, workflow interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflow", reflect.TypeOf((*MockWorkflowUsecase)(nil).UpdateWorkflow), ctx, actor, workflow)
+}
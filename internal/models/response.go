@@ -1,12 +1,52 @@
 package models
 
+import "encoding/json"
+
 type ResponseSuccess struct {
 	Message string `json:"message"`
 	Status  string `json:"status"`
 	Data    any    `json:"data"`
 }
 
-type ResponseError struct {
-	Message string `json:"message"`
-	Status  string `json:"status"`
+type ResponsePaginated struct {
+	Message    string `json:"message"`
+	Status     string `json:"status"`
+	Data       any    `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// BatchItemResult is the outcome of one operation of a batch request.
+type BatchItemResult struct {
+	Index  int             `json:"index"`
+	Status int             `json:"status" example:"201"`
+	Data   any             `json:"data,omitempty"`
+	Error  *ProblemDetails `json:"error,omitempty"`
+}
+
+// ProblemDetails is an RFC 7807 application/problem+json error body.
+type ProblemDetails struct {
+	Type      string `json:"type" example:"about:blank"`
+	Title     string `json:"title" example:"Not Found"`
+	Status    int    `json:"status" example:"404"`
+	Detail    string `json:"detail,omitempty" example:"task not found"`
+	Instance  string `json:"instance,omitempty" example:"/v1/tasks/1"`
+	RequestID string `json:"request_id,omitempty" example:"task-services-1729212000000000000"`
+	// Extensions are additional problem members, flattened into the body.
+	Extensions map[string]any `json:"-"`
+}
+
+func (p ProblemDetails) MarshalJSON() ([]byte, error) {
+	type problem ProblemDetails
+	body, err := json.Marshal(problem(p))
+	if err != nil || len(p.Extensions) == 0 {
+		return body, err
+	}
+	members := make(map[string]any, len(p.Extensions))
+	for k, v := range p.Extensions {
+		members[k] = v
+	}
+	if err := json.Unmarshal(body, &members); err != nil {
+		return nil, err
+	}
+	return json.Marshal(members)
 }